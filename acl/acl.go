@@ -398,12 +398,15 @@ func userMod(conf *viper.Viper, userId string, groups string) error {
 		is a non-negative integer between 0-7.
 	4. It will delete, if group already have a rule for the predicate and the permission is
 		a negative integer.
+	5. It will set the filter of the rule if one is given. A predicate of the form
+		type:<TypeName> creates a rule that only restricts the nodes of the type.
 */
 
 func chMod(conf *viper.Viper) error {
 	groupName := conf.GetString("group")
 	predicate := conf.GetString("pred")
 	perm := conf.GetInt("perm")
+	filter := conf.GetString("filter")
 	switch {
 	case len(groupName) == 0:
 		return errors.New("the group must not be empty")
@@ -470,6 +473,20 @@ func chMod(conf *viper.Viper) error {
 		Cond: "@if(eq(len(rUID), 0) AND eq(len(gUID), 1))",
 	}
 
+	if filter != "" {
+		filterVal := &api.Value{Val: &api.Value_StrVal{StrVal: filter}}
+		updateRule.Set = append(updateRule.Set, &api.NQuad{
+			Subject:     "uid(rUID)",
+			Predicate:   "dgraph.rule.filter",
+			ObjectValue: filterVal,
+		})
+		createRule.Set = append(createRule.Set, &api.NQuad{
+			Subject:     "_:newrule",
+			Predicate:   "dgraph.rule.filter",
+			ObjectValue: filterVal,
+		})
+	}
+
 	deleteRule := &api.Mutation{
 		Del: []*api.NQuad{
 			{
@@ -602,7 +619,7 @@ func queryAndPrintUser(ctx context.Context, txn *dgo.Txn, userId string) error {
 
 func queryAndPrintGroup(ctx context.Context, txn *dgo.Txn, groupId string) error {
 	group, err := queryGroup(ctx, txn, groupId, "dgraph.xid", "~dgraph.user.group{dgraph.xid}",
-		"dgraph.acl.rule{dgraph.rule.predicate, dgraph.rule.permission}")
+		"dgraph.acl.rule{dgraph.rule.predicate, dgraph.rule.permission, dgraph.rule.filter}")
 	if err != nil {
 		return err
 	}
@@ -68,6 +68,10 @@ const (
 		"predicate": "dgraph.password",
 		"type": "password"
 	  },
+	  {
+		"predicate": "dgraph.rule.filter",
+		"type": "string"
+	  },
 	  {
 		"predicate": "dgraph.rule.permission",
 		"type": "int"
@@ -144,6 +148,9 @@ const (
 		  },
 		  {
 			"name": "dgraph.rule.permission"
+		  },
+		  {
+			"name": "dgraph.rule.filter"
 		  }
 		],
 		"name": "dgraph.type.Rule"
@@ -200,6 +207,10 @@ const (
 		"predicate": "dgraph.password",
 		"type": "password"
 	  },
+	  {
+		"predicate": "dgraph.rule.filter",
+		"type": "string"
+	  },
 	  {
 		"predicate": "dgraph.rule.permission",
 		"type": "int"
@@ -277,6 +288,9 @@ const (
 		  },
 		  {
 			"name": "dgraph.rule.permission"
+		  },
+		  {
+			"name": "dgraph.rule.filter"
 		  }
 		],
 		"name": "dgraph.type.Rule"
@@ -352,6 +366,10 @@ const (
 		"predicate": "dgraph.password",
 		"type": "password"
 	  },
+	  {
+		"predicate": "dgraph.rule.filter",
+		"type": "string"
+	  },
 	  {
 		"predicate": "dgraph.rule.permission",
 		"type": "int"
@@ -440,6 +458,9 @@ const (
 		  },
 		  {
 			"name": "dgraph.rule.permission"
+		  },
+		  {
+			"name": "dgraph.rule.filter"
 		  }
 		],
 		"name": "dgraph.type.Rule"
@@ -108,10 +108,17 @@ func initSubcommands() []*x.SubCommand {
 	modFlags.StringP("group_list", "l", defaultGroupList,
 		"The list of groups to be set for the user")
 	modFlags.StringP("group", "g", "", "The group whose permission is to be changed")
-	modFlags.StringP("pred", "p", "", "The predicates whose acls are to be changed")
+	modFlags.StringP("pred", "p", "", "The predicates whose acls are to be changed. The admin "+
+		"permissions dgraph.admin.backup, dgraph.admin.restore, dgraph.admin.schema, "+
+		"dgraph.admin.namespace and dgraph.admin.cluster are granted the same way, and so is "+
+		"dgraph.key.<name> with the read permission, which decrypts the values of the "+
+		"@encrypted predicates using the key <name>")
 	modFlags.IntP("perm", "m", 0, "The acl represented using "+
 		"an integer: 4 for read, 2 for write, and 1 for modify. Use a negative value to remove a "+
 		"predicate from the group")
+	modFlags.StringP("filter", "f", "", "The DQL filter that restricts the nodes the group can "+
+		"access through the predicate, e.g. eq(owner, $user). Use type:<TypeName> as the "+
+		"predicate to restrict the nodes of a type")
 
 	var cmdInfo x.SubCommand
 	cmdInfo.Cmd = &cobra.Command{
@@ -8,6 +8,7 @@ package acl
 import (
 	"encoding/json"
 	"fmt"
+	"strings"
 
 	"github.com/golang/glog"
 	"github.com/spf13/viper"
@@ -38,6 +39,30 @@ var (
 	OpModify = "Modify"
 )
 
+// Admin permissions are granted to a group through a rule on one of these names, with any
+// non-zero permission. They let the members of the group run admin operations that are
+// otherwise reserved for the guardians.
+const (
+	// AdminBackup allows taking and listing backups, and exports.
+	AdminBackup = "dgraph.admin.backup"
+	// AdminRestore allows restoring backups.
+	AdminRestore = "dgraph.admin.restore"
+	// AdminSchema allows altering the schema of all the non-ACL predicates and the GraphQL
+	// schema. Dropping a predicate still needs the permission to modify it, and dropping all
+	// the data a guardian.
+	AdminSchema = "dgraph.admin.schema"
+	// AdminNamespace allows creating, dropping, renaming and listing namespaces.
+	AdminNamespace = "dgraph.admin.namespace"
+	// AdminCluster allows draining, moving tablets, removing nodes, assigning uids and
+	// timestamps, changing the config, shutting down and reading the state of the cluster.
+	AdminCluster = "dgraph.admin.cluster"
+)
+
+// FieldKeyPrefix marks the rules that grant a field key to a group. Members of a group with a
+// rule on dgraph.key.<name> with the read permission get the values of the @encrypted
+// predicates encrypted with the key <name> decrypted. Other users don't get them at all.
+const FieldKeyPrefix = "dgraph.key."
+
 // Operation represents a Dgraph data operation (e.g write or read).
 type Operation struct {
 	Code int32
@@ -99,11 +124,23 @@ func UnmarshalUser(resp *api.Response, userKey string) (user *User, err error) {
 	return &users[0], nil
 }
 
+// TypeRulePrefix marks the rules whose predicate is of the form type:<TypeName>. Such rules
+// don't grant permissions, they only restrict the nodes of the type through their filter.
+const TypeRulePrefix = "type:"
+
 // Acl represents the permissions in the ACL system.
-// An Acl can have a predicate and permission for that predicate.
+// An Acl can have a predicate and permission for that predicate. The optional filter is a DQL
+// filter that restricts the nodes the group can access through the predicate. For type rules,
+// it restricts the nodes of the type.
 type Acl struct {
 	Predicate string `json:"dgraph.rule.predicate"`
 	Perm      int32  `json:"dgraph.rule.permission"`
+	Filter    string `json:"dgraph.rule.filter,omitempty"`
+}
+
+// IsTypeRule returns true if the rule applies to the nodes of a type instead of a predicate.
+func (a *Acl) IsTypeRule() bool {
+	return strings.HasPrefix(a.Predicate, TypeRulePrefix)
 }
 
 // Group represents a group in the ACL system.
@@ -9,7 +9,10 @@ import (
 	"fmt"
 	"math"
 	"os"
+	"path/filepath"
+	"sync"
 	"sync/atomic"
+	"time"
 
 	"github.com/golang/glog"
 
@@ -36,6 +39,15 @@ type AuditEvent struct {
 	Req         string
 	Status      string
 	QueryParams map[string][]string
+	// Preds are the predicates read or written by the request, if known.
+	Preds []string
+	// NumUids is the number of nodes touched by the request, if known.
+	NumUids uint64
+	// StartTs and CommitTs identify the transaction of the request, if any.
+	StartTs  uint64
+	CommitTs uint64
+	// Latency is the time taken to serve the request.
+	Latency time.Duration
 }
 
 const (
@@ -52,6 +64,18 @@ var auditor = &auditLogger{}
 
 type auditLogger struct {
 	log *x.Logger
+	// sinks are the remote outputs the entries are shipped to.
+	sinks []*sink
+
+	// mu serializes the writes, so that the entries are written in the order of the chain.
+	mu sync.Mutex
+	// seq and prevHash are the sequence number and the hash of the last entry written.
+	seq      uint64
+	prevHash string
+	// key is the key of the hashes of the chain, the audit encryption key.
+	key []byte
+	// headPath is the file the head of the chain is recorded in, if any.
+	headPath string
 }
 
 func GetAuditConf(conf string) *x.LoggerConf {
@@ -63,9 +87,18 @@ func GetAuditConf(conf string) *x.LoggerConf {
 	if out != "stdout" {
 		out = auditFlag.GetPath("output")
 	}
-	x.AssertTruef(out != "", "out flag is not provided for the audit logs")
+	syslog := auditFlag.GetString("syslog")
+	otlp := auditFlag.GetString("otlp")
+	x.AssertTruef(out != "" || syslog != "" || otlp != "",
+		"none of the output, syslog or otlp flags is provided for the audit logs")
 	encBytes, err := readAuditEncKey(auditFlag)
 	x.Check(err)
+	syslogTLS, err := readSyslogTLSConfig(auditFlag)
+	x.Check(err)
+	otlpHeaders, err := parseOTLPHeaders(auditFlag.GetString("otlp-headers"))
+	x.Check(err)
+	bufferSize := auditFlag.GetInt64("buffer")
+	x.AssertTruef(bufferSize > 0, "the buffer flag of the audit logs must be positive")
 	return &x.LoggerConf{
 		Compress:      auditFlag.GetBool("compress"),
 		Output:        out,
@@ -73,6 +106,12 @@ func GetAuditConf(conf string) *x.LoggerConf {
 		Days:          auditFlag.GetInt64("days"),
 		Size:          auditFlag.GetInt64("size"),
 		MessageKey:    "endpoint",
+		Syslog:        syslog,
+		SyslogTLS:     syslogTLS,
+		OTLP:          otlp,
+		OTLPHeaders:   otlpHeaders,
+		BufferSize:    int(bufferSize),
+		ChainHeadDir:  auditFlag.GetPath("head-dir"),
 	}
 }
 
@@ -102,11 +141,50 @@ func InitAuditor(conf *x.LoggerConf, gId, nId uint64) error {
 	if gId == 0 {
 		ntype = NodeTypeZero
 	}
-	var err error
+	sinks, err := newSinks(conf, ntype)
+	if err != nil {
+		return err
+	}
+	auditor.mu.Lock()
+	defer auditor.mu.Unlock()
+	auditor.sinks = sinks
 	filename := fmt.Sprintf(defaultAuditFilenameF, ntype, gId, nId)
-	if auditor.log, err = x.InitLogger(conf, filename); err != nil {
+	if conf.Output == "" {
+		// The audit logs are only shipped to the remote outputs.
+		auditor.log = nil
+	} else if auditor.log, err = x.InitLogger(conf, filename); err != nil {
+		closeSinks(sinks)
+		auditor.sinks = nil
 		return err
 	}
+	// Continue the hash chain of the entries already in the file, if any.
+	auditor.seq, auditor.prevHash, auditor.headPath = 0, "", ""
+	auditor.key = conf.EncryptionKey
+	if path := auditor.log.FilePath(); path != "" {
+		last, err := lastEntry(path, conf.EncryptionKey)
+		if err != nil {
+			glog.Warningf("unable to read the last audit entry of %s, starting a new hash "+
+				"chain: %v", path, err)
+		} else if last != nil {
+			auditor.seq, auditor.prevHash = last.Seq, last.Hash
+		}
+
+		headDir := conf.ChainHeadDir
+		if headDir == "" {
+			headDir = filepath.Dir(path)
+		}
+		auditor.headPath = headPath(headDir, path)
+		head, err := readHead(auditor.headPath)
+		if err != nil {
+			glog.Warningf("unable to read the audit chain head: %v", err)
+		} else if head != nil && head.Seq > auditor.seq {
+			// The end of the log is missing. The chain continues from the head, so that the
+			// gap stays visible to `dgraph audit verify`.
+			glog.Errorf("the audit chain head is entry %d but %s ends at entry %d, entries "+
+				"have been removed from the end of the log", head.Seq, path, auditor.seq)
+			auditor.seq, auditor.prevHash = head.Seq, head.Hash
+		}
+	}
 	atomic.StoreUint32(&auditEnabled, 1)
 	glog.Infoln("audit logs are enabled")
 	return nil
@@ -119,20 +197,55 @@ func Close() {
 	if atomic.LoadUint32(&auditEnabled) == 0 {
 		return
 	}
+	auditor.mu.Lock()
+	defer auditor.mu.Unlock()
 	auditor.log.Sync()
 	auditor.log = nil
+	closeSinks(auditor.sinks)
+	auditor.sinks = nil
 	glog.Infoln("audit logs are closed.")
 }
 
 func (a *auditLogger) Audit(event *AuditEvent) {
-	a.log.AuditI(event.Endpoint,
-		"level", "AUDIT",
-		"user", event.User,
-		"namespace", event.Namespace,
-		"server", event.ServerHost,
-		"client", event.ClientHost,
-		"req_type", event.ReqType,
-		"req_body", event.Req,
-		"query_param", event.QueryParams,
-		"status", event.Status)
+	entry := &logEntry{
+		Time:        time.Now().UTC().Format(timeFormat),
+		Endpoint:    event.Endpoint,
+		Level:       "AUDIT",
+		User:        event.User,
+		Namespace:   event.Namespace,
+		Server:      event.ServerHost,
+		Client:      event.ClientHost,
+		ReqType:     event.ReqType,
+		Req:         event.Req,
+		QueryParams: event.QueryParams,
+		Status:      event.Status,
+		Preds:       event.Preds,
+		NumUids:     event.NumUids,
+		StartTs:     event.StartTs,
+		CommitTs:    event.CommitTs,
+		LatencyNs:   event.Latency.Nanoseconds(),
+	}
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	entry.Seq = a.seq + 1
+	entry.PrevHash = a.prevHash
+	line, err := entry.seal(a.key)
+	if err != nil {
+		glog.Errorf("unable to encode audit entry for %s: %v", event.Endpoint, err)
+		return
+	}
+	if _, err := a.log.Write(line); err != nil {
+		glog.Errorf("unable to write audit entry for %s: %v", event.Endpoint, err)
+		return
+	}
+	a.seq, a.prevHash = entry.Seq, entry.Hash
+	if a.headPath != "" {
+		if err := writeHead(a.headPath, chainHead{Seq: entry.Seq, Hash: entry.Hash}); err != nil {
+			glog.Errorf("unable to record the audit chain head: %v", err)
+		}
+	}
+	for _, s := range a.sinks {
+		s.send(entry, line)
+	}
 }
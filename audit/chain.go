@@ -0,0 +1,301 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package audit
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/hypermodeinc/dgraph/v25/x"
+)
+
+const (
+	timeFormat = "2006-01-02T15:04:05.000Z0700"
+	// maxTailLength is the size of the tail of a plain audit log that is read to find the last
+	// entry, it must be larger than any entry.
+	maxTailLength = 1 << 20
+)
+
+// logEntry is an audit event as it is written to the audit log, one JSON object per line.
+//
+// The entries form a hash chain: every entry holds the hash of the previous one in prev_hash,
+// and its own hash is the HMAC-SHA256 of the entry encoded without the hash field, keyed with
+// the audit encryption key. An entry that is edited no longer matches its hash, and an entry
+// that is deleted breaks the chain of the next one, which is what `dgraph audit verify` checks.
+// Without the key the hashes can't be recomputed, so the chain can't be rewritten to hide the
+// changes. The logs that aren't encrypted are chained with a plain SHA-256, which only detects
+// accidental changes.
+//
+// The entries removed from the end of the log don't break the chain, so the sequence number
+// and hash of the last entry are also recorded in a head file outside the log, see chainHead.
+type logEntry struct {
+	Time        string              `json:"ts"`
+	Endpoint    string              `json:"endpoint"`
+	Level       string              `json:"level"`
+	User        string              `json:"user"`
+	Namespace   uint64              `json:"namespace"`
+	Server      string              `json:"server"`
+	Client      string              `json:"client"`
+	ReqType     string              `json:"req_type"`
+	Req         string              `json:"req_body"`
+	QueryParams map[string][]string `json:"query_param"`
+	Status      string              `json:"status"`
+	Preds       []string            `json:"preds,omitempty"`
+	NumUids     uint64              `json:"num_uids,omitempty"`
+	StartTs     uint64              `json:"start_ts,omitempty"`
+	CommitTs    uint64              `json:"commit_ts,omitempty"`
+	LatencyNs   int64               `json:"latency_ns"`
+	Seq         uint64              `json:"seq"`
+	PrevHash    string              `json:"prev_hash"`
+	Hash        string              `json:"hash,omitempty"`
+}
+
+// digest returns the hash of the entry, computed over the entry encoded without its hash. The
+// hash is keyed with key, if any.
+func (e *logEntry) digest(key []byte) (string, error) {
+	unsealed := *e
+	unsealed.Hash = ""
+	data, err := json.Marshal(&unsealed)
+	if err != nil {
+		return "", err
+	}
+	if len(key) == 0 {
+		sum := sha256.Sum256(data)
+		return hex.EncodeToString(sum[:]), nil
+	}
+	mac := hmac.New(sha256.New, key)
+	mac.Write(data)
+	return hex.EncodeToString(mac.Sum(nil)), nil
+}
+
+// seal sets the hash of the entry and returns the line to be written to the audit log.
+func (e *logEntry) seal(key []byte) ([]byte, error) {
+	var err error
+	if e.Hash, err = e.digest(key); err != nil {
+		return nil, err
+	}
+	line, err := json.Marshal(e)
+	if err != nil {
+		return nil, err
+	}
+	return append(line, '\n'), nil
+}
+
+// lastEntry returns the last entry of the audit log at path, or nil if the file doesn't exist
+// or doesn't end with a chained entry.
+func lastEntry(path string, encKey []byte) (*logEntry, error) {
+	f, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = f.Close()
+	}()
+	stat, err := f.Stat()
+	if err != nil {
+		return nil, err
+	}
+
+	var line []byte
+	if encKey != nil {
+		line, err = lastEncryptedWrite(f, stat.Size(), encKey)
+	} else {
+		line, err = lastLine(f, stat.Size())
+	}
+	if err != nil || len(line) == 0 {
+		return nil, err
+	}
+
+	var entry logEntry
+	if err := json.Unmarshal(bytes.TrimSpace(line), &entry); err != nil || entry.Hash == "" {
+		// Entries written before the hash chain was introduced.
+		return nil, nil
+	}
+	return &entry, nil
+}
+
+func lastLine(f io.ReaderAt, sz int64) ([]byte, error) {
+	off := max(sz-maxTailLength, 0)
+	tail := make([]byte, sz-off)
+	if _, err := f.ReadAt(tail, off); err != nil && err != io.EOF {
+		return nil, err
+	}
+	tail = bytes.TrimRight(tail, "\n")
+	return tail[bytes.LastIndexByte(tail, '\n')+1:], nil
+}
+
+// lastEncryptedWrite returns the last write of an encrypted audit log, skipping over the ones
+// before it without decrypting them. See decrypt for the format of the file.
+func lastEncryptedWrite(f io.ReaderAt, sz int64, encKey []byte) ([]byte, error) {
+	block, err := aes.NewCipher(encKey)
+	if err != nil {
+		return nil, err
+	}
+	var lastOff, lastLen int64
+	iterator := int64(aes.BlockSize + 4 + len(x.VerificationText))
+	header := make([]byte, aes.BlockSize+4)
+	for iterator+int64(len(header)) <= sz {
+		if _, err := f.ReadAt(header, iterator); err != nil {
+			return nil, err
+		}
+		length := int64(binary.BigEndian.Uint32(header[aes.BlockSize:]))
+		if iterator+int64(len(header))+length > sz {
+			// the last write is truncated
+			break
+		}
+		lastOff, lastLen = iterator, length
+		iterator += int64(len(header)) + length
+	}
+	if lastLen == 0 {
+		return nil, nil
+	}
+
+	iv := make([]byte, aes.BlockSize)
+	if _, err := f.ReadAt(iv, lastOff); err != nil {
+		return nil, err
+	}
+	content := make([]byte, lastLen)
+	if _, err := f.ReadAt(content, lastOff+int64(len(header))); err != nil {
+		return nil, err
+	}
+	cipher.NewCTR(block, iv).XORKeyStream(content, content)
+	return content, nil
+}
+
+// chainHead is the last entry of a hash chain, as recorded in the head file of an audit log.
+// The head file is kept in a directory of its own, ideally on another volume than the logs, so
+// that truncating the log doesn't remove it: an entry past the end of the log is reported by
+// `dgraph audit verify`.
+type chainHead struct {
+	Seq  uint64 `json:"seq"`
+	Hash string `json:"hash"`
+}
+
+// headPath returns the path of the head file, in dir, of the audit log named filename.
+func headPath(dir, filename string) string {
+	name := filepath.Base(filename)
+	if m := auditFileRe.FindStringSubmatch(name); m != nil {
+		name = m[1]
+	}
+	return filepath.Join(dir, name+".head")
+}
+
+// readHead returns the head recorded at path, or nil if there is none.
+func readHead(path string) (*chainHead, error) {
+	data, err := os.ReadFile(path)
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var head chainHead
+	if err := json.Unmarshal(data, &head); err != nil {
+		return nil, fmt.Errorf("unable to parse the chain head %s: %w", path, err)
+	}
+	return &head, nil
+}
+
+// writeHead records the head at path. The head is written to a temporary file which is then
+// renamed, so that the file never holds a partial head.
+func writeHead(path string, head chainHead) error {
+	data, err := json.Marshal(head)
+	if err != nil {
+		return err
+	}
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0600); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+// chainVerifier verifies the hash chain of the entries of an audit log, possibly split across
+// several files.
+type chainVerifier struct {
+	// key is the key of the hashes, the audit encryption key, if any.
+	key []byte
+	// entries is the number of entries verified so far.
+	entries uint64
+	// seq and hash are the sequence number and the hash of the last entry verified.
+	seq  uint64
+	hash string
+	// errs are the problems found, in the order of the entries.
+	errs []string
+}
+
+// verify reads the entries from r, which is named name in the errors.
+func (v *chainVerifier) verify(name string, r io.Reader) error {
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 0, 64<<10), maxTailLength)
+	line := 0
+	for scanner.Scan() {
+		line++
+		data := bytes.TrimSpace(scanner.Bytes())
+		if len(data) == 0 {
+			continue
+		}
+
+		var entry logEntry
+		if err := json.Unmarshal(data, &entry); err != nil {
+			v.errorf("%s:%d: unable to parse entry: %v", name, line, err)
+			continue
+		}
+		if entry.Hash == "" {
+			v.errorf("%s:%d: entry is not chained", name, line)
+			continue
+		}
+		digest, err := entry.digest(v.key)
+		if err != nil {
+			return err
+		}
+		if digest != entry.Hash {
+			v.errorf("%s:%d: entry %d has been modified", name, line, entry.Seq)
+		}
+		if v.entries > 0 {
+			switch {
+			case entry.PrevHash == "":
+				v.errorf("%s:%d: hash chain restarted at entry %d after entry %d", name, line,
+					entry.Seq, v.seq)
+			case entry.PrevHash != v.hash || entry.Seq != v.seq+1:
+				v.errorf("%s:%d: hash chain broken between entry %d and entry %d, entries are "+
+					"missing or have been modified", name, line, v.seq, entry.Seq)
+			}
+		}
+		v.entries++
+		v.seq, v.hash = entry.Seq, entry.Hash
+	}
+	return scanner.Err()
+}
+
+// checkHead reports the entries recorded in head that are missing from the end of the log.
+func (v *chainVerifier) checkHead(name string, head *chainHead) {
+	switch {
+	case head.Seq > v.seq:
+		v.errorf("%s: the chain head is entry %d but the log ends at entry %d, entries have "+
+			"been removed from the end of the log", name, head.Seq, v.seq)
+	case head.Seq == v.seq && head.Hash != v.hash:
+		v.errorf("%s: the last entry %d doesn't match the chain head", name, v.seq)
+	}
+}
+
+func (v *chainVerifier) errorf(format string, args ...interface{}) {
+	v.errs = append(v.errs, fmt.Sprintf(format, args...))
+}
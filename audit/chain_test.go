@@ -0,0 +1,246 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package audit
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hypermodeinc/dgraph/v25/x"
+)
+
+func writeAuditEntries(t *testing.T, conf *x.LoggerConf, from, to int) string {
+	require.NoError(t, InitAuditor(conf, 1, 1))
+	for i := from; i < to; i++ {
+		auditor.Audit(&AuditEvent{
+			User:       "alice",
+			Endpoint:   "/query",
+			ReqType:    Http,
+			Req:        fmt.Sprintf(`{ q(func: uid(%#x)) { name } }`, i),
+			Status:     "OK",
+			Preds:      []string{"name"},
+			NumUids:    uint64(i),
+			StartTs:    uint64(10 + i),
+			CommitTs:   uint64(11 + i),
+			Latency:    time.Duration(i) * time.Millisecond,
+			ServerHost: "localhost:7080",
+		})
+	}
+	path := auditor.log.FilePath()
+	Close()
+	return path
+}
+
+func verifyAuditFile(t *testing.T, file string, key []byte) *chainVerifier {
+	var block cipher.Block
+	if key != nil {
+		var err error
+		block, err = aes.NewCipher(key)
+		require.NoError(t, err)
+	}
+	v := &chainVerifier{key: key}
+	require.NoError(t, verifyFile(v, file, block))
+	return v
+}
+
+func TestAuditHashChain(t *testing.T) {
+	conf := &x.LoggerConf{Output: t.TempDir(), Size: 100, Days: 1}
+	writeAuditEntries(t, conf, 0, 3)
+	// The chain continues across restarts.
+	path := writeAuditEntries(t, conf, 3, 5)
+
+	v := verifyAuditFile(t, path, nil)
+	require.Empty(t, v.errs)
+	require.Equal(t, uint64(5), v.entries)
+	require.Equal(t, uint64(5), v.seq)
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	lines := strings.SplitAfter(strings.TrimSpace(string(data)), "\n")
+	require.Len(t, lines, 5)
+	require.Contains(t, lines[1], `"preds":["name"],"num_uids":1,"start_ts":11,"commit_ts":12`)
+
+	tamper := func(lines []string) []string {
+		file := filepath.Join(t.TempDir(), "alpha_audit_1_1.log")
+		require.NoError(t, os.WriteFile(file, []byte(strings.Join(lines, "")), 0600))
+		return verifyAuditFile(t, file, nil).errs
+	}
+
+	// An edited entry.
+	edited := append([]string{}, lines...)
+	edited[2] = strings.Replace(edited[2], `"user":"alice"`, `"user":"bob"`, 1)
+	errs := tamper(edited)
+	require.Len(t, errs, 1)
+	require.Contains(t, errs[0], "entry 3 has been modified")
+
+	// A deleted entry.
+	deleted := append(append([]string{}, lines[:2]...), lines[3:]...)
+	errs = tamper(deleted)
+	require.Len(t, errs, 1)
+	require.Contains(t, errs[0], "hash chain broken between entry 2 and entry 4")
+
+	// Entries removed from the start of the log can't be detected, but the rest is verified.
+	require.Empty(t, tamper(lines[2:]))
+}
+
+func TestAuditHashChainEncrypted(t *testing.T) {
+	key, err := os.ReadFile("../enc/test-fixtures/enc-key")
+	require.NoError(t, err)
+
+	conf := &x.LoggerConf{Output: t.TempDir(), Size: 100, Days: 1, EncryptionKey: key}
+	writeAuditEntries(t, conf, 0, 2)
+	path := writeAuditEntries(t, conf, 2, 4)
+	require.True(t, strings.HasSuffix(path, ".enc"))
+
+	last, err := lastEntry(path, key)
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), last.Seq)
+
+	v := verifyAuditFile(t, path, key)
+	require.Empty(t, v.errs)
+	require.Equal(t, uint64(4), v.entries)
+	require.Equal(t, last.Hash, v.hash)
+
+	// The hashes are keyed, they don't match without the key.
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	block, err := aes.NewCipher(key)
+	require.NoError(t, err)
+	var out bytes.Buffer
+	require.NoError(t, decrypt(bytes.NewReader(data), &out, block, int64(len(data))))
+	v = &chainVerifier{}
+	require.NoError(t, v.verify(path, &out))
+	require.Len(t, v.errs, 4)
+	require.Contains(t, v.errs[0], "entry 1 has been modified")
+}
+
+func TestAuditHashChainKeyed(t *testing.T) {
+	key := []byte("0123456789abcdef")
+	var lines []string
+	prev := ""
+	for i := 1; i <= 3; i++ {
+		e := &logEntry{Endpoint: "/query", User: "alice", Seq: uint64(i), PrevHash: prev}
+		line, err := e.seal(key)
+		require.NoError(t, err)
+		lines = append(lines, string(line))
+		prev = e.Hash
+	}
+	verify := func(lines []string) []string {
+		v := &chainVerifier{key: key}
+		require.NoError(t, v.verify("log", strings.NewReader(strings.Join(lines, ""))))
+		return v.errs
+	}
+	require.Empty(t, verify(lines))
+
+	// An entry edited and hashed again without the key is detected, even with the chain of the
+	// next entries rewritten.
+	forged := append([]string{}, lines[:1]...)
+	prev = ""
+	for i := 1; i <= 3; i++ {
+		e := &logEntry{Endpoint: "/query", User: "bob", Seq: uint64(i), PrevHash: prev}
+		if i == 1 {
+			e.User = "alice"
+		}
+		line, err := e.seal(nil)
+		require.NoError(t, err)
+		if i > 1 {
+			forged = append(forged, string(line))
+		}
+		prev = e.Hash
+	}
+	errs := verify(forged)
+	require.Len(t, errs, 3)
+	require.Contains(t, errs[0], "entry 2 has been modified")
+	require.Contains(t, errs[1], "hash chain broken between entry 1 and entry 2")
+	require.Contains(t, errs[2], "entry 3 has been modified")
+}
+
+func TestAuditChainHead(t *testing.T) {
+	headDir := t.TempDir()
+	conf := &x.LoggerConf{Output: t.TempDir(), Size: 100, Days: 1, ChainHeadDir: headDir}
+	path := writeAuditEntries(t, conf, 0, 5)
+
+	head, err := readHead(headPath(headDir, path))
+	require.NoError(t, err)
+	require.Equal(t, uint64(5), head.Seq)
+	v := verifyAuditFile(t, path, nil)
+	require.Equal(t, v.hash, head.Hash)
+	v.checkHead(path, head)
+	require.Empty(t, v.errs)
+
+	// Entries removed from the end of the log.
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	lines := strings.SplitAfter(strings.TrimSpace(string(data)), "\n")
+	require.NoError(t, os.WriteFile(path, []byte(strings.Join(lines[:3], "")), 0600))
+	v = verifyAuditFile(t, path, nil)
+	require.Empty(t, v.errs)
+	v.checkHead(path, head)
+	require.Len(t, v.errs, 1)
+	require.Contains(t, v.errs[0], "the chain head is entry 5 but the log ends at entry 3")
+
+	// The chain continues from the head after a restart, the gap stays in the log.
+	writeAuditEntries(t, conf, 5, 6)
+	v = verifyAuditFile(t, path, nil)
+	require.Len(t, v.errs, 1)
+	require.Contains(t, v.errs[0], "hash chain broken between entry 3 and entry 6")
+	head, err = readHead(headPath(headDir, path))
+	require.NoError(t, err)
+	v.checkHead(path, head)
+	require.Len(t, v.errs, 1)
+}
+
+func TestAuditChains(t *testing.T) {
+	dir := t.TempDir()
+	for _, name := range []string{
+		"alpha_audit_1_1.log",
+		"alpha_audit_1_1-2026-01-02T15-04-05.000.log.gz",
+		"alpha_audit_1_1-2025-12-02T15-04-05.000.log",
+		"zero_audit_0_1.log.enc",
+		"zero_audit_0_1.log-2026-01-02T15-04-05.000.enc",
+		"notes.txt",
+	} {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, name), nil, 0600))
+	}
+
+	chains, err := auditChains(dir)
+	require.NoError(t, err)
+	for _, chain := range chains {
+		for i := range chain {
+			chain[i] = filepath.Base(chain[i])
+		}
+	}
+	require.Equal(t, [][]string{
+		{
+			"alpha_audit_1_1-2025-12-02T15-04-05.000.log",
+			"alpha_audit_1_1-2026-01-02T15-04-05.000.log.gz",
+			"alpha_audit_1_1.log",
+		},
+		{
+			"zero_audit_0_1.log-2026-01-02T15-04-05.000.enc",
+			"zero_audit_0_1.log.enc",
+		},
+	}, chains)
+}
+
+func TestLastEntryLegacy(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "alpha_audit_1_1.log")
+	var buf bytes.Buffer
+	buf.WriteString(`{"ts":"2026-01-02T15:04:05.000Z","endpoint":"/query","status":"OK"}` + "\n")
+	require.NoError(t, os.WriteFile(file, buf.Bytes(), 0600))
+	last, err := lastEntry(file, nil)
+	require.NoError(t, err)
+	require.Nil(t, last)
+}
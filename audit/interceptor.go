@@ -15,10 +15,14 @@ import (
 	"net"
 	"net/http"
 	"regexp"
+	"slices"
+	"sort"
 	"strconv"
 	"strings"
 	"sync/atomic"
+	"time"
 
+	"github.com/dgraph-io/dgo/v250/protos/api"
 	"github.com/dgraph-io/gqlparser/v2/ast"
 	"github.com/dgraph-io/gqlparser/v2/parser"
 	"github.com/hypermodeinc/dgraph/v25/graphql/schema"
@@ -34,7 +38,8 @@ import (
 )
 
 const (
-	maxReqLength = 4 << 10 // 4 KB
+	maxReqLength  = 4 << 10 // 4 KB
+	maxRespLength = 1 << 20 // 1 MB
 )
 
 var skipApis = map[string]bool{
@@ -55,6 +60,13 @@ var skipApis = map[string]bool{
 	"Watch": true,
 }
 
+// txnEPs are the endpoints whose responses hold the transaction of the request.
+var txnEPs = map[string]bool{
+	"/query":  true,
+	"/mutate": true,
+	"/commit": true,
+}
+
 var skipEPs = map[string]bool{
 	// list of endpoints that needs to be skipped
 	"/health":        true,
@@ -71,8 +83,9 @@ func AuditRequestGRPC(ctx context.Context, req interface{},
 	if atomic.LoadUint32(&auditEnabled) == 0 || skip(info.FullMethod) {
 		return handler(ctx, req)
 	}
+	start := time.Now()
 	response, err := handler(ctx, req)
-	auditGrpc(ctx, req, info)
+	auditGrpc(ctx, req, response, err, time.Since(start), info)
 	return response, err
 }
 
@@ -100,12 +113,15 @@ func AuditRequestHttp(next http.Handler) http.Handler {
 		}
 
 		rw := NewResponseWriter(w)
+		rw.captureBody = txnEPs[r.URL.Path]
 		var buf bytes.Buffer
 		tee := io.TeeReader(r.Body, &buf)
 		r.Body = io.NopCloser(tee)
+		start := time.Now()
 		next.ServeHTTP(rw, r)
+		latency := time.Since(start)
 		r.Body = io.NopCloser(bytes.NewReader(buf.Bytes()))
-		auditHttp(rw, r)
+		auditHttp(rw, r, latency)
 	})
 }
 
@@ -148,14 +164,14 @@ func AuditWebSockets(ctx context.Context, req *schema.Request) {
 	})
 }
 
-func auditGrpc(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo) {
+func auditGrpc(ctx context.Context, req, resp interface{}, err error, latency time.Duration,
+	info *grpc.UnaryServerInfo) {
 	clientHost := ""
 	if p, ok := peer.FromContext(ctx); ok {
 		clientHost = p.Addr.String()
 	}
 	var user string
 	var namespace uint64
-	var err error
 	extractUser := func(md metadata.MD) {
 		if t := md.Get("accessJwt"); len(t) > 0 {
 			user = getUser(t[0], false)
@@ -171,6 +187,7 @@ func auditGrpc(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo)
 		if len(ns) == 0 {
 			namespace = UnknownNamespace
 		} else {
+			var err error
 			if namespace, err = strconv.ParseUint(ns[0], 10, 64); err != nil {
 				namespace = UnknownNamespace
 			}
@@ -189,7 +206,7 @@ func auditGrpc(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo)
 
 	reqBody := checkRequestBody(Grpc, info.FullMethod[strings.LastIndex(info.FullMethod,
 		"/")+1:], fmt.Sprintf("%+v", req))
-	auditor.Audit(&AuditEvent{
+	event := &AuditEvent{
 		User:       user,
 		Namespace:  namespace,
 		ServerHost: x.WorkerConfig.MyAddr,
@@ -198,10 +215,45 @@ func auditGrpc(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo)
 		ReqType:    Grpc,
 		Req:        truncate(reqBody, maxReqLength),
 		Status:     cd.String(),
-	})
+		Latency:    latency,
+	}
+	switch resp := resp.(type) {
+	case *api.Response:
+		event.setTxn(resp.GetTxn())
+		event.NumUids = resp.GetMetrics().GetNumUids()["_total"]
+	case *api.TxnContext:
+		event.setTxn(resp)
+	}
+	auditor.Audit(event)
+}
+
+// setTxn records the transaction of the request and the predicates it touched.
+func (e *AuditEvent) setTxn(txn *api.TxnContext) {
+	if txn == nil {
+		return
+	}
+	e.StartTs = txn.StartTs
+	e.CommitTs = txn.CommitTs
+	e.Preds = parseTxnPreds(txn.Preds)
 }
 
-func auditHttp(w *ResponseWriter, r *http.Request) {
+// parseTxnPreds strips the group and the namespace from the predicates of a transaction, which
+// are of the form gid-namespace-attr.
+func parseTxnPreds(preds []string) []string {
+	resp := make([]string, 0, len(preds))
+	for _, pred := range preds {
+		parts := strings.SplitN(pred, x.NsSeparator, 3)
+		if len(parts) != 3 {
+			resp = append(resp, pred)
+			continue
+		}
+		resp = append(resp, parts[2])
+	}
+	sort.Strings(resp)
+	return slices.Compact(resp)
+}
+
+func auditHttp(w *ResponseWriter, r *http.Request, latency time.Duration) {
 	body := getRequestBody(r)
 	var user string
 	if token := r.Header.Get("X-Dgraph-AccessToken"); token != "" {
@@ -212,7 +264,7 @@ func auditHttp(w *ResponseWriter, r *http.Request) {
 		user = getUser("", false)
 	}
 
-	auditor.Audit(&AuditEvent{
+	event := &AuditEvent{
 		User:        user,
 		Namespace:   x.ExtractNamespaceHTTP(r),
 		ServerHost:  x.WorkerConfig.MyAddr,
@@ -222,7 +274,39 @@ func auditHttp(w *ResponseWriter, r *http.Request) {
 		Req:         truncate(checkRequestBody(Http, r.URL.Path, string(body)), maxReqLength),
 		Status:      http.StatusText(w.statusCode),
 		QueryParams: r.URL.Query(),
-	})
+		Latency:     latency,
+	}
+	if cost := w.Header().Get(x.DgraphCostHeader); cost != "" {
+		event.NumUids, _ = strconv.ParseUint(cost, 10, 64)
+	}
+	if w.captureBody && !w.truncated {
+		event.setTxn(getResponseTxn(w))
+	}
+	auditor.Audit(event)
+}
+
+// getResponseTxn returns the transaction in the extensions of the response of /query, /mutate
+// and /commit.
+func getResponseTxn(w *ResponseWriter) *api.TxnContext {
+	var in io.Reader = bytes.NewReader(w.body.Bytes())
+	if w.Header().Get("Content-Encoding") == "gzip" {
+		gz, err := gzip.NewReader(in)
+		if err != nil {
+			return nil
+		}
+		defer gz.Close()
+		in = gz
+	}
+
+	var resp struct {
+		Extensions struct {
+			Txn *api.TxnContext `json:"txn"`
+		} `json:"extensions"`
+	}
+	if err := json.NewDecoder(in).Decode(&resp); err != nil {
+		return nil
+	}
+	return resp.Extensions.Txn
 }
 
 // password fields are accessible only via /admin endpoint hence,
@@ -372,12 +456,17 @@ func getUser(token string, poorman bool) string {
 type ResponseWriter struct {
 	http.ResponseWriter
 	statusCode int
+
+	// captureBody is set to keep a copy of the body of the response, up to maxRespLength.
+	captureBody bool
+	body        bytes.Buffer
+	truncated   bool
 }
 
 func NewResponseWriter(w http.ResponseWriter) *ResponseWriter {
 	// WriteHeader(int) is not called if our response implicitly returns 200 OK, so
 	// we default to that status code.
-	return &ResponseWriter{w, http.StatusOK}
+	return &ResponseWriter{ResponseWriter: w, statusCode: http.StatusOK}
 }
 
 func (rw *ResponseWriter) WriteHeader(code int) {
@@ -385,6 +474,18 @@ func (rw *ResponseWriter) WriteHeader(code int) {
 	rw.ResponseWriter.WriteHeader(code)
 }
 
+func (rw *ResponseWriter) Write(b []byte) (int, error) {
+	if rw.captureBody && !rw.truncated {
+		if rw.body.Len()+len(b) > maxRespLength {
+			rw.truncated = true
+			rw.body.Reset()
+		} else {
+			rw.body.Write(b)
+		}
+	}
+	return rw.ResponseWriter.Write(b)
+}
+
 func truncate(s string, l int) string {
 	if len(s) > l {
 		return s[:l]
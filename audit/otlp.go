@@ -0,0 +1,173 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package audit
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+	collogspb "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+	commonpb "go.opentelemetry.io/proto/otlp/common/v1"
+	logspb "go.opentelemetry.io/proto/otlp/logs/v1"
+	resourcepb "go.opentelemetry.io/proto/otlp/resource/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+const otlpTimeout = 30 * time.Second
+
+// otlpShipper ships the audit entries to an OTLP/HTTP logs endpoint. The body of each log record
+// is the entry as written to the audit log, and its main fields are also set as attributes.
+type otlpShipper struct {
+	url      string
+	headers  map[string]string
+	client   *http.Client
+	resource *resourcepb.Resource
+}
+
+// parseOTLPHeaders parses a comma separated list of key:value headers.
+func parseOTLPHeaders(headers string) (map[string]string, error) {
+	if headers == "" {
+		return nil, nil
+	}
+	resp := make(map[string]string)
+	for _, header := range strings.Split(headers, ",") {
+		key, value, found := strings.Cut(header, ":")
+		key = strings.TrimSpace(key)
+		if !found || key == "" {
+			return nil, errors.Errorf("invalid OTLP header %q, expected key:value", header)
+		}
+		resp[key] = strings.TrimSpace(value)
+	}
+	return resp, nil
+}
+
+func newOTLPShipper(endpoint string, headers map[string]string,
+	ntype string) (*otlpShipper, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid OTLP endpoint: %s", endpoint)
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, errors.Errorf("invalid OTLP endpoint %s, expected an http or https URL",
+			endpoint)
+	}
+
+	attrs := []*commonpb.KeyValue{
+		stringAttr("service.name", "dgraph"),
+		stringAttr("dgraph.node_type", ntype),
+	}
+	if hostname, err := os.Hostname(); err == nil {
+		attrs = append(attrs, stringAttr("host.name", hostname))
+	}
+	return &otlpShipper{
+		url:      endpoint,
+		headers:  headers,
+		client:   &http.Client{Timeout: otlpTimeout},
+		resource: &resourcepb.Resource{Attributes: attrs},
+	}, nil
+}
+
+func stringAttr(key, value string) *commonpb.KeyValue {
+	return &commonpb.KeyValue{
+		Key:   key,
+		Value: &commonpb.AnyValue{Value: &commonpb.AnyValue_StringValue{StringValue: value}},
+	}
+}
+
+func intAttr(key string, value uint64) *commonpb.KeyValue {
+	return &commonpb.KeyValue{
+		Key:   key,
+		Value: &commonpb.AnyValue{Value: &commonpb.AnyValue_IntValue{IntValue: int64(value)}},
+	}
+}
+
+func (s *otlpShipper) logRecord(e shippedEntry, observed uint64) *logspb.LogRecord {
+	var ts uint64
+	if t, err := time.Parse(timeFormat, e.entry.Time); err == nil {
+		ts = uint64(t.UnixNano())
+	}
+	return &logspb.LogRecord{
+		TimeUnixNano:         ts,
+		ObservedTimeUnixNano: observed,
+		SeverityNumber:       logspb.SeverityNumber_SEVERITY_NUMBER_INFO,
+		SeverityText:         e.entry.Level,
+		Body: &commonpb.AnyValue{Value: &commonpb.AnyValue_StringValue{
+			StringValue: string(bytes.TrimRight(e.line, "\n")),
+		}},
+		Attributes: []*commonpb.KeyValue{
+			stringAttr("endpoint", e.entry.Endpoint),
+			stringAttr("user", e.entry.User),
+			intAttr("namespace", e.entry.Namespace),
+			stringAttr("req_type", e.entry.ReqType),
+			stringAttr("status", e.entry.Status),
+			intAttr("seq", e.entry.Seq),
+			stringAttr("hash", e.entry.Hash),
+		},
+	}
+}
+
+func (s *otlpShipper) ship(entries []shippedEntry) (int, error) {
+	observed := uint64(time.Now().UnixNano())
+	records := make([]*logspb.LogRecord, 0, len(entries))
+	for _, e := range entries {
+		records = append(records, s.logRecord(e, observed))
+	}
+	data, err := proto.Marshal(&collogspb.ExportLogsServiceRequest{
+		ResourceLogs: []*logspb.ResourceLogs{{
+			Resource: s.resource,
+			ScopeLogs: []*logspb.ScopeLogs{{
+				Scope:      &commonpb.InstrumentationScope{Name: "dgraph.audit"},
+				LogRecords: records,
+			}},
+		}},
+	})
+	if err != nil {
+		return 0, permanentError{err}
+	}
+
+	req, err := http.NewRequest(http.MethodPost, s.url, bytes.NewReader(data))
+	if err != nil {
+		return 0, permanentError{err}
+	}
+	req.Header.Set("Content-Type", "application/x-protobuf")
+	for k, v := range s.headers {
+		req.Header.Set(k, v)
+	}
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return 0, errors.Wrapf(err, "while sending audit logs to %s", s.url)
+	}
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			glog.Warningf("error closing body: %v", err)
+		}
+	}()
+	body, _ := io.ReadAll(io.LimitReader(resp.Body, 4<<10))
+
+	switch {
+	case resp.StatusCode >= 200 && resp.StatusCode < 300:
+		return len(entries), nil
+	case resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode == http.StatusBadGateway ||
+		resp.StatusCode == http.StatusServiceUnavailable ||
+		resp.StatusCode == http.StatusGatewayTimeout:
+		return 0, errors.Errorf("while sending audit logs to %s: %s", s.url, resp.Status)
+	default:
+		return 0, permanentError{errors.Errorf("while sending audit logs to %s: %s: %s", s.url,
+			resp.Status, body)}
+	}
+}
+
+func (s *otlpShipper) close() error {
+	s.client.CloseIdleConnections()
+	return nil
+}
@@ -46,7 +46,7 @@ func init() {
 	}
 }
 
-var decryptCmd x.SubCommand
+var decryptCmd, verifyCmd x.SubCommand
 
 func initSubcommands() []*x.SubCommand {
 	decryptCmd.Cmd = &cobra.Command{
@@ -65,7 +65,30 @@ func initSubcommands() []*x.SubCommand {
 	decFlags.String("out", "audit_log_out.log",
 		"output file to which decrypted output will be dumped.")
 	decFlags.String("encryption_key_file", "", "path to encrypt files.")
-	return []*x.SubCommand{&decryptCmd}
+
+	verifyCmd.Cmd = &cobra.Command{
+		Use:   "verify",
+		Short: "Run Dgraph Audit tool to verify the hash chain of audit files",
+		Long: "Verifies that the entries of the audit logs have not been modified or deleted. " +
+			"The logs of a directory are grouped by node, and the rotated files of a node are " +
+			"verified in order as a single chain.",
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := runVerify(); err != nil {
+				fmt.Printf("%v\n", err)
+				os.Exit(1)
+			}
+		},
+	}
+
+	verFlags := verifyCmd.Cmd.Flags()
+	verFlags.String("in", "", "audit file, or directory of audit files, that needs to be "+
+		"verified. A comma separated list of files is verified in the given order.")
+	verFlags.String("encryption_key_file", "", "path to the audit encryption key, which "+
+		"decrypts the encrypted audit files and keys the hashes of their chain.")
+	verFlags.String("head_dir", "", "directory the heads of the hash chains are recorded in, "+
+		"the head-dir of the audit options, to detect the entries removed from the end of "+
+		"the logs.")
+	return []*x.SubCommand{&decryptCmd, &verifyCmd}
 }
 
 func run() error {
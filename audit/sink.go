@@ -0,0 +1,186 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package audit
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"time"
+
+	"github.com/golang/glog"
+	ostats "go.opencensus.io/stats"
+
+	"github.com/dgraph-io/ristretto/v2/z"
+	"github.com/hypermodeinc/dgraph/v25/x"
+)
+
+const (
+	// sinkBatchSize is the maximum number of entries shipped at once.
+	sinkBatchSize = 512
+	// sinkFlushInterval is how often the entries buffered by a sink are shipped.
+	sinkFlushInterval = time.Second
+	sinkMinBackoff    = 100 * time.Millisecond
+	sinkMaxBackoff    = 30 * time.Second
+)
+
+// shippedEntry is an entry to be shipped, along with the line written to the audit log.
+type shippedEntry struct {
+	entry *logEntry
+	line  []byte
+}
+
+// shipper ships audit entries to a remote output.
+type shipper interface {
+	// ship ships the entries in order. It returns the number of entries shipped, which is less
+	// than len(entries) only if it returns an error.
+	ship(entries []shippedEntry) (int, error)
+	close() error
+}
+
+// permanentError is returned by a shipper for entries that would be rejected again if they were
+// shipped again. They are dropped instead of being retried.
+type permanentError struct {
+	error
+}
+
+// sink buffers the audit entries for a remote output, so that a slow or unavailable output
+// doesn't block the requests being audited. Entries are dropped from the sink while its buffer
+// is full, they are still written to the audit log files.
+type sink struct {
+	name    string
+	shipper shipper
+	ch      chan shippedEntry
+	closer  *z.Closer
+	// dropped is the number of entries dropped since the last warning about it.
+	dropped atomic.Uint64
+}
+
+func newSink(name string, s shipper, bufferSize int) *sink {
+	sk := &sink{
+		name:    name,
+		shipper: s,
+		ch:      make(chan shippedEntry, bufferSize),
+		closer:  z.NewCloser(1),
+	}
+	go sk.run()
+	return sk
+}
+
+// newSinks returns the sinks for the remote outputs in the config.
+func newSinks(conf *x.LoggerConf, ntype string) ([]*sink, error) {
+	var sinks []*sink
+	if conf.Syslog != "" {
+		s, err := newSyslogShipper(conf.Syslog, conf.SyslogTLS, ntype)
+		if err != nil {
+			return nil, err
+		}
+		sinks = append(sinks, newSink("syslog", s, conf.BufferSize))
+	}
+	if conf.OTLP != "" {
+		s, err := newOTLPShipper(conf.OTLP, conf.OTLPHeaders, ntype)
+		if err != nil {
+			closeSinks(sinks)
+			return nil, err
+		}
+		sinks = append(sinks, newSink("otlp", s, conf.BufferSize))
+	}
+	return sinks, nil
+}
+
+func closeSinks(sinks []*sink) {
+	for _, s := range sinks {
+		s.close()
+	}
+}
+
+// send buffers the entry to be shipped. It never blocks.
+func (s *sink) send(entry *logEntry, line []byte) {
+	select {
+	case s.ch <- shippedEntry{entry: entry, line: line}:
+	default:
+		s.dropped.Add(1)
+		ostats.Record(context.Background(), x.NumAuditDropped.M(1))
+	}
+}
+
+func (s *sink) run() {
+	defer s.closer.Done()
+	ticker := time.NewTicker(sinkFlushInterval)
+	defer ticker.Stop()
+
+	batch := make([]shippedEntry, 0, sinkBatchSize)
+	for {
+		select {
+		case e := <-s.ch:
+			batch = append(batch, e)
+			if len(batch) >= sinkBatchSize {
+				batch = s.flush(batch, true)
+			}
+		case <-ticker.C:
+			batch = s.flush(batch, true)
+			if dropped := s.dropped.Swap(0); dropped > 0 {
+				glog.Warningf("audit %s output dropped %d entries because its buffer was full",
+					s.name, dropped)
+			}
+		case <-s.closer.HasBeenClosed():
+			// Ship what is left in the buffer, without retrying.
+			for {
+				select {
+				case e := <-s.ch:
+					batch = append(batch, e)
+					if len(batch) >= sinkBatchSize {
+						batch = s.flush(batch, false)
+					}
+				default:
+					if batch = s.flush(batch, false); len(batch) > 0 {
+						glog.Errorf("audit %s output dropped %d entries on close", s.name,
+							len(batch))
+					}
+					return
+				}
+			}
+		}
+	}
+}
+
+// flush ships the batch, retrying with backoff until it is shipped or the sink is closed. It
+// returns the entries that couldn't be shipped, if any.
+func (s *sink) flush(batch []shippedEntry, retry bool) []shippedEntry {
+	backoff := sinkMinBackoff
+	for len(batch) > 0 {
+		n, err := s.shipper.ship(batch)
+		batch = batch[n:]
+		if err == nil {
+			break
+		}
+		var perr permanentError
+		if errors.As(err, &perr) {
+			glog.Errorf("audit %s output rejected %d entries: %v", s.name, len(batch), err)
+			batch = batch[:0]
+			break
+		}
+		glog.Warningf("unable to ship %d audit entries to the %s output, retrying in %s: %v",
+			len(batch), s.name, backoff, err)
+		if !retry {
+			return batch
+		}
+		select {
+		case <-time.After(backoff):
+		case <-s.closer.HasBeenClosed():
+			return batch
+		}
+		backoff = min(backoff*2, sinkMaxBackoff)
+	}
+	return batch[:0]
+}
+
+func (s *sink) close() {
+	s.closer.SignalAndWait()
+	if err := s.shipper.close(); err != nil {
+		glog.Warningf("error closing audit %s output: %v", s.name, err)
+	}
+}
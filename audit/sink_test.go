@@ -0,0 +1,204 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package audit
+
+import (
+	"bufio"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	collogspb "go.opentelemetry.io/proto/otlp/collector/logs/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+func testEntries(t *testing.T, n int) []shippedEntry {
+	entries := make([]shippedEntry, 0, n)
+	prevHash := ""
+	for i := 1; i <= n; i++ {
+		e := &logEntry{
+			Time:     "2026-01-02T15:04:05.000Z",
+			Endpoint: "/query",
+			Level:    "AUDIT",
+			User:     "alice",
+			Status:   "OK",
+			Seq:      uint64(i),
+			PrevHash: prevHash,
+		}
+		line, err := e.seal(nil)
+		require.NoError(t, err)
+		prevHash = e.Hash
+		entries = append(entries, shippedEntry{entry: e, line: line})
+	}
+	return entries
+}
+
+func TestSyslogShipper(t *testing.T) {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer ln.Close()
+
+	msgs := make(chan string, 10)
+	go func() {
+		conn, err := ln.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+		r := bufio.NewReader(conn)
+		for {
+			// Messages are framed with their length.
+			length, err := r.ReadString(' ')
+			if err != nil {
+				return
+			}
+			n, err := strconv.Atoi(strings.TrimSpace(length))
+			if err != nil {
+				return
+			}
+			msg := make([]byte, n)
+			if _, err := io.ReadFull(r, msg); err != nil {
+				return
+			}
+			msgs <- string(msg)
+		}
+	}()
+
+	s, err := newSyslogShipper("tcp://"+ln.Addr().String(), nil, NodeTypeAlpha)
+	require.NoError(t, err)
+	defer s.close()
+	entries := testEntries(t, 2)
+	n, err := s.ship(entries)
+	require.NoError(t, err)
+	require.Equal(t, 2, n)
+
+	for _, e := range entries {
+		msg := <-msgs
+		require.True(t, strings.HasPrefix(msg, "<110>1 2026-01-02T15:04:05.000Z "), msg)
+		require.Contains(t, msg, " dgraph-alpha ")
+		require.True(t, strings.HasSuffix(msg, " audit - "+strings.TrimSpace(string(e.line))),
+			msg)
+	}
+}
+
+func TestOTLPShipper(t *testing.T) {
+	var mu sync.Mutex
+	var reqs []*collogspb.ExportLogsServiceRequest
+	status := http.StatusOK
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.Equal(t, "application/x-protobuf", r.Header.Get("Content-Type"))
+		require.Equal(t, "Bearer token", r.Header.Get("Authorization"))
+		data, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		var req collogspb.ExportLogsServiceRequest
+		require.NoError(t, proto.Unmarshal(data, &req))
+		mu.Lock()
+		defer mu.Unlock()
+		reqs = append(reqs, &req)
+		w.WriteHeader(status)
+	}))
+	defer srv.Close()
+
+	headers, err := parseOTLPHeaders("Authorization: Bearer token")
+	require.NoError(t, err)
+	s, err := newOTLPShipper(srv.URL+"/v1/logs", headers, NodeTypeZero)
+	require.NoError(t, err)
+	entries := testEntries(t, 3)
+	n, err := s.ship(entries)
+	require.NoError(t, err)
+	require.Equal(t, 3, n)
+
+	require.Len(t, reqs, 1)
+	records := reqs[0].ResourceLogs[0].ScopeLogs[0].LogRecords
+	require.Len(t, records, 3)
+	require.Equal(t, strings.TrimSpace(string(entries[1].line)),
+		records[1].Body.GetStringValue())
+	require.Equal(t, uint64(time.Date(2026, 1, 2, 15, 4, 5, 0, time.UTC).UnixNano()),
+		records[1].TimeUnixNano)
+
+	// Overloaded collectors are retried, invalid requests are not.
+	setStatus := func(code int) {
+		mu.Lock()
+		defer mu.Unlock()
+		status = code
+	}
+	setStatus(http.StatusServiceUnavailable)
+	_, err = s.ship(entries)
+	require.Error(t, err)
+	require.False(t, errors.As(err, &permanentError{}))
+	setStatus(http.StatusBadRequest)
+	_, err = s.ship(entries)
+	require.True(t, errors.As(err, &permanentError{}))
+}
+
+// blockingShipper ships entries only once it is unblocked.
+type blockingShipper struct {
+	unblock chan struct{}
+	mu      sync.Mutex
+	shipped []uint64
+}
+
+func (s *blockingShipper) ship(entries []shippedEntry) (int, error) {
+	<-s.unblock
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, e := range entries {
+		s.shipped = append(s.shipped, e.entry.Seq)
+	}
+	return len(entries), nil
+}
+
+func (s *blockingShipper) close() error { return nil }
+
+func TestSinkDropsWhenFull(t *testing.T) {
+	shipper := &blockingShipper{unblock: make(chan struct{})}
+	s := newSink("test", shipper, 2)
+	entries := testEntries(t, 10)
+
+	// send never blocks, even though the shipper does.
+	done := make(chan struct{})
+	go func() {
+		for _, e := range entries {
+			s.send(e.entry, e.line)
+		}
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(10 * time.Second):
+		t.Fatal("send blocked on a full sink")
+	}
+	require.NotZero(t, s.dropped.Load())
+
+	close(shipper.unblock)
+	s.close()
+	require.NotEmpty(t, shipper.shipped)
+	require.Less(t, len(shipper.shipped), len(entries))
+	for i := 1; i < len(shipper.shipped); i++ {
+		require.Less(t, shipper.shipped[i-1], shipper.shipped[i])
+	}
+}
+
+func TestParseSinkConfigErrors(t *testing.T) {
+	for _, addr := range []string{"localhost:514", "http://localhost:514", "tcp://localhost"} {
+		_, _, err := parseSyslogAddr(addr)
+		require.Error(t, err, addr)
+	}
+	_, err := newSyslogShipper("tls://localhost:6514", nil, NodeTypeAlpha)
+	require.Error(t, err)
+	_, err = parseOTLPHeaders("Authorization")
+	require.Error(t, err)
+	_, err = newOTLPShipper("collector:4318", nil, NodeTypeAlpha)
+	require.Error(t, err)
+}
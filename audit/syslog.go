@@ -0,0 +1,165 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package audit
+
+import (
+	"bytes"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/url"
+	"os"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/dgraph-io/ristretto/v2/z"
+	"github.com/hypermodeinc/dgraph/v25/x"
+)
+
+const (
+	// syslogPriority is the priority of the messages, for the log audit facility (13) and the
+	// informational severity (6).
+	syslogPriority = 13*8 + 6
+	syslogTimeout  = 10 * time.Second
+)
+
+// syslogShipper ships the audit entries to a syslog server as RFC5424 messages. Messages sent
+// over TCP and TLS are framed with their length, as per RFC6587.
+type syslogShipper struct {
+	network  string
+	addr     string
+	tlsConf  *tls.Config
+	hostname string
+	appName  string
+	procId   string
+
+	conn net.Conn
+}
+
+// parseSyslogAddr returns the network and the address of a syslog server given as
+// tcp://host:port, udp://host:port or tls://host:port.
+func parseSyslogAddr(addr string) (string, string, error) {
+	u, err := url.Parse(addr)
+	if err != nil {
+		return "", "", errors.Wrapf(err, "invalid syslog address: %s", addr)
+	}
+	switch u.Scheme {
+	case "tcp", "udp", "tls":
+	default:
+		return "", "", errors.Errorf("invalid syslog address %s, the scheme must be one of "+
+			"tcp, udp or tls", addr)
+	}
+	if u.Host == "" || u.Port() == "" {
+		return "", "", errors.Errorf("invalid syslog address %s, expected %s://host:port",
+			addr, u.Scheme)
+	}
+	return u.Scheme, u.Host, nil
+}
+
+// readSyslogTLSConfig returns the TLS config to connect to a tls:// syslog server.
+func readSyslogTLSConfig(sf *z.SuperFlag) (*tls.Config, error) {
+	addr := sf.GetString("syslog")
+	if addr == "" {
+		return nil, nil
+	}
+	network, host, err := parseSyslogAddr(addr)
+	if err != nil || network != "tls" {
+		return nil, err
+	}
+	serverName, _, err := net.SplitHostPort(host)
+	if err != nil {
+		return nil, err
+	}
+	return x.GenerateClientTLSConfig(&x.TLSHelperConfig{
+		CertRequired:     true,
+		UseSystemCACerts: true,
+		RootCACert:       sf.GetPath("syslog-ca-cert"),
+		Cert:             sf.GetPath("syslog-client-cert"),
+		Key:              sf.GetPath("syslog-client-key"),
+		ServerName:       serverName,
+	})
+}
+
+func newSyslogShipper(addr string, tlsConf *tls.Config, ntype string) (*syslogShipper, error) {
+	network, host, err := parseSyslogAddr(addr)
+	if err != nil {
+		return nil, err
+	}
+	if network == "tls" && tlsConf == nil {
+		return nil, errors.Errorf("missing TLS config for syslog address: %s", addr)
+	}
+	hostname, err := os.Hostname()
+	if err != nil || hostname == "" {
+		hostname = "-"
+	}
+	return &syslogShipper{
+		network:  network,
+		addr:     host,
+		tlsConf:  tlsConf,
+		hostname: hostname,
+		appName:  "dgraph-" + ntype,
+		procId:   strconv.Itoa(os.Getpid()),
+	}, nil
+}
+
+func (s *syslogShipper) dial() error {
+	if s.conn != nil {
+		return nil
+	}
+	dialer := &net.Dialer{Timeout: syslogTimeout}
+	var err error
+	if s.network == "tls" {
+		s.conn, err = tls.DialWithDialer(dialer, "tcp", s.addr, s.tlsConf)
+	} else {
+		s.conn, err = dialer.Dial(s.network, s.addr)
+	}
+	return errors.Wrapf(err, "while connecting to syslog server %s", s.addr)
+}
+
+// format returns the entry as an RFC5424 message, framed for the transport.
+func (s *syslogShipper) format(e shippedEntry) []byte {
+	var msg bytes.Buffer
+	x.Check2(fmt.Fprintf(&msg, "<%d>1 %s %s %s %s audit - ", syslogPriority, e.entry.Time,
+		s.hostname, s.appName, s.procId))
+	x.Check2(msg.Write(bytes.TrimRight(e.line, "\n")))
+	if s.network == "udp" {
+		return msg.Bytes()
+	}
+	return append([]byte(strconv.Itoa(msg.Len())+" "), msg.Bytes()...)
+}
+
+func (s *syslogShipper) ship(entries []shippedEntry) (int, error) {
+	if err := s.dial(); err != nil {
+		return 0, err
+	}
+	for i, e := range entries {
+		if err := s.conn.SetWriteDeadline(time.Now().Add(syslogTimeout)); err != nil {
+			return i, s.reset(err)
+		}
+		if _, err := s.conn.Write(s.format(e)); err != nil {
+			return i, s.reset(err)
+		}
+	}
+	return len(entries), nil
+}
+
+// reset closes the connection after an error, so that it is dialed again on the next ship.
+func (s *syslogShipper) reset(err error) error {
+	_ = s.conn.Close()
+	s.conn = nil
+	return errors.Wrapf(err, "while writing to syslog server %s", s.addr)
+}
+
+func (s *syslogShipper) close() error {
+	if s.conn == nil {
+		return nil
+	}
+	err := s.conn.Close()
+	s.conn = nil
+	return err
+}
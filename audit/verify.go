@@ -0,0 +1,180 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package audit
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/aes"
+	"crypto/cipher"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// auditFileRe matches the audit logs written by x.LogWriter, the active one and the rotated
+// ones, which have the time of the rotation before the extension, e.g. alpha_audit_1_1.log,
+// alpha_audit_1_1-2006-01-02T15-04-05.000.log, alpha_audit_1_1.log.enc and
+// alpha_audit_1_1.log-2006-01-02T15-04-05.000.enc.
+var auditFileRe = regexp.MustCompile(
+	`^(.+?)(?:\.log)?(-\d{4}-\d{2}-\d{2}T\d{2}-\d{2}-\d{2}\.\d{3})?(?:\.log|\.enc)(?:\.gz)?$`)
+
+func runVerify() error {
+	var key []byte
+	var block cipher.Block
+	if keyFile := verifyCmd.Conf.GetString("encryption_key_file"); keyFile != "" {
+		var err error
+		if key, err = os.ReadFile(keyFile); err != nil {
+			return fmt.Errorf("unable to read encryption key: %w", err)
+		}
+		if block, err = aes.NewCipher(key); err != nil {
+			return fmt.Errorf("invalid encryption key: %w", err)
+		}
+	}
+
+	in := verifyCmd.Conf.GetString("in")
+	if in == "" {
+		return errors.New("--in is required")
+	}
+	chains, err := auditChains(in)
+	if err != nil {
+		return err
+	}
+
+	headDir := verifyCmd.Conf.GetString("head_dir")
+	if headDir == "" {
+		fmt.Println("--head_dir isn't set, the entries removed from the end of the logs " +
+			"can't be detected")
+	}
+
+	failed := false
+	for _, files := range chains {
+		v := &chainVerifier{key: key}
+		for _, file := range files {
+			if err := verifyFile(v, file, block); err != nil {
+				return fmt.Errorf("unable to verify %s: %w", file, err)
+			}
+		}
+		if headDir != "" {
+			last := files[len(files)-1]
+			head, err := readHead(headPath(headDir, last))
+			if err != nil {
+				return err
+			}
+			if head == nil {
+				v.errorf("%s: no chain head found in %s", last, headDir)
+			} else {
+				v.checkHead(last, head)
+			}
+		}
+		for _, e := range v.errs {
+			fmt.Println(e)
+		}
+		if len(v.errs) > 0 {
+			failed = true
+			fmt.Printf("%s: hash chain verification failed with %d errors in %d entries\n",
+				strings.Join(files, ", "), len(v.errs), v.entries)
+			continue
+		}
+		fmt.Printf("%s: verified %d entries, last entry %d has hash %s\n",
+			strings.Join(files, ", "), v.entries, v.seq, v.hash)
+	}
+	if failed {
+		return errors.New("audit logs have been tampered with")
+	}
+	return nil
+}
+
+// auditChains returns the files to verify, each chain in order. A directory is split in a chain
+// per node, with the rotated files ordered by the time of the rotation and the active file last.
+func auditChains(in string) ([][]string, error) {
+	stat, err := os.Stat(in)
+	if err != nil && !strings.Contains(in, ",") {
+		return nil, err
+	}
+	if err != nil || !stat.IsDir() {
+		return [][]string{strings.Split(in, ",")}, nil
+	}
+
+	entries, err := os.ReadDir(in)
+	if err != nil {
+		return nil, err
+	}
+	type auditFile struct {
+		path      string
+		rotatedAt string
+	}
+	byNode := make(map[string][]auditFile)
+	for _, entry := range entries {
+		m := auditFileRe.FindStringSubmatch(entry.Name())
+		if entry.IsDir() || m == nil {
+			continue
+		}
+		rotatedAt := m[2]
+		if rotatedAt == "" {
+			// the active file is the latest one
+			rotatedAt = "~"
+		}
+		byNode[m[1]] = append(byNode[m[1]],
+			auditFile{path: filepath.Join(in, entry.Name()), rotatedAt: rotatedAt})
+	}
+	if len(byNode) == 0 {
+		return nil, fmt.Errorf("no audit files found in %s", in)
+	}
+
+	nodes := make([]string, 0, len(byNode))
+	for node := range byNode {
+		nodes = append(nodes, node)
+	}
+	sort.Strings(nodes)
+	chains := make([][]string, 0, len(nodes))
+	for _, node := range nodes {
+		files := byNode[node]
+		sort.Slice(files, func(i, j int) bool { return files[i].rotatedAt < files[j].rotatedAt })
+		chain := make([]string, 0, len(files))
+		for _, f := range files {
+			chain = append(chain, f.path)
+		}
+		chains = append(chains, chain)
+	}
+	return chains, nil
+}
+
+// verifyFile verifies the entries of an audit file, which may be compressed and encrypted.
+func verifyFile(v *chainVerifier, file string, block cipher.Block) error {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return err
+	}
+	name := strings.TrimSuffix(file, ".gz")
+	if name != file {
+		gz, err := gzip.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return err
+		}
+		if data, err = io.ReadAll(gz); err != nil {
+			return err
+		}
+	}
+	if strings.HasSuffix(name, ".enc") {
+		if block == nil {
+			return errors.New("--encryption_key_file is required for encrypted audit files")
+		}
+		var out bytes.Buffer
+		if len(data) > 0 {
+			if err := decrypt(bytes.NewReader(data), &out, block, int64(len(data))); err != nil {
+				return err
+			}
+		}
+		data = out.Bytes()
+	}
+	return v.verify(file, bytes.NewReader(data))
+}
@@ -38,6 +38,9 @@ var LsBackup x.SubCommand
 
 var ExportBackup x.SubCommand
 
+// Backup is the sub-command used to prune and verify the backups in a folder.
+var Backup x.SubCommand
+
 var opt struct {
 	backupId    string
 	badger      string
@@ -50,12 +53,15 @@ var opt struct {
 	format      string
 	verbose     bool
 	upgrade     bool // used by export backup command.
+	retention   worker.RetentionPolicy
+	dryRun      bool
 }
 
 func init() {
 	initRestore()
 	initBackupLs()
 	initExportBackup()
+	initBackup()
 }
 
 func initRestore() {
@@ -168,6 +174,8 @@ func runRestoreCmd() error {
 		return err
 	}
 	opt.key = keys.EncKey
+	// The backups encrypted with the old keys are restored with the key ring.
+	x.WorkerConfig.EncryptionKeyRing = keys.EncKeyRing
 	fmt.Println("Restoring backups from:", opt.location)
 	fmt.Println("Writing postings to:", opt.pdir)
 
@@ -294,6 +302,126 @@ func runLsbackupCmd() error {
 	return nil
 }
 
+func initBackup() {
+	Backup.Cmd = &cobra.Command{
+		Use:         "backup",
+		Short:       "Prune and verify the backups in a given location",
+		Args:        cobra.NoArgs,
+		Annotations: map[string]string{"group": "tool"},
+	}
+	Backup.Cmd.SetHelpTemplate(x.NonRootTemplate)
+	Backup.Cmd.PersistentFlags().StringVarP(&opt.location, "location", "l", "",
+		"Sets the source location URI (required).")
+	_ = Backup.Cmd.MarkPersistentFlagRequired("location")
+
+	cmdPrune := &cobra.Command{
+		Use:   "prune",
+		Short: "Delete the backup series which the retention policy doesn't keep",
+		Long: `Delete the backup series which the retention policy doesn't keep, along with their
+manifest entries. A series is the full backup and the incremental backups taken on top of it. It's
+kept if any of the rules keeps it, and the latest series is always kept.`,
+		Args: cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := runPruneCmd(); err != nil {
+				fmt.Fprintln(os.Stderr, err)
+				os.Exit(1)
+			}
+		},
+	}
+	flag := cmdPrune.Flags()
+	flag.IntVar(&opt.retention.KeepSeries, "keep-series", 0,
+		"Number of latest backup series to keep. The latest series is always kept. At least one "+
+			"of --keep-series, --keep-days and --keep-weeks is needed to delete backups.")
+	flag.IntVar(&opt.retention.KeepDays, "keep-days", 0,
+		"Keep the series with the latest backup of each of this many last days.")
+	flag.IntVar(&opt.retention.KeepWeeks, "keep-weeks", 0,
+		"Keep the series with the latest backup of each of this many last weeks.")
+	flag.BoolVar(&opt.dryRun, "dry-run", false,
+		"Only print which series would be deleted, without deleting them.")
+	Backup.Cmd.AddCommand(cmdPrune)
+
+	cmdVerify := &cobra.Command{
+		Use:   "verify",
+		Short: "Verify every backup file against the checksum recorded in its manifest",
+		Args:  cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := runVerifyCmd(); err != nil {
+				fmt.Fprintln(os.Stderr, err)
+				os.Exit(1)
+			}
+		},
+	}
+	Backup.Cmd.AddCommand(cmdVerify)
+}
+
+func runPruneCmd() error {
+	series, err := worker.PruneBackups(opt.location, nil, opt.retention, opt.dryRun)
+	if err != nil {
+		return fmt.Errorf("while pruning backups: %w", err)
+	}
+
+	type seriesEntry struct {
+		BackupId string   `json:"backup_id"`
+		Paths    []string `json:"paths"`
+		Kept     bool     `json:"kept"`
+		Reason   string   `json:"reason,omitempty"`
+	}
+	var output []seriesEntry
+	for _, s := range series {
+		se := seriesEntry{BackupId: s.BackupId, Kept: s.Keep, Reason: s.Reason}
+		for _, m := range s.Manifests {
+			se.Paths = append(se.Paths, m.Path)
+		}
+		output = append(output, se)
+	}
+	b, err := json.MarshalIndent(output, "", "\t")
+	if err != nil {
+		return err
+	}
+	_, _ = os.Stdout.Write(b)
+	fmt.Println()
+	return nil
+}
+
+func runVerifyCmd() error {
+	verifications, err := worker.VerifyBackups(opt.location, nil)
+	if err != nil {
+		return fmt.Errorf("while verifying backups: %w", err)
+	}
+
+	type verifyEntry struct {
+		Path       string            `json:"path"`
+		BackupId   string            `json:"backup_id"`
+		BackupNum  uint64            `json:"backup_num"`
+		Files      map[string]string `json:"files"`
+		Restorable bool              `json:"restorable"`
+	}
+	var output []verifyEntry
+	broken := 0
+	for _, v := range verifications {
+		output = append(output, verifyEntry{
+			Path:       v.Manifest.Path,
+			BackupId:   v.Manifest.BackupId,
+			BackupNum:  v.Manifest.BackupNum,
+			Files:      v.Files,
+			Restorable: v.Restorable,
+		})
+		if !v.Restorable {
+			broken++
+		}
+	}
+	b, err := json.MarshalIndent(output, "", "\t")
+	if err != nil {
+		return err
+	}
+	_, _ = os.Stdout.Write(b)
+	fmt.Println()
+	if broken > 0 {
+		return fmt.Errorf("%d of %d backups can't be restored", broken, len(verifications))
+	}
+	return nil
+}
+
 func initExportBackup() {
 	ExportBackup.Cmd = &cobra.Command{
 		Use:   "export_backup",
@@ -392,6 +520,8 @@ func runExportBackup() error {
 		return err
 	}
 	opt.key = keys.EncKey
+	// The backups encrypted with the old keys are restored with the key ring.
+	x.WorkerConfig.EncryptionKeyRing = keys.EncKeyRing
 	if opt.format != "json" && opt.format != "rdf" {
 		return fmt.Errorf("invalid format %s", opt.format)
 	}
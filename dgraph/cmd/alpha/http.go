@@ -277,6 +277,18 @@ func queryHandler(w http.ResponseWriter, r *http.Request) {
 		if isReadOnly {
 			req.ReadOnly = true
 		}
+
+		// If maxStaleness is set, run this as a readonly query that accepts results stale by
+		// up to that long.
+		maxStaleness, err := parseDuration(r, "maxStaleness")
+		if err != nil {
+			x.SetStatus(w, x.ErrorInvalidRequest, err.Error())
+			return
+		}
+		if maxStaleness > 0 {
+			req.ReadOnly = true
+			ctx = x.AttachMaxStaleness(ctx, maxStaleness)
+		}
 	}
 
 	// If rdf is set true, then response will be in rdf format.
@@ -155,7 +155,11 @@ they form a Raft group and provide synchronous replication.
 			"Provides an optional Raft Group ID that this Alpha would indicate to Zero to join.").
 		Flag("learner",
 			`Make this Alpha a "learner" node. In learner mode, this Alpha will not participate `+
-				"in Raft elections. This can be used to achieve a read-only replica.").
+				"in Raft elections. This can be used to achieve a read-only replica, which serves "+
+				"the best-effort queries and the queries with a maxStaleness bound.").
+		Flag("zone",
+			"The zone, like a datacenter or a region, this Alpha runs in. Alphas read from the "+
+				"members of the other groups in their own zone first.").
 		Flag("snapshot-after-entries",
 			"Create a new Raft snapshot after N number of Raft entries. The lower this number, "+
 				"the more frequent snapshot creation will be. Snapshots are created only if both "+
@@ -249,6 +253,15 @@ they form a Raft group and provide synchronous replication.
 			"The path to client key file for TLS encryption.").
 		String())
 
+	flag.String("archive", worker.ArchiveDefaults, z.NewSuperFlagHelp(worker.ArchiveDefaults).
+		Head("Continuous archiving options, for point-in-time recovery").
+		Flag("dest",
+			"The location to archive the committed changes to, like the backups. A restore "+
+				"replays them on top of the latest backup at the same location.").
+		Flag("frequency",
+			"How often the leader of each group archives the changes committed since.").
+		String())
+
 	flag.String("audit", worker.AuditDefaults, z.NewSuperFlagHelp(worker.AuditDefaults).
 		Head("Audit options").
 		Flag("output",
@@ -263,6 +276,28 @@ they form a Raft group and provide synchronous replication.
 			"The number of days audit logs will be preserved.").
 		Flag("size",
 			"The audit log max size in MB after which it will be rolled over.").
+		Flag("syslog",
+			`[tcp://host:port, udp://host:port, tls://host:port] The syslog server the audit
+			logs are shipped to, as RFC5424 messages.`).
+		Flag("syslog-ca-cert",
+			"The path to the CA certificate used to verify a tls:// syslog server.").
+		Flag("syslog-client-cert",
+			"The path to the client certificate used to connect to a tls:// syslog server.").
+		Flag("syslog-client-key",
+			"The path to the client key used to connect to a tls:// syslog server.").
+		Flag("otlp",
+			"The URL of the OTLP/HTTP logs endpoint the audit logs are shipped to, like "+
+				"http://collector:4318/v1/logs.").
+		Flag("otlp-headers",
+			"Comma separated list of key:value headers sent to the OTLP endpoint.").
+		Flag("buffer",
+			`The number of audit entries buffered for each of the syslog and OTLP outputs. New
+			entries are dropped from an output while its buffer is full, they are still written
+			to the audit log files.`).
+		Flag("head-dir",
+			`The directory the last entry of the hash chain of the audit logs is recorded in, to
+			detect the entries removed from the end of the logs. It should be out of reach of
+			whoever can write the logs. The directory of the logs is used if it's empty.`).
 		String())
 
 	flag.String("feature-flags", worker.FeatureFlagsDefaults, z.NewSuperFlagHelp(worker.FeatureFlagsDefaults).
@@ -458,7 +493,8 @@ func serveGRPC(l net.Listener, tlsCfg *tls.Config, closer *z.Closer) {
 		grpc.MaxSendMsgSize(x.GrpcMaxSize),
 		grpc.MaxConcurrentStreams(1000),
 		grpc.StatsHandler(&ocgrpc.ServerHandler{}),
-		grpc.UnaryInterceptor(audit.AuditRequestGRPC),
+		grpc.ChainUnaryInterceptor(x.CertIdentityUnaryInterceptor, audit.AuditRequestGRPC),
+		grpc.StreamInterceptor(x.CertIdentityStreamInterceptor),
 	}
 	if tlsCfg != nil {
 		tlsCfg.NextProtos = []string{"h2"}
@@ -696,6 +732,7 @@ func run() {
 		AuthToken:          security.GetString("token"),
 		Audit:              conf,
 		ChangeDataConf:     Alpha.Conf.GetString("cdc"),
+		ArchiveConf:        Alpha.Conf.GetString("archive"),
 		TypeFilterUidLimit: x.Config.Limit.GetUint64("type-filter-uid-limit"),
 	}
 
@@ -750,6 +787,8 @@ func run() {
 		TLSServerConfig:     tlsServerConf,
 		AclJwtAlg:           keys.AclJwtAlg,
 		AclPublicKey:        keys.AclPublicKey,
+		AclOidc:             keys.AclOidc,
+		AclCertIdentity:     keys.AclCertIdentity,
 		Audit:               opts.Audit != nil,
 		Badger:              bopts,
 	}
@@ -759,6 +798,8 @@ func run() {
 	z.SetTmpDir(x.WorkerConfig.TmpDir)
 
 	x.WorkerConfig.EncryptionKey = keys.EncKey
+	x.WorkerConfig.EncryptionKeyRing = keys.EncKeyRing
+	x.WorkerConfig.FieldKeys = keys.FieldKeys
 
 	setupCustomTokenizers()
 	x.Config.PortOffset = Alpha.Conf.GetInt("port_offset")
@@ -201,6 +201,7 @@ EOF
       1 dgraph.graphql.schema_history
       1 dgraph.graphql.xid
       1 dgraph.password
+      1 dgraph.rule.filter
       1 dgraph.rule.permission
       1 dgraph.rule.predicate
       1 dgraph.type
@@ -219,6 +219,14 @@ func (s *Server) AssignIds(ctx context.Context, num *pb.Num) (*pb.AssignedIds, e
 			}
 			span.SetAttributes(attribute.String("tablet", "predicate"))
 			span.AddEvent(fmt.Sprintf("Zero leader leasing %d ids", num.GetVal()))
+			if num.GetType() == pb.Num_UID {
+				if ns, err := x.ExtractNamespace(ctx); err == nil {
+					var ok bool
+					if reply, ok, err = s.leaseUidsWithQuota(ctx, ns, num); ok {
+						return err
+					}
+				}
+			}
 			reply, err = s.lease(ctx, num)
 			return err
 		}
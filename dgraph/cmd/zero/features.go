@@ -0,0 +1,132 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package zero
+
+import (
+	"context"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/dgraph-io/dgo/v250/protos/api"
+	"github.com/hypermodeinc/dgraph/v25/conn"
+	"github.com/hypermodeinc/dgraph/v25/protos/pb"
+	"github.com/hypermodeinc/dgraph/v25/x"
+)
+
+// proposalFeature returns the feature set by the proposal, if any. Only the proposals made on
+// behalf of the users are checked, so that the members keep being tracked while the cluster is
+// downgraded.
+func proposalFeature(p *pb.ZeroProposal) *x.Feature {
+	switch {
+	case p.Quota != nil:
+		return &x.FeatureNamespaceQuotas
+	case p.Placement != nil:
+		return &x.FeatureTabletPlacement
+	case p.Replication != nil:
+		return &x.FeatureReplication
+	case p.Schedule != nil:
+		return &x.FeatureJobSchedules
+	case p.Decommission != nil:
+		return &x.FeatureDecommission
+	}
+	return nil
+}
+
+// checkFeature returns an error if a member of the cluster doesn't support the feature.
+func (s *Server) checkFeature(f x.Feature) error {
+	s.RLock()
+	defer s.RUnlock()
+	return f.Check(s.state.FeatureVersion())
+}
+
+// checkJoin returns an error if the node supports a lower feature version than the one the
+// cluster was finalized at.
+func (s *Server) checkJoin(addr string, featureVersion uint32) error {
+	s.RLock()
+	defer s.RUnlock()
+	if finalized := s.state.GetFinalizedFeatureVersion(); featureVersion < finalized {
+		return errors.Errorf("OLD_VERSION: Node %s supports feature version %d, but the cluster "+
+			"was upgraded to feature version %d", addr, featureVersion, finalized)
+	}
+	return nil
+}
+
+// FinalizeUpgrade finalizes the upgrade of the cluster, once every member supports the same
+// feature version. The members supporting a lower version can't join the cluster after it.
+func (s *Server) FinalizeUpgrade(ctx context.Context, _ *api.Payload) (*pb.Status, error) {
+	if !s.Node.AmLeader() {
+		return &pb.Status{Code: 1, Msg: x.Error}, errNotLeader
+	}
+	s.RLock()
+	version, safe := s.state.SafeToFinalize()
+	finalized := s.state.GetFinalizedFeatureVersion()
+	s.RUnlock()
+	if !safe {
+		if version <= finalized {
+			return nil, errors.Errorf("The cluster is already finalized at feature version %d",
+				finalized)
+		}
+		return nil, errors.Errorf("The members of the cluster don't support the same feature " +
+			"version yet")
+	}
+	err := s.Node.proposeAndWait(ctx, &pb.ZeroProposal{FinalizedFeatureVersion: version})
+	if err != nil {
+		return nil, err
+	}
+	return &pb.Status{Msg: x.Success}, nil
+}
+
+// applyFinalizedFeatureVersion records the feature version the cluster was upgraded to.
+func (n *node) applyFinalizedFeatureVersion(version uint32) {
+	n.server.AssertLock()
+	if version > n.server.state.FinalizedFeatureVersion {
+		n.server.state.FinalizedFeatureVersion = version
+		glog.Infof("Finalized the upgrade of the cluster to feature version %d", version)
+	}
+}
+
+// updateFeatureVersion records the release and the feature version of this Zero in the
+// membership state, after it was restarted with another release. The followers send it to the
+// leader, like the alphas do.
+func (n *node) updateFeatureVersion() {
+	s := n.server
+	s.RLock()
+	m, ok := s.state.Zeros[n.Id]
+	var leader *pb.Member
+	for _, z := range s.state.Zeros {
+		if z.Leader {
+			leader = z
+		}
+	}
+	if !ok || (m.Version == x.Version() && m.FeatureVersion == x.FeatureVersion) {
+		s.RUnlock()
+		return
+	}
+	m = proto.Clone(m).(*pb.Member)
+	s.RUnlock()
+
+	m.Version, m.FeatureVersion = x.Version(), x.FeatureVersion
+	ctx, cancel := context.WithTimeout(n.ctx, 10*time.Second)
+	defer cancel()
+	var err error
+	switch {
+	case n.AmLeader():
+		m.Leader = true
+		err = n.proposeAndWait(ctx, &pb.ZeroProposal{Member: m})
+	case leader != nil:
+		var pl *conn.Pool
+		if pl, err = conn.GetPools().Get(leader.Addr); err == nil {
+			group := &pb.Group{Members: map[uint64]*pb.Member{m.Id: m}}
+			_, err = pb.NewZeroClient(pl.Get()).UpdateMembership(ctx, group)
+		}
+	}
+	if err != nil {
+		glog.Warningf("While updating the feature version of Zero %#x: %v", n.Id, err)
+	}
+}
@@ -0,0 +1,53 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package zero
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hypermodeinc/dgraph/v25/protos/pb"
+	"github.com/hypermodeinc/dgraph/v25/x"
+)
+
+func TestFeatureNegotiation(t *testing.T) {
+	state := &pb.MembershipState{
+		Zeros: map[uint64]*pb.Member{1: {Id: 1, FeatureVersion: x.FeatureVersion}},
+		Groups: map[uint32]*pb.Group{1: {Members: map[uint64]*pb.Member{
+			2: {Id: 2, GroupId: 1, FeatureVersion: x.FeatureVersion},
+			// An alpha which wasn't upgraded yet.
+			3: {Id: 3, GroupId: 1},
+		}}},
+	}
+	s := &Server{state: state}
+
+	// The features are refused until every member supports them.
+	require.Equal(t, uint32(0), state.FeatureVersion())
+	p := &pb.ZeroProposal{Placement: &pb.TabletPlacement{Predicate: "0-name"}}
+	require.ErrorContains(t, s.checkFeature(*proposalFeature(p)), "Upgrade every member")
+	require.Nil(t, proposalFeature(&pb.ZeroProposal{Member: &pb.Member{Id: 3}}))
+	_, safe := state.SafeToFinalize()
+	require.False(t, safe)
+
+	// The predicates are moved in a single round.
+	require.Equal(t, 1, s.moveRounds())
+
+	state.Groups[1].Members[3].FeatureVersion = x.FeatureVersion
+	require.NoError(t, s.checkFeature(*proposalFeature(p)))
+	require.Equal(t, maxMoveRounds, s.moveRounds())
+	version, safe := state.SafeToFinalize()
+	require.True(t, safe)
+	require.Equal(t, x.FeatureVersion, version)
+
+	// Once finalized, the members of the older releases can't join.
+	require.NoError(t, s.checkJoin("alpha4:7080", 0))
+	state.FinalizedFeatureVersion = version
+	require.ErrorContains(t, s.checkJoin("alpha4:7080", 0), "OLD_VERSION")
+	require.NoError(t, s.checkJoin("alpha4:7080", x.FeatureVersion))
+	_, safe = state.SafeToFinalize()
+	require.False(t, safe)
+}
@@ -205,6 +205,75 @@ func (st *state) moveTablet(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
+// splitTablet can be used to split a tablet across groups. It takes in tablet and the comma
+// separated groups to serve the new sub-tablets as argument.
+func (st *state) splitTablet(w http.ResponseWriter, r *http.Request) {
+	x.AddCorsHeaders(w)
+	if r.Method == "OPTIONS" {
+		return
+	}
+	if r.Method != http.MethodGet {
+		w.WriteHeader(http.StatusBadRequest)
+		x.SetStatus(w, x.ErrorInvalidMethod, "Invalid method")
+		return
+	}
+
+	if !st.node.AmLeader() {
+		w.WriteHeader(http.StatusBadRequest)
+		x.SetStatus(w, x.ErrorInvalidRequest,
+			"This Zero server is not the leader. Re-run command on leader.")
+		return
+	}
+
+	namespace := strings.TrimSpace(r.URL.Query().Get("namespace"))
+	ns := x.RootNamespace
+	if namespace != "" {
+		var err error
+		if ns, err = strconv.ParseUint(namespace, 0, 64); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			x.SetStatus(w, x.ErrorInvalidRequest, "Invalid namespace in query parameter.")
+			return
+		}
+	}
+
+	tablet := r.URL.Query().Get("tablet")
+	if len(tablet) == 0 {
+		w.WriteHeader(http.StatusBadRequest)
+		x.SetStatus(w, x.ErrorInvalidRequest, "tablet is a mandatory query parameter")
+		return
+	}
+
+	var groups []uint32
+	for _, group := range strings.Split(r.URL.Query().Get("groups"), ",") {
+		gid, err := strconv.ParseUint(strings.TrimSpace(group), 0, 32)
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			x.SetStatus(w, x.ErrorInvalidRequest,
+				"Query parameter 'groups' should contain comma separated group ids.")
+			return
+		}
+		groups = append(groups, uint32(gid))
+	}
+
+	resp, err := st.zero.SplitTablet(
+		context.Background(),
+		&pb.SplitTabletRequest{Namespace: ns, Tablet: tablet, Groups: groups},
+	)
+	if err != nil {
+		if resp.GetMsg() == x.ErrorInvalidRequest {
+			w.WriteHeader(http.StatusBadRequest)
+			x.SetStatus(w, x.ErrorInvalidRequest, err.Error())
+		} else {
+			w.WriteHeader(http.StatusInternalServerError)
+			x.SetStatus(w, x.Error, err.Error())
+		}
+		return
+	}
+	if _, err = fmt.Fprint(w, resp.GetMsg()); err != nil {
+		glog.Warningf("Error while writing response: %+v", err)
+	}
+}
+
 func (st *state) getState(w http.ResponseWriter, r *http.Request) {
 	x.AddCorsHeaders(w)
 	w.Header().Set("Content-Type", "application/json")
@@ -221,6 +290,7 @@ func (st *state) getState(w http.ResponseWriter, r *http.Request) {
 		x.SetStatus(w, x.ErrorNoData, "No membership state found.")
 		return
 	}
+	mstate.RedactSchedules()
 
 	m := protojson.MarshalOptions{EmitUnpopulated: true}
 	buf, err := m.Marshal(mstate)
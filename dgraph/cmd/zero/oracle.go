@@ -339,6 +339,44 @@ func (s *Server) proposeTxn(ctx context.Context, src *api.TxnContext) error {
 	return nil
 }
 
+// checkCommitPreds returns an error if the transaction must be aborted because one of the
+// predicates it mutated is being moved or split, or was moved or split since it started.
+func (s *Server) checkCommitPreds(src *api.TxnContext) error {
+	for _, pkey := range src.Preds {
+		splits := strings.SplitN(pkey, "-", 2)
+		if len(splits) < 2 {
+			return errors.Errorf("Unable to find group id in %s", pkey)
+		}
+		gid, err := strconv.Atoi(splits[0])
+		if err != nil {
+			return errors.Wrapf(err, "unable to parse group id from %s", pkey)
+		}
+		pred := splits[1]
+		if strings.Contains(pred, hnsw.VecKeyword) {
+			pred = pred[0:strings.Index(pred, hnsw.VecKeyword)]
+		}
+		tablet := s.ServingTablet(pred)
+		if tablet == nil {
+			return errors.Errorf("Tablet for %s is nil", pred)
+		}
+		if tablet.GroupId != uint32(gid) && !servesShard(tablet, uint32(gid)) {
+			return errors.Errorf("Mutation done in group: %d. Predicate %s assigned to %d",
+				gid, pred, tablet.GroupId)
+		}
+		if s.isBlocked(pred) {
+			return errors.Errorf("Commits on predicate %s are blocked due to predicate move", pred)
+		}
+		// A split keeps the tablet in its group, so the group of the mutation still matches. The
+		// mutations of the transaction were done before the split, the ones of the UIDs now
+		// served by another group would be lost.
+		if src.StartTs < tablet.MoveTs {
+			return errors.Errorf("StartTs: %d is from before MoveTs: %d for pred: %q",
+				src.StartTs, tablet.MoveTs, pred)
+		}
+	}
+	return nil
+}
+
 func (s *Server) commit(ctx context.Context, src *api.TxnContext) error {
 	span := trace.SpanFromContext(ctx)
 	span.SetAttributes(attribute.Int64("startTs", int64(src.StartTs)))
@@ -356,36 +394,7 @@ func (s *Server) commit(ctx context.Context, src *api.TxnContext) error {
 		return s.proposeTxn(ctx, src)
 	}
 
-	checkPreds := func() error {
-		// Check if any of these tablets is being moved. If so, abort the transaction.
-		for _, pkey := range src.Preds {
-			splits := strings.SplitN(pkey, "-", 2)
-			if len(splits) < 2 {
-				return errors.Errorf("Unable to find group id in %s", pkey)
-			}
-			gid, err := strconv.Atoi(splits[0])
-			if err != nil {
-				return errors.Wrapf(err, "unable to parse group id from %s", pkey)
-			}
-			pred := splits[1]
-			if strings.Contains(pred, hnsw.VecKeyword) {
-				pred = pred[0:strings.Index(pred, hnsw.VecKeyword)]
-			}
-			tablet := s.ServingTablet(pred)
-			if tablet == nil {
-				return errors.Errorf("Tablet for %s is nil", pred)
-			}
-			if tablet.GroupId != uint32(gid) {
-				return errors.Errorf("Mutation done in group: %d. Predicate %s assigned to %d",
-					gid, pred, tablet.GroupId)
-			}
-			if s.isBlocked(pred) {
-				return errors.Errorf("Commits on predicate %s are blocked due to predicate move", pred)
-			}
-		}
-		return nil
-	}
-	if err := checkPreds(); err != nil {
+	if err := s.checkCommitPreds(src); err != nil {
 		span.SetAttributes(attribute.Bool("abort", true))
 		src.Aborted = true
 		return s.proposeTxn(ctx, src)
@@ -504,6 +513,18 @@ func (s *Server) Timestamps(ctx context.Context, num *pb.Num) (*pb.AssignedIds,
 	}
 
 	num.Type = pb.Num_TXN_TS
+	if num.GetBump() {
+		// Bump the timestamps up to num.Val, like a secondary cluster does for the data
+		// replicated at the timestamps of its primary.
+		s.leaseLock.Lock()
+		cur := s.nextUint[pb.Num_TXN_TS] - 1
+		s.leaseLock.Unlock()
+		if cur >= num.Val {
+			return &pb.AssignedIds{StartId: cur, EndId: cur}, nil
+		}
+		num.Val -= cur
+		num.Bump = false
+	}
 	reply, err := s.lease(ctx, num)
 	span.AddEvent(fmt.Sprintf("Response: %+v, Error: %v", reply, err))
 
@@ -0,0 +1,43 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package zero
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dgraph-io/dgo/v250/protos/api"
+	"github.com/hypermodeinc/dgraph/v25/protos/pb"
+)
+
+func TestCheckCommitPreds(t *testing.T) {
+	s := testPlacementServer(nil,
+		&pb.Tablet{GroupId: 1, Predicate: "0-name", MoveTs: 10, Shards: []uint32{1, 2}},
+		&pb.Tablet{GroupId: 3, Predicate: "0-age", MoveTs: 20},
+	)
+	s.blockCommitsOn = new(sync.Map)
+
+	// A transaction started before the split mutated the UIDs now served by group 2 in group 1.
+	err := s.checkCommitPreds(&api.TxnContext{StartTs: 5, Preds: []string{"1-0-name"}})
+	require.ErrorContains(t, err, "StartTs: 5 is from before MoveTs: 10")
+	require.NoError(t, s.checkCommitPreds(&api.TxnContext{StartTs: 11,
+		Preds: []string{"1-0-name", "2-0-name"}}))
+
+	// A transaction started before the move, like the ones that mutated the old group.
+	err = s.checkCommitPreds(&api.TxnContext{StartTs: 15, Preds: []string{"3-0-age"}})
+	require.ErrorContains(t, err, "StartTs: 15 is from before MoveTs: 20")
+	err = s.checkCommitPreds(&api.TxnContext{StartTs: 25, Preds: []string{"1-0-age"}})
+	require.ErrorContains(t, err, "Predicate 0-age assigned to 3")
+
+	unblock := s.blockTablet("0-age")
+	err = s.checkCommitPreds(&api.TxnContext{StartTs: 25, Preds: []string{"3-0-age"}})
+	require.ErrorContains(t, err, "blocked due to predicate move")
+	unblock()
+	require.NoError(t, s.checkCommitPreds(&api.TxnContext{StartTs: 25,
+		Preds: []string{"3-0-age"}}))
+}
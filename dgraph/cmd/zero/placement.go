@@ -0,0 +1,333 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package zero
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/hypermodeinc/dgraph/v25/protos/pb"
+	"github.com/hypermodeinc/dgraph/v25/x"
+)
+
+// The placement policies, in the order they are tried by default.
+const placementDefaults = "pin,colocation,size"
+
+// minLoadDiff is the smallest difference, in reads and writes per second, between the loads of
+// two groups that the load policy balances.
+const minLoadDiff = 10
+
+// placementPolicy chooses a tablet to move to another group. Zero tries its policies in order
+// every rebalance interval, and moves the tablet chosen by the first one that chooses one.
+type placementPolicy interface {
+	name() string
+	// chooseTablet returns the tablet to move from srcGroup to dstGroup, or an empty predicate.
+	// It's called with the read lock of the server held.
+	chooseTablet(s *Server) (predicate string, srcGroup, dstGroup uint32)
+}
+
+// parsePlacementPolicies parses the comma separated names of the placement policies.
+func parsePlacementPolicies(names string) ([]placementPolicy, error) {
+	var policies []placementPolicy
+	for _, name := range strings.Split(names, ",") {
+		switch strings.TrimSpace(name) {
+		case "pin":
+			policies = append(policies, pinPolicy{})
+		case "colocation":
+			policies = append(policies, colocationPolicy{})
+		case "size":
+			policies = append(policies, balancePolicy{policy: "size",
+				metric: func(tab *pb.Tablet) float64 { return float64(tab.OnDiskBytes) }})
+		case "load":
+			policies = append(policies, balancePolicy{policy: "load", minDiff: minLoadDiff,
+				metric: func(tab *pb.Tablet) float64 { return tab.ReadsPerSec + tab.WritesPerSec }})
+		case "":
+		default:
+			return nil, errors.Errorf("unknown placement policy %q, expected one of: "+
+				"pin, colocation, size, load", name)
+		}
+	}
+	return policies, nil
+}
+
+// movable returns whether the placement policies may move the tablet around. Reserved
+// predicates always stay in group 1, and a split predicate is spread out already.
+func movable(tab *pb.Tablet) bool {
+	return !x.IsReservedPredicate(tab.Predicate) && len(tab.Shards) == 0
+}
+
+// acceptsTablets returns whether tablets may be placed in the group: it has a leader, and it isn't
+// being emptied to be decommissioned.
+func (s *Server) acceptsTablets(gid uint32) bool {
+	return s.hasLeader(gid) && !s.state.Groups[gid].GetDecommissioning()
+}
+
+// pinPolicy moves the pinned tablets to their groups.
+type pinPolicy struct{}
+
+func (pinPolicy) name() string { return "pin" }
+
+func (pinPolicy) chooseTablet(s *Server) (string, uint32, uint32) {
+	for pred, p := range s.state.GetPlacements() {
+		tab := s.servingTablet(pred)
+		if tab == nil || !movable(tab) || p.PinnedGroup == 0 || tab.GroupId == p.PinnedGroup {
+			continue
+		}
+		if _, ok := s.state.Groups[p.PinnedGroup]; !ok || !s.acceptsTablets(p.PinnedGroup) {
+			continue
+		}
+		return pred, tab.GroupId, p.PinnedGroup
+	}
+	return "", 0, 0
+}
+
+// colocationPolicy moves the tablets of a colocation to the group serving most of it, or to the
+// group a tablet of the colocation is pinned to.
+type colocationPolicy struct{}
+
+func (colocationPolicy) name() string { return "colocation" }
+
+func (colocationPolicy) chooseTablet(s *Server) (string, uint32, uint32) {
+	placements := s.state.GetPlacements()
+	type colocation struct {
+		pinned  uint32
+		size    map[uint32]int64
+		tablets []*pb.Tablet
+	}
+	colocations := make(map[string]*colocation)
+	for pred, p := range placements {
+		tab := s.servingTablet(pred)
+		if tab == nil || p.Colocation == "" {
+			continue
+		}
+		c := colocations[p.Colocation]
+		if c == nil {
+			c = &colocation{size: make(map[uint32]int64)}
+			colocations[p.Colocation] = c
+		}
+		if p.PinnedGroup != 0 && c.pinned == 0 {
+			c.pinned = p.PinnedGroup
+		}
+		c.size[tab.GroupId] += tab.OnDiskBytes
+		c.tablets = append(c.tablets, tab)
+	}
+
+	for label, c := range colocations {
+		if len(c.size) < 2 && (c.pinned == 0 || c.size[c.pinned] > 0) {
+			continue
+		}
+		dst := c.pinned
+		if dst == 0 {
+			for gid, size := range c.size {
+				if dst == 0 || size > c.size[dst] || (size == c.size[dst] && gid < dst) {
+					dst = gid
+				}
+			}
+		}
+		if _, ok := s.state.Groups[dst]; !ok || !s.acceptsTablets(dst) {
+			continue
+		}
+		// Move the smallest tablets first, to colocate most of the tablets sooner.
+		sort.Slice(c.tablets, func(i, j int) bool {
+			return c.tablets[i].OnDiskBytes < c.tablets[j].OnDiskBytes
+		})
+		for _, tab := range c.tablets {
+			if tab.GroupId == dst || !movable(tab) || placements[tab.Predicate].PinnedGroup != 0 {
+				continue
+			}
+			glog.V(2).Infof("Colocating %s with %s in group %d", tab.Predicate, label, dst)
+			return tab.Predicate, tab.GroupId, dst
+		}
+	}
+	return "", 0, 0
+}
+
+// balancePolicy moves a tablet from the group with the biggest metric to the group with the
+// smallest one, once they differ by 10%. The pinned and colocated tablets are left to the other
+// policies.
+type balancePolicy struct {
+	policy  string
+	metric  func(tab *pb.Tablet) float64
+	minDiff float64
+}
+
+func (p balancePolicy) name() string { return p.policy }
+
+func (p balancePolicy) chooseTablet(s *Server) (predicate string, srcGroup, dstGroup uint32) {
+	// Sort all groups by their metric.
+	type kv struct {
+		gid    uint32
+		metric float64
+	}
+	var groups []kv
+	for k, v := range s.state.Groups {
+		// The tablets of a decommissioning group are moved off it by the decommission.
+		if v.Decommissioning {
+			continue
+		}
+		metric := float64(0)
+		for _, tab := range v.Tablets {
+			metric += p.metric(tab)
+		}
+		groups = append(groups, kv{k, metric})
+	}
+	sort.Slice(groups, func(i, j int) bool {
+		return groups[i].metric < groups[j].metric
+	})
+
+	glog.Infof("\n\nGroups sorted by %s: %+v\n\n", p.policy, groups)
+	placements := s.state.GetPlacements()
+	for lastGroup := len(groups) - 1; lastGroup > 0; lastGroup-- {
+		srcGroup = groups[lastGroup].gid
+		dstGroup = groups[0].gid
+		diff := groups[lastGroup].metric - groups[0].metric
+		glog.Infof("%s_diff %v\n", p.policy, diff)
+		// Don't move a node unless you receive atleast one update regarding tablet size.
+		// Tablet size would have come up with leader update.
+		if !s.hasLeader(dstGroup) {
+			return "", 0, 0
+		}
+		// We move the predicate only if the difference between both groups is atleast 10% of
+		// dst group.
+		if diff < 0.1*groups[0].metric || diff < p.minDiff {
+			continue
+		}
+
+		// Try to find a predicate which we can move.
+		metric := float64(0)
+		group := s.state.Groups[srcGroup]
+		for _, tab := range group.Tablets {
+			if !movable(tab) {
+				continue
+			}
+			if pl := placements[tab.Predicate]; pl.GetPinnedGroup() != 0 ||
+				pl.GetColocation() != "" {
+				continue
+			}
+
+			// Finds a tablet as big a possible such that on moving it dstGroup's metric is
+			// less than or equal to srcGroup.
+			if m := p.metric(tab); m <= diff/2 && m > metric {
+				predicate = tab.Predicate
+				metric = m
+			}
+		}
+		if len(predicate) > 0 {
+			return
+		}
+	}
+	return "", 0, 0
+}
+
+// placeTablet returns the group that should serve a new tablet as per its placement, or zero if
+// the group asking for it can serve it. A decommissioning group is never given a new tablet.
+func (s *Server) placeTablet(pred string, asking uint32) uint32 {
+	s.RLock()
+	defer s.RUnlock()
+
+	placements := s.state.GetPlacements()
+	p := placements[pred]
+	if gid := p.GetPinnedGroup(); gid != 0 {
+		if group, ok := s.state.Groups[gid]; ok && !group.Decommissioning {
+			return gid
+		}
+	}
+	if p.GetColocation() == "" {
+		return s.otherGroup(asking)
+	}
+	// Serve it in the group serving most of its colocation.
+	var dst uint32
+	count := make(map[uint32]int)
+	for gid, group := range s.state.Groups {
+		if group.Decommissioning {
+			continue
+		}
+		for other := range group.Tablets {
+			if placements[other].GetColocation() == p.Colocation {
+				count[gid]++
+			}
+		}
+		if count[gid] > count[dst] || (count[gid] == count[dst] && count[gid] > 0 && gid < dst) {
+			dst = gid
+		}
+	}
+	if dst == 0 {
+		return s.otherGroup(asking)
+	}
+	return dst
+}
+
+// otherGroup returns the group holding the least data of those accepting tablets if the given
+// group is being decommissioned, or zero otherwise.
+func (s *Server) otherGroup(gid uint32) uint32 {
+	if !s.state.Groups[gid].GetDecommissioning() {
+		return 0
+	}
+	var dst uint32
+	size := make(map[uint32]int64)
+	for id, group := range s.state.Groups {
+		if !s.acceptsTablets(id) {
+			continue
+		}
+		for _, tab := range group.Tablets {
+			size[id] += tab.OnDiskBytes
+		}
+		if dst == 0 || size[id] < size[dst] || (size[id] == size[dst] && id < dst) {
+			dst = id
+		}
+	}
+	return dst
+}
+
+// SetTabletPlacement sets the placement of a tablet. A placement without a pinned group and
+// without a colocation removes it. The tablets are moved by the placement policies.
+func (s *Server) SetTabletPlacement(ctx context.Context,
+	p *pb.TabletPlacement) (*pb.Status, error) {
+	if !s.Node.AmLeader() {
+		return &pb.Status{Code: 1, Msg: x.Error}, errNotLeader
+	}
+	if p.Predicate == "" {
+		return nil, errors.Errorf("The predicate of the placement is empty")
+	}
+	if x.IsReservedPredicate(p.Predicate) {
+		return nil, errors.Errorf("Reserved predicate %s is always served by group 1",
+			x.ParseAttr(p.Predicate))
+	}
+	if p.PinnedGroup != 0 {
+		var known bool
+		for _, gid := range s.KnownGroups() {
+			known = known || gid == p.PinnedGroup
+		}
+		if !known {
+			return nil, errors.Errorf("Group %d is not a known group", p.PinnedGroup)
+		}
+	}
+	if err := s.Node.proposeAndWait(ctx, &pb.ZeroProposal{Placement: p}); err != nil {
+		return nil, err
+	}
+	return &pb.Status{}, nil
+}
+
+// applyPlacement updates the placement of the tablet in the membership state.
+func (n *node) applyPlacement(p *pb.TabletPlacement) {
+	n.server.AssertLock()
+	state := n.server.state
+	if p.PinnedGroup == 0 && p.Colocation == "" {
+		delete(state.Placements, p.Predicate)
+		glog.Infof("Removed placement of predicate %s", p.Predicate)
+		return
+	}
+	if state.Placements == nil {
+		state.Placements = make(map[string]*pb.TabletPlacement)
+	}
+	state.Placements[p.Predicate] = proto.Clone(p).(*pb.TabletPlacement)
+	glog.Infof("Set placement of predicate %s to %+v", p.Predicate, p)
+}
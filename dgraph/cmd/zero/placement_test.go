@@ -0,0 +1,178 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package zero
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hypermodeinc/dgraph/v25/protos/pb"
+)
+
+func testPlacementServer(placements map[string]*pb.TabletPlacement,
+	tablets ...*pb.Tablet) *Server {
+	state := &pb.MembershipState{
+		Groups:     make(map[uint32]*pb.Group),
+		Placements: placements,
+	}
+	for gid := uint32(1); gid <= 3; gid++ {
+		state.Groups[gid] = &pb.Group{
+			Members: map[uint64]*pb.Member{uint64(gid): {Id: uint64(gid), Leader: true}},
+			Tablets: make(map[string]*pb.Tablet),
+		}
+	}
+	for _, tab := range tablets {
+		state.Groups[tab.GroupId].Tablets[tab.Predicate] = tab
+	}
+	return &Server{state: state}
+}
+
+func choose(s *Server, p placementPolicy) (string, uint32, uint32) {
+	s.RLock()
+	defer s.RUnlock()
+	return p.chooseTablet(s)
+}
+
+func TestParsePlacementPolicies(t *testing.T) {
+	policies, err := parsePlacementPolicies(placementDefaults + ", load")
+	require.NoError(t, err)
+	var names []string
+	for _, p := range policies {
+		names = append(names, p.name())
+	}
+	require.Equal(t, []string{"pin", "colocation", "size", "load"}, names)
+
+	_, err = parsePlacementPolicies("size,random")
+	require.Error(t, err)
+}
+
+func TestPinPolicy(t *testing.T) {
+	s := testPlacementServer(map[string]*pb.TabletPlacement{
+		"0-name": {Predicate: "0-name", PinnedGroup: 2},
+		"0-age":  {Predicate: "0-age", PinnedGroup: 1},
+	},
+		&pb.Tablet{GroupId: 1, Predicate: "0-name"},
+		&pb.Tablet{GroupId: 1, Predicate: "0-age"},
+	)
+	pred, src, dst := choose(s, pinPolicy{})
+	require.Equal(t, "0-name", pred)
+	require.Equal(t, uint32(1), src)
+	require.Equal(t, uint32(2), dst)
+}
+
+func TestColocationPolicy(t *testing.T) {
+	s := testPlacementServer(map[string]*pb.TabletPlacement{
+		"0-name":   {Predicate: "0-name", Colocation: "person"},
+		"0-friend": {Predicate: "0-friend", Colocation: "person"},
+		"0-age":    {Predicate: "0-age", Colocation: "person"},
+	},
+		&pb.Tablet{GroupId: 1, Predicate: "0-name", OnDiskBytes: 100},
+		&pb.Tablet{GroupId: 2, Predicate: "0-friend", OnDiskBytes: 500},
+		&pb.Tablet{GroupId: 2, Predicate: "0-age", OnDiskBytes: 10},
+	)
+	pred, src, dst := choose(s, colocationPolicy{})
+	require.Equal(t, "0-name", pred)
+	require.Equal(t, uint32(1), src)
+	require.Equal(t, uint32(2), dst)
+	require.Equal(t, uint32(2), s.placeTablet("0-age", 3))
+}
+
+func TestBalancePolicy(t *testing.T) {
+	policies, err := parsePlacementPolicies("size,load")
+	require.NoError(t, err)
+	s := testPlacementServer(map[string]*pb.TabletPlacement{
+		"0-pinned": {Predicate: "0-pinned", PinnedGroup: 1},
+	},
+		&pb.Tablet{GroupId: 1, Predicate: "0-pinned", OnDiskBytes: 300},
+		&pb.Tablet{GroupId: 1, Predicate: "0-big", OnDiskBytes: 200, ReadsPerSec: 5},
+		&pb.Tablet{GroupId: 1, Predicate: "0-small", OnDiskBytes: 100, WritesPerSec: 500},
+		&pb.Tablet{GroupId: 2, Predicate: "0-hot", OnDiskBytes: 50, ReadsPerSec: 1000},
+		&pb.Tablet{GroupId: 2, Predicate: "0-warm", OnDiskBytes: 50, ReadsPerSec: 200},
+		&pb.Tablet{GroupId: 3, Predicate: "0-other", OnDiskBytes: 500, ReadsPerSec: 600},
+	)
+
+	pred, src, dst := choose(s, policies[0])
+	require.Equal(t, "0-big", pred)
+	require.Equal(t, uint32(1), src)
+	require.Equal(t, uint32(2), dst)
+
+	pred, src, dst = choose(s, policies[1])
+	require.Equal(t, "0-warm", pred)
+	require.Equal(t, uint32(2), src)
+	require.Equal(t, uint32(1), dst)
+}
+
+func TestRateChanged(t *testing.T) {
+	require.True(t, rateChanged(0, 5))
+	require.True(t, rateChanged(100, 120))
+	require.False(t, rateChanged(100, 105))
+	// Swings of lightly used tablets aren't proposed.
+	require.False(t, rateChanged(0, 0.5))
+	require.False(t, rateChanged(2, 1.5))
+}
+
+func TestDecommissioningGroup(t *testing.T) {
+	policies, err := parsePlacementPolicies("size")
+	require.NoError(t, err)
+	s := testPlacementServer(map[string]*pb.TabletPlacement{
+		"0-name": {Predicate: "0-name", PinnedGroup: 3},
+		"0-age":  {Predicate: "0-age", Colocation: "person"},
+		"0-city": {Predicate: "0-city", Colocation: "person"},
+	},
+		&pb.Tablet{GroupId: 1, Predicate: "0-name", OnDiskBytes: 100},
+		&pb.Tablet{GroupId: 1, Predicate: "0-big", OnDiskBytes: 300},
+		&pb.Tablet{GroupId: 1, Predicate: "0-huge", OnDiskBytes: 1000},
+		&pb.Tablet{GroupId: 2, Predicate: "0-small", OnDiskBytes: 10},
+		&pb.Tablet{GroupId: 3, Predicate: "0-city", OnDiskBytes: 5},
+	)
+	s.state.Groups[3].Decommissioning = true
+
+	// The tablets aren't moved to a decommissioning group.
+	pred, _, _ := choose(s, pinPolicy{})
+	require.Empty(t, pred)
+	pred, _, dst := choose(s, policies[0])
+	require.Equal(t, "0-big", pred)
+	require.Equal(t, uint32(2), dst)
+
+	// Nor are the new tablets placed in it, and those it asks for go to another group.
+	require.Zero(t, s.placeTablet("0-name", 1))
+	require.Zero(t, s.placeTablet("0-age", 1))
+	require.Equal(t, uint32(2), s.placeTablet("0-other", 3))
+
+	// Its last member can't be removed while it serves a sub-tablet.
+	s.state.Groups[3].Tablets = make(map[string]*pb.Tablet)
+	s.state.Groups[1].Tablets["0-name"].Shards = []uint32{1, 3}
+	_, err = s.RemoveNode(context.Background(), &pb.RemoveNodeRequest{NodeId: 3, GroupId: 3})
+	require.ErrorContains(t, err, "serves a sub-tablet of the predicate name")
+}
+
+func TestStaleMoves(t *testing.T) {
+	s := testPlacementServer(nil,
+		&pb.Tablet{GroupId: 1, Predicate: "0-name", Moving: true},
+		&pb.Tablet{GroupId: 1, Predicate: "0-age"},
+		&pb.Tablet{GroupId: 2, Predicate: "0-city", Moving: true, Shards: []uint32{2, 3}},
+	)
+	s.state.Groups[3].Tablets["0-city"] = s.state.Groups[2].Tablets["0-city"]
+	require.Empty(t, testPlacementServer(nil).staleMoves())
+
+	// A new leader unmarks the tablets left marked as moving, once each.
+	tablets := s.staleMoves()
+	slices.SortFunc(tablets, func(a, b *pb.Tablet) int {
+		return strings.Compare(a.Predicate, b.Predicate)
+	})
+	require.Len(t, tablets, 2)
+	for i, pred := range []string{"0-city", "0-name"} {
+		require.Equal(t, pred, tablets[i].Predicate)
+		require.False(t, tablets[i].Moving)
+		require.True(t, tablets[i].Force)
+	}
+	// The state isn't changed until they're proposed.
+	require.True(t, s.state.Groups[1].Tablets["0-name"].Moving)
+}
@@ -0,0 +1,89 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package zero
+
+import (
+	"context"
+
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/hypermodeinc/dgraph/v25/protos/pb"
+	"github.com/hypermodeinc/dgraph/v25/x"
+)
+
+// SetNamespaceQuota sets the quota of a namespace. A quota without any limit removes it. The
+// quotas are part of the membership state, so that every alpha enforces them.
+func (s *Server) SetNamespaceQuota(ctx context.Context, q *pb.NamespaceQuota) (*pb.Status, error) {
+	if q.Namespace == x.RootNamespace {
+		return nil, errors.Errorf("The root namespace can't have a quota")
+	}
+	if err := s.Node.proposeAndWait(ctx, &pb.ZeroProposal{Quota: q}); err != nil {
+		return nil, err
+	}
+	return &pb.Status{}, nil
+}
+
+func hasQuotaLimits(q *pb.NamespaceQuota) bool {
+	return q.MaxDiskBytes > 0 || q.MaxUids > 0 || q.QueriesPerSec > 0 ||
+		q.MutationsPerSec > 0 || q.MaxConcurrentQueries > 0
+}
+
+// applyQuota updates the quota of the namespace in the membership state.
+func (n *node) applyQuota(q *pb.NamespaceQuota) {
+	n.server.AssertLock()
+	state := n.server.state
+	if !hasQuotaLimits(q) {
+		delete(state.Quotas, q.Namespace)
+		glog.Infof("Removed quota of namespace %#x", q.Namespace)
+		return
+	}
+	if state.Quotas == nil {
+		state.Quotas = make(map[uint64]*pb.NamespaceQuota)
+	}
+	state.Quotas[q.Namespace] = proto.Clone(q).(*pb.NamespaceQuota)
+	glog.Infof("Set quota of namespace %#x to %+v", q.Namespace, q)
+}
+
+// applyLeasedUids counts the UIDs leased by a namespace.
+func (n *node) applyLeasedUids(l *pb.NamespaceUids) {
+	n.server.AssertLock()
+	state := n.server.state
+	if state.LeasedUids == nil {
+		state.LeasedUids = make(map[uint64]uint64)
+	}
+	state.LeasedUids[l.Namespace] += l.Count
+}
+
+// leaseUidsWithQuota leases UIDs for a namespace with a UID quota. The UIDs leased by the
+// namespace are counted, and the lease fails if it would exceed the quota.
+func (s *Server) leaseUidsWithQuota(ctx context.Context, ns uint64,
+	num *pb.Num) (*pb.AssignedIds, bool, error) {
+	s.RLock()
+	q := s.state.GetQuotas()[ns]
+	s.RUnlock()
+	if q.GetMaxUids() == 0 {
+		return nil, false, nil
+	}
+
+	s.quotaLock.Lock()
+	defer s.quotaLock.Unlock()
+	s.RLock()
+	leased := s.state.GetLeasedUids()[ns]
+	s.RUnlock()
+	if leased+num.Val > q.MaxUids {
+		return nil, true, errors.Errorf("Cannot lease %d UIDs because namespace %#x has leased %d "+
+			"UIDs out of its quota of %d", num.Val, ns, leased, q.MaxUids)
+	}
+	reply, err := s.lease(ctx, num)
+	if err != nil {
+		return nil, true, err
+	}
+	err = s.Node.proposeAndWait(ctx, &pb.ZeroProposal{
+		LeasedUids: &pb.NamespaceUids{Namespace: ns, Count: num.Val}})
+	return reply, true, err
+}
@@ -104,6 +104,11 @@ func (n *node) proposeAndWait(ctx context.Context, proposal *pb.ZeroProposal) er
 		// Do this check upfront. Don't do this inside propose for reasons explained below.
 		return errors.Errorf("Not Zero leader. Aborting proposal: %+v", proposal)
 	}
+	if f := proposalFeature(proposal); f != nil {
+		if err := n.server.checkFeature(*f); err != nil {
+			return err
+		}
+	}
 
 	// We could consider adding a wrapper around the user proposal, so we can access any key-values.
 	// Something like this:
@@ -245,6 +250,13 @@ func (n *node) handleMemberProposal(member *pb.Member) error {
 		return errors.Errorf("Group reached replication level. Can't add another member: %+v", member)
 	}
 
+	if !has && group.Decommissioning {
+		// The group takes a new member, so it's no longer being emptied.
+		glog.Infof("Group %d got member %#x while being decommissioned", member.GroupId,
+			member.Id)
+		group.Decommissioning = false
+	}
+
 	// Create a connection to this server.
 	go conn.GetPools().Connect(member.Addr, n.server.tlsClientConfig)
 
@@ -275,11 +287,23 @@ func (n *node) regenerateChecksum() {
 	// Regenerate group checksums. These checksums are solely based on which tablets are being
 	// served by the group. If the tablets that a group is serving changes, and the Alpha does
 	// not know about these changes, then the read request must fail.
+	// The groups serving the sub-tablets of a split predicate serve it too.
+	shards := make(map[uint32][]string)
 	for _, g := range state.GetGroups() {
-		preds := make([]string, 0, len(g.GetTablets()))
-		for pred := range g.GetTablets() {
-			preds = append(preds, pred)
+		for pred, tablet := range g.GetTablets() {
+			for _, gid := range tablet.GetShards() {
+				shards[gid] = append(shards[gid], fmt.Sprintf("%s%v", pred, tablet.GetShards()))
+			}
 		}
+	}
+	for gid, g := range state.GetGroups() {
+		preds := make([]string, 0, len(g.GetTablets())+len(shards[gid]))
+		for pred, tablet := range g.GetTablets() {
+			if len(tablet.GetShards()) == 0 {
+				preds = append(preds, pred)
+			}
+		}
+		preds = append(preds, shards[gid]...)
 		sort.Strings(preds)
 		g.Checksum = farm.Fingerprint64([]byte(strings.Join(preds, "")))
 	}
@@ -367,6 +391,13 @@ func (n *node) deleteNamespace(delNs uint64) error {
 			}
 		}
 	}
+	for pred := range state.Placements {
+		if x.ParseNamespace(pred) == delNs {
+			delete(state.Placements, pred)
+		}
+	}
+	delete(state.Quotas, delNs)
+	delete(state.LeasedUids, delNs)
 	return nil
 }
 
@@ -465,6 +496,30 @@ func (n *node) applyProposal(e raftpb.Entry) (uint64, error) {
 			return key, err
 		}
 	}
+	if p.Placement != nil {
+		n.applyPlacement(p.Placement)
+	}
+	if p.Replication != nil {
+		n.applyReplication(p.Replication)
+	}
+	if p.Decommission != nil {
+		n.applyDecommission(p.Decommission)
+	}
+	if p.Schedule != nil {
+		n.applySchedule(p.Schedule)
+	}
+	if p.JobRun != nil {
+		n.applyJobRun(p.JobRun)
+	}
+	if p.FinalizedFeatureVersion > 0 {
+		n.applyFinalizedFeatureVersion(p.FinalizedFeatureVersion)
+	}
+	if p.Quota != nil {
+		n.applyQuota(p.Quota)
+	}
+	if p.LeasedUids != nil {
+		n.applyLeasedUids(p.LeasedUids)
+	}
 
 	switch {
 	case p.MaxUID > state.MaxUID:
@@ -506,10 +561,19 @@ func (n *node) applyConfChange(e raftpb.Entry) {
 		go n.Connect(rc.Id, rc.Addr)
 
 		m := &pb.Member{
-			Id:      rc.Id,
-			Addr:    rc.Addr,
-			GroupId: 0,
-			Learner: rc.IsLearner,
+			Id:             rc.Id,
+			Addr:           rc.Addr,
+			GroupId:        0,
+			Learner:        rc.IsLearner,
+			Version:        rc.Version,
+			FeatureVersion: rc.FeatureVersion,
+		}
+		if err := n.server.checkJoin(m.Addr, m.FeatureVersion); err != nil {
+			n.DoneConfChange(cc.ID, err)
+			// Cancel configuration change.
+			cc.NodeID = raft.None
+			n.Raft().ApplyConfChange(cc)
+			return
 		}
 		for _, member := range n.server.membershipState().Removed {
 			// It is not recommended to reuse RAFT ids.
@@ -697,6 +761,7 @@ func (n *node) updateZeroMembershipPeriodically(closer *z.Closer) {
 		select {
 		case <-ticker.C:
 			n.server.updateZeroLeader()
+			n.updateFeatureVersion()
 		case <-closer.HasBeenClosed():
 			return
 		}
@@ -919,6 +984,7 @@ func (n *node) Run() {
 				if rd.RaftState == raft.StateLeader && !leader {
 					glog.Infoln("I've become the leader, updating leases.")
 					n.server.updateLeases()
+					go n.server.clearStaleMoves()
 				}
 				leader = rd.RaftState == raft.StateLeader
 				// group id hardcoded as 0
@@ -0,0 +1,45 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package zero
+
+import (
+	"context"
+
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/hypermodeinc/dgraph/v25/protos/pb"
+	"github.com/hypermodeinc/dgraph/v25/x"
+)
+
+// SetReplication sets the role of the cluster in a cross-cluster replication. The role is part
+// of the membership state, so that the leaders of the groups of a primary ship their data to the
+// target, and the alphas of a secondary only accept the replicated writes.
+func (s *Server) SetReplication(ctx context.Context, r *pb.Replication) (*pb.Status, error) {
+	if !s.Node.AmLeader() {
+		return &pb.Status{Code: 1, Msg: x.Error}, errNotLeader
+	}
+	switch {
+	case r.Role != pb.Replication_PRIMARY && r.Target != "":
+		return nil, errors.Errorf("Only a primary cluster replicates to a target")
+	case r.Target != "":
+		if err := x.ValidateAddress(r.Target); err != nil {
+			return nil, errors.Wrapf(err, "invalid replication target")
+		}
+	}
+	if err := s.Node.proposeAndWait(ctx, &pb.ZeroProposal{Replication: r}); err != nil {
+		return nil, err
+	}
+	return &pb.Status{}, nil
+}
+
+// applyReplication updates the replication role of the cluster in the membership state.
+func (n *node) applyReplication(r *pb.Replication) {
+	n.server.AssertLock()
+	n.server.state.Replication = proto.Clone(r).(*pb.Replication)
+	glog.Infof("Set replication of the cluster to %+v", r)
+}
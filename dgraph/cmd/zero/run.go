@@ -44,6 +44,7 @@ type options struct {
 	peer              string
 	w                 string
 	rebalanceInterval time.Duration
+	placementPolicies []placementPolicy
 	tlsClientConfig   *tls.Config
 	audit             *x.LoggerConf
 	limiterConfig     *x.LimiterConf
@@ -84,6 +85,10 @@ instances to achieve high-availability.
 	flag.String("peer", "", "Address of another dgraphzero server.")
 	flag.StringP("wal", "w", "zw", "Directory storing WAL.")
 	flag.Duration("rebalance_interval", 8*time.Minute, "Interval for trying a predicate move.")
+	flag.String("placement", placementDefaults, "Comma separated placement policies tried in "+
+		"order to choose the predicate to move: pin (to the group a predicate is pinned to), "+
+		"colocation (with the predicates queried together), size (of the groups on disk), "+
+		"load (the rates of reads and writes of the groups).")
 	flag.String("enterprise_license", "", "(deprecated) Path to the enterprise license file.")
 	flag.String("cid", "", "Cluster ID")
 
@@ -121,6 +126,28 @@ instances to achieve high-availability.
 			"The number of days audit logs will be preserved.").
 		Flag("size",
 			"The audit log max size in MB after which it will be rolled over.").
+		Flag("syslog",
+			`[tcp://host:port, udp://host:port, tls://host:port] The syslog server the audit
+			logs are shipped to, as RFC5424 messages.`).
+		Flag("syslog-ca-cert",
+			"The path to the CA certificate used to verify a tls:// syslog server.").
+		Flag("syslog-client-cert",
+			"The path to the client certificate used to connect to a tls:// syslog server.").
+		Flag("syslog-client-key",
+			"The path to the client key used to connect to a tls:// syslog server.").
+		Flag("otlp",
+			"The URL of the OTLP/HTTP logs endpoint the audit logs are shipped to, like "+
+				"http://collector:4318/v1/logs.").
+		Flag("otlp-headers",
+			"Comma separated list of key:value headers sent to the OTLP endpoint.").
+		Flag("buffer",
+			`The number of audit entries buffered for each of the syslog and OTLP outputs. New
+			entries are dropped from an output while its buffer is full, they are still written
+			to the audit log files.`).
+		Flag("head-dir",
+			`The directory the last entry of the hash chain of the audit logs is recorded in, to
+			detect the entries removed from the end of the logs. It should be out of reach of
+			whoever can write the logs. The directory of the logs is used if it's empty.`).
 		String())
 }
 
@@ -155,10 +182,12 @@ func (st *state) serveGRPC(l net.Listener, store *raftwal.DiskStorage) {
 
 	nodeId := opts.raft.GetUint64("idx")
 	rc := pb.RaftContext{
-		Id:        nodeId,
-		Addr:      x.WorkerConfig.MyAddr,
-		Group:     0,
-		IsLearner: opts.raft.GetBool("learner"),
+		Id:             nodeId,
+		Addr:           x.WorkerConfig.MyAddr,
+		Group:          0,
+		IsLearner:      opts.raft.GetBool("learner"),
+		Version:        x.Version(),
+		FeatureVersion: x.FeatureVersion,
 	}
 	m := conn.NewNode(&rc, store, opts.tlsClientConfig)
 
@@ -237,7 +266,7 @@ func run() {
 			opts.numReplicas)
 	}
 
-	if opts.audit != nil {
+	if opts.audit != nil && opts.audit.Output != "" {
 		wd, err := filepath.Abs(opts.w)
 		x.Check(err)
 		ad, err := filepath.Abs(opts.audit.Output)
@@ -250,6 +279,11 @@ func run() {
 		log.Fatalf("ERROR: Rebalance interval must be greater than zero. Found: %d",
 			opts.rebalanceInterval)
 	}
+	policies, err := parsePlacementPolicies(Zero.Conf.GetString("placement"))
+	if err != nil {
+		log.Fatalf("ERROR: Invalid placement policies: %v", err)
+	}
+	opts.placementPolicies = policies
 
 	addr := "localhost"
 	if opts.bindall {
@@ -298,6 +332,7 @@ func run() {
 		baseMux.HandleFunc("/state", st.getState)
 		baseMux.HandleFunc("/removeNode", st.removeNode)
 		baseMux.HandleFunc("/moveTablet", st.moveTablet)
+		baseMux.HandleFunc("/splitTablet", st.splitTablet)
 		baseMux.HandleFunc("/assign", st.assign)
 	}
 	baseMux.HandleFunc("/debug/jemalloc", x.JemallocHandler)
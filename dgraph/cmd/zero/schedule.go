@@ -0,0 +1,96 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package zero
+
+import (
+	"context"
+
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/hypermodeinc/dgraph/v25/protos/pb"
+	"github.com/hypermodeinc/dgraph/v25/x"
+)
+
+// jobHistorySize is the number of the latest runs kept in the history of a scheduled job.
+const jobHistorySize = 10
+
+// SetJobSchedule sets, or removes, the schedule of a backup or an export job. The schedules are
+// part of the membership state, so that the leader of group 1 runs the jobs.
+func (s *Server) SetJobSchedule(ctx context.Context, js *pb.JobSchedule) (*pb.Status, error) {
+	if !s.Node.AmLeader() {
+		return &pb.Status{Code: 1, Msg: x.Error}, errNotLeader
+	}
+	if js.Name == "" {
+		return nil, errors.Errorf("The name of the schedule is missing")
+	}
+	if js.Remove {
+		s.RLock()
+		_, ok := s.state.Schedules[js.Name]
+		s.RUnlock()
+		if !ok {
+			return nil, errors.Errorf("Schedule %q doesn't exist", js.Name)
+		}
+	} else {
+		if _, err := x.ParseCron(js.Cron); err != nil {
+			return nil, err
+		}
+		if (js.Backup == nil) == (js.Export == nil) {
+			return nil, errors.Errorf("Schedule %q must run either a backup or an export", js.Name)
+		}
+	}
+	js = &pb.JobSchedule{Name: js.Name, Cron: js.Cron, Backup: js.Backup, Export: js.Export,
+		Remove: js.Remove}
+	if err := s.Node.proposeAndWait(ctx, &pb.ZeroProposal{Schedule: js}); err != nil {
+		return nil, err
+	}
+	return &pb.Status{}, nil
+}
+
+// RecordJobRun adds a run of a scheduled job to its history.
+func (s *Server) RecordJobRun(ctx context.Context, run *pb.JobRun) (*pb.Status, error) {
+	if !s.Node.AmLeader() {
+		return &pb.Status{Code: 1, Msg: x.Error}, errNotLeader
+	}
+	if err := s.Node.proposeAndWait(ctx, &pb.ZeroProposal{JobRun: run}); err != nil {
+		return nil, err
+	}
+	return &pb.Status{}, nil
+}
+
+// applySchedule sets or removes the schedule of a job in the membership state. The history of
+// the job is kept when its schedule is updated.
+func (n *node) applySchedule(js *pb.JobSchedule) {
+	n.server.AssertLock()
+	state := n.server.state
+	if js.Remove {
+		delete(state.Schedules, js.Name)
+		glog.Infof("Removed schedule %q", js.Name)
+		return
+	}
+	if state.Schedules == nil {
+		state.Schedules = make(map[string]*pb.JobSchedule)
+	}
+	updated := proto.Clone(js).(*pb.JobSchedule)
+	updated.History = state.Schedules[js.Name].GetHistory()
+	state.Schedules[js.Name] = updated
+	glog.Infof("Set schedule %q to %q", js.Name, js.Cron)
+}
+
+// applyJobRun records a run of a scheduled job, and drops the oldest runs from its history.
+// Runs of removed schedules are ignored.
+func (n *node) applyJobRun(run *pb.JobRun) {
+	n.server.AssertLock()
+	js, ok := n.server.state.Schedules[run.Name]
+	if !ok {
+		return
+	}
+	js.History = append(js.History, proto.Clone(run).(*pb.JobRun))
+	if len(js.History) > jobHistorySize {
+		js.History = js.History[len(js.History)-jobHistorySize:]
+	}
+}
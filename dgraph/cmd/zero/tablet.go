@@ -8,7 +8,6 @@ package zero
 import (
 	"context"
 	"fmt"
-	"sort"
 	"time"
 
 	humanize "github.com/dustin/go-humanize"
@@ -16,6 +15,7 @@ import (
 	"github.com/pkg/errors"
 	attribute "go.opentelemetry.io/otel/attribute"
 	trace "go.opentelemetry.io/otel/trace"
+	"google.golang.org/protobuf/proto"
 
 	"github.com/hypermodeinc/dgraph/v25/protos/pb"
 	"github.com/hypermodeinc/dgraph/v25/x"
@@ -23,6 +23,12 @@ import (
 
 const (
 	predicateMoveTimeout = 120 * time.Minute
+	// A predicate is copied while it takes writes, and then the changes since the previous copy
+	// are copied again, until a round takes less than moveCutoverTime, or after maxMoveRounds.
+	// The writes are only blocked during the last round. Until every member supports online
+	// moves, a predicate is moved in a single round, with the writes blocked.
+	moveCutoverTime = 5 * time.Second
+	maxMoveRounds   = 10
 )
 
 /*
@@ -47,6 +53,12 @@ This would trigger G1 to get latest state. Wait for it.
 • G1 gets this, G2 gets this.
 • Both propagate this to their followers.
 
+Online move:
+• G1 streams P to G2 at a timestamp T1, while P still takes writes.
+• G1 streams the posting lists of P that changed after T1 as of T2, which G2 writes on top.
+  This repeats until a round is short enough.
+• Zero blocks the commits on P, and G1 streams the last changes. Only then is P reassigned.
+
 */
 
 // TODO: Have a event log for everything.
@@ -84,6 +96,14 @@ func (s *Server) MoveTablet(ctx context.Context, req *pb.MoveTabletRequest) (*pb
 			fmt.Errorf("group: [%d] is not a known group", req.DstGroup)
 	}
 
+	s.RLock()
+	decommissioning := s.state.Groups[req.DstGroup].GetDecommissioning()
+	s.RUnlock()
+	if decommissioning {
+		return &pb.Status{Code: 1, Msg: x.ErrorInvalidRequest},
+			fmt.Errorf("group: [%d] is being decommissioned", req.DstGroup)
+	}
+
 	tablet := x.NamespaceAttr(req.Namespace, req.Tablet)
 	tab := s.ServingTablet(tablet)
 	if tab == nil {
@@ -140,6 +160,10 @@ func (s *Server) movePredicate(predicate string, srcGroup, dstGroup uint32) erro
 	if tab == nil {
 		return errors.Errorf("Tablet to be moved: [%v] is not being served", predicate)
 	}
+	if len(tab.Shards) > 0 {
+		return errors.Errorf("Unable to move predicate %s split across groups %v", predicate,
+			tab.Shards)
+	}
 	msg := fmt.Sprintf("Going to move predicate: [%v], size: [ondisk: %v, uncompressed: %v]"+
 		" from group %d to %d\n", predicate, humanize.IBytes(uint64(tab.OnDiskBytes)),
 		humanize.IBytes(uint64(tab.UncompressedBytes)), srcGroup, dstGroup)
@@ -147,17 +171,6 @@ func (s *Server) movePredicate(predicate string, srcGroup, dstGroup uint32) erro
 	span.SetAttributes(attribute.String("tablet", predicate))
 	span.SetStatus(1, msg)
 
-	// Block all commits on this predicate. Keep them blocked until we return from this function.
-	unblock := s.blockTablet(predicate)
-	defer unblock()
-
-	// Get a new timestamp, beyond which we are sure that no new txns would be committed for this
-	// predicate. Source Alpha leader must reach this timestamp before streaming the data.
-	ids, err := s.Timestamps(ctx, &pb.Num{Val: 1})
-	if err != nil || ids.StartId == 0 {
-		return errors.Wrapf(err, "while leasing txn timestamp. Id: %+v", ids)
-	}
-
 	// Get connection to leader of source group.
 	pl := s.Leader(srcGroup)
 	if pl == nil {
@@ -168,12 +181,55 @@ func (s *Server) movePredicate(predicate string, srcGroup, dstGroup uint32) erro
 		Predicate: predicate,
 		SourceGid: srcGroup,
 		DestGid:   dstGroup,
-		TxnTs:     ids.StartId,
 	}
-	span.AddEvent(fmt.Sprintf("Move Predicate payload: %+v", in))
-	glog.Infof("Starting move: %+v", in)
-	if _, err := wc.MovePredicate(ctx, in); err != nil {
-		return errors.Wrapf(err, "while calling MovePredicate")
+
+	// The schema of the predicate can't change until it's moved: the deletions of an index
+	// rebuild aren't versioned, so they wouldn't reach the destination.
+	if err := s.setTabletMoving(ctx, predicate, true); err != nil {
+		return errors.Wrapf(err, "while marking tablet %s as moving", predicate)
+	}
+	moved := false
+	defer func() {
+		if moved {
+			return
+		}
+		ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+		defer cancel()
+		if err := s.setTabletMoving(ctx, predicate, false); err != nil {
+			glog.Errorf("While unmarking tablet %s as moving: %v", predicate, err)
+		}
+	}()
+
+	var took time.Duration
+	rounds := s.moveRounds()
+	for round := 1; ; round++ {
+		last := round == rounds || (in.TxnTs > 0 && took < moveCutoverTime)
+		if last {
+			// Block all commits on this predicate. Keep them blocked until we return from this
+			// function.
+			unblock := s.blockTablet(predicate)
+			defer unblock()
+		}
+
+		// Get a new timestamp, beyond which we are sure that no new txns would be committed for
+		// this predicate in the last round. Source Alpha leader must reach this timestamp before
+		// streaming the data.
+		ids, err := s.Timestamps(ctx, &pb.Num{Val: 1})
+		if err != nil || ids.StartId == 0 {
+			return errors.Wrapf(err, "while leasing txn timestamp. Id: %+v", ids)
+		}
+		in.SinceTs, in.TxnTs = in.TxnTs, ids.StartId
+
+		span.AddEvent(fmt.Sprintf("Move Predicate payload: %+v", in))
+		glog.Infof("Starting move round %d: %+v", round, in)
+		start := time.Now()
+		if _, err := wc.MovePredicate(ctx, in); err != nil {
+			return errors.Wrapf(err, "while calling MovePredicate")
+		}
+		took = time.Since(start)
+		if last {
+			break
+		}
 	}
 
 	p := &pb.ZeroProposal{}
@@ -191,6 +247,8 @@ func (s *Server) movePredicate(predicate string, srcGroup, dstGroup uint32) erro
 	if err := s.Node.proposeAndWait(ctx, p); err != nil {
 		return errors.Wrapf(err, "while proposing tablet reassignment. Proposal: %+v", p)
 	}
+	// The tablet assigned to the destination isn't marked as moving.
+	moved = true
 	msg = fmt.Sprintf("Predicate move done for: [%v] from group %d to %d\n",
 		predicate, srcGroup, dstGroup)
 	span.AddEvent(msg)
@@ -228,59 +286,254 @@ func (s *Server) chooseTablet() (predicate string, srcGroup uint32, dstGroup uin
 		return
 	}
 
-	// Sort all groups by their sizes.
-	type kv struct {
-		gid  uint32
-		size int64 // in bytes
-	}
-	var groups []kv
-	for k, v := range s.state.Groups {
-		space := int64(0)
-		for _, tab := range v.Tablets {
-			space += tab.OnDiskBytes
-		}
-		groups = append(groups, kv{k, space})
-	}
-	sort.Slice(groups, func(i, j int) bool {
-		return groups[i].size < groups[j].size
-	})
-
-	glog.Infof("\n\nGroups sorted by size: %+v\n\n", groups)
-	for lastGroup := numGroups - 1; lastGroup > 0; lastGroup-- {
-		srcGroup = groups[lastGroup].gid
-		dstGroup = groups[0].gid
-		sizeDiff := groups[lastGroup].size - groups[0].size
-		glog.Infof("size_diff %v\n", sizeDiff)
-		// Don't move a node unless you receive atleast one update regarding tablet size.
-		// Tablet size would have come up with leader update.
-		if !s.hasLeader(dstGroup) {
+	for _, policy := range opts.placementPolicies {
+		predicate, srcGroup, dstGroup = policy.chooseTablet(s)
+		if len(predicate) > 0 {
+			glog.Infof("Placement policy %s chose to move %s from group %d to %d",
+				policy.name(), predicate, srcGroup, dstGroup)
 			return
 		}
-		// We move the predicate only if the difference between size of both machines is
-		// atleast 10% of dst group.
-		if float64(sizeDiff) < 0.1*float64(groups[0].size) {
-			continue
-		}
+	}
+	return
+}
 
-		// Try to find a predicate which we can move.
-		size := int64(0)
-		group := s.state.Groups[srcGroup]
-		for _, tab := range group.Tablets {
-			// Reserved predicates should always be in group 1 so do not re-balance them.
-			if x.IsReservedPredicate(tab.Predicate) {
+// moveRounds returns the most rounds a predicate move can take. The alphas of the older releases
+// only stream the whole predicate, so they can only move it in a single round.
+func (s *Server) moveRounds() int {
+	if err := s.checkFeature(x.FeatureOnlineMoves); err != nil {
+		return 1
+	}
+	return maxMoveRounds
+}
+
+// setTabletMoving marks the tablet of the predicate as being moved to another group, or not.
+func (s *Server) setTabletMoving(ctx context.Context, predicate string, moving bool) error {
+	s.RLock()
+	tab := s.servingTablet(predicate)
+	if tab != nil {
+		tab = proto.Clone(tab).(*pb.Tablet)
+	}
+	s.RUnlock()
+	if tab == nil {
+		return errors.Errorf("Tablet %s is not being served", predicate)
+	}
+	tab.Moving, tab.Force = moving, true
+	return s.Node.proposeAndWait(ctx, &pb.ZeroProposal{Tablet: tab})
+}
+
+// staleMoves returns the tablets marked as moving, unmarked. Only the leader moves tablets, and
+// it unmarks them once it's done, so when it isn't moving any, those were left marked by a
+// previous leader that lost the leadership, or crashed, in the middle of a move.
+func (s *Server) staleMoves() []*pb.Tablet {
+	s.RLock()
+	defer s.RUnlock()
+	var tablets []*pb.Tablet
+	for gid, group := range s.state.GetGroups() {
+		for _, tab := range group.GetTablets() {
+			if tab.GroupId != gid || !tab.Moving {
 				continue
 			}
+			tab = proto.Clone(tab).(*pb.Tablet)
+			tab.Moving, tab.Force = false, true
+			tablets = append(tablets, tab)
+		}
+	}
+	return tablets
+}
 
-			// Finds a tablet as big a possible such that on moving it dstGroup's size is
-			// less than or equal to srcGroup.
-			if tab.OnDiskBytes <= sizeDiff/2 && tab.OnDiskBytes > size {
-				predicate = tab.Predicate
-				size = tab.OnDiskBytes
-			}
+// clearStaleMoves unmarks the tablets left marked as moving by a previous leader, so that their
+// schema can change again. It's called when this Zero becomes the leader.
+func (s *Server) clearStaleMoves() {
+	// A move this Zero started before it lost the leadership unmarks its tablet itself.
+	s.moveOngoing <- struct{}{}
+	defer func() {
+		<-s.moveOngoing
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	if _, err := s.latestMembershipState(ctx); err != nil {
+		glog.Errorf("While clearing the stale tablet moves: %v", err)
+		return
+	}
+	if !s.Node.AmLeader() {
+		return
+	}
+	tablets := s.staleMoves()
+	if len(tablets) == 0 {
+		return
+	}
+	for _, tab := range tablets {
+		glog.Infof("Unmarking tablet %s left as moving by the previous leader", tab.Predicate)
+	}
+	if err := s.Node.proposeAndWait(ctx, &pb.ZeroProposal{Tablets: tablets}); err != nil {
+		glog.Errorf("While clearing the stale tablet moves: %v", err)
+	}
+}
+
+// servesShard returns whether the group serves a sub-tablet of the split tablet.
+func servesShard(tab *pb.Tablet, gid uint32) bool {
+	for _, shard := range tab.GetShards() {
+		if shard == gid {
+			return true
 		}
-		if len(predicate) > 0 {
-			return
+	}
+	return false
+}
+
+// SplitTablet splits a tablet by the hash of the subject UIDs, and of the object UIDs for the
+// reverse edges, into sub-tablets, served by the group serving the tablet and by the given
+// groups. It returns a *pb.Status to be used by the `/splitTablet` HTTP handler in Zero.
+func (s *Server) SplitTablet(ctx context.Context, req *pb.SplitTabletRequest) (*pb.Status, error) {
+	if !s.Node.AmLeader() {
+		return &pb.Status{Code: 1, Msg: x.Error}, errNotLeader
+	}
+	if err := s.checkFeature(x.FeatureTabletSplits); err != nil {
+		return nil, err
+	}
+
+	tablet := x.NamespaceAttr(req.Namespace, req.Tablet)
+	tab := s.ServingTablet(tablet)
+	if tab == nil {
+		return &pb.Status{Code: 1, Msg: x.ErrorInvalidRequest},
+			fmt.Errorf("namespace: %d. No tablet found for: %s", req.Namespace, req.Tablet)
+	}
+	if len(tab.Shards) > 0 {
+		return &pb.Status{Code: 1, Msg: x.ErrorInvalidRequest},
+			fmt.Errorf("namespace: %d. Tablet: [%s] is already split across groups %v",
+				req.Namespace, req.Tablet, tab.Shards)
+	}
+
+	knownGroups := make(map[uint32]bool)
+	for _, gid := range s.KnownGroups() {
+		knownGroups[gid] = true
+	}
+	shards := []uint32{tab.GroupId}
+	for _, gid := range req.Groups {
+		if !knownGroups[gid] {
+			return &pb.Status{Code: 1, Msg: x.ErrorInvalidRequest},
+				fmt.Errorf("group: [%d] is not a known group", gid)
 		}
+		if servesShard(&pb.Tablet{Shards: shards}, gid) {
+			return &pb.Status{Code: 1, Msg: x.ErrorInvalidRequest},
+				fmt.Errorf("group: [%d] is given more than once or already serves the tablet", gid)
+		}
+		shards = append(shards, gid)
 	}
-	return
+	if len(shards) < 2 {
+		return &pb.Status{Code: 1, Msg: x.ErrorInvalidRequest},
+			fmt.Errorf("at least one group is needed to split tablet: [%s]", req.Tablet)
+	}
+
+	if err := s.splitPredicate(tablet, shards); err != nil {
+		glog.Errorf("namespace: %d. While splitting predicate %s across groups %v. Error: %v",
+			req.Namespace, req.Tablet, shards, err)
+		return &pb.Status{Code: 1, Msg: x.Error}, err
+	}
+	return &pb.Status{Code: 0, Msg: fmt.Sprintf("namespace: %d. "+
+		"Predicate: [%s] split across groups %v", req.Namespace, req.Tablet, shards)}, nil
+}
+
+// splitPredicate splits the predicate into sub-tablets served by the shards groups. It follows
+// the steps of a predicate move: the group serving the predicate streams the data of the other
+// sub-tablets to their groups, Zero proposes the split tablet, and then the group drops the
+// data it no longer serves.
+func (s *Server) splitPredicate(predicate string, shards []uint32) error {
+	s.moveOngoing <- struct{}{}
+	defer func() {
+		<-s.moveOngoing
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), predicateMoveTimeout)
+	defer cancel()
+
+	// Ensure that reserved predicates cannot be split.
+	if x.IsReservedPredicate(predicate) {
+		return errors.Errorf("Unable to split reserved predicate %s", predicate)
+	}
+
+	// Ensure that I'm connected to the rest of the Zero group, and am the leader.
+	if _, err := s.latestMembershipState(ctx); err != nil {
+		return errors.Wrapf(err, "unable to reach quorum")
+	}
+	if !s.Node.AmLeader() {
+		return errors.Errorf("I am not the Zero leader")
+	}
+	tab := s.ServingTablet(predicate)
+	if tab == nil {
+		return errors.Errorf("Tablet to be split: [%v] is not being served", predicate)
+	}
+	if tab.GroupId != shards[0] {
+		return errors.Errorf("Tablet to be split: [%v] moved to group %d", predicate, tab.GroupId)
+	}
+	glog.Infof("Going to split predicate: [%v], size: [ondisk: %v] across groups %v\n",
+		predicate, humanize.IBytes(uint64(tab.OnDiskBytes)), shards)
+
+	// Block all commits on this predicate. Keep them blocked until we return from this function.
+	unblock := s.blockTablet(predicate)
+	defer unblock()
+
+	ids, err := s.Timestamps(ctx, &pb.Num{Val: 1})
+	if err != nil || ids.StartId == 0 {
+		return errors.Wrapf(err, "while leasing txn timestamp. Id: %+v", ids)
+	}
+
+	pl := s.Leader(shards[0])
+	if pl == nil {
+		return errors.Errorf("No healthy connection found to leader of group %d", shards[0])
+	}
+	wc := pb.NewWorkerClient(pl.Get())
+	in := &pb.SplitPredicatePayload{
+		Predicate: predicate,
+		Shards:    shards,
+		TxnTs:     ids.StartId,
+	}
+	glog.Infof("Starting split: %+v", in)
+	if _, err := wc.SplitPredicate(ctx, in); err != nil {
+		return errors.Wrapf(err, "while calling SplitPredicate")
+	}
+
+	p := &pb.ZeroProposal{}
+	p.Tablet = &pb.Tablet{
+		GroupId:           shards[0],
+		Predicate:         predicate,
+		OnDiskBytes:       tab.OnDiskBytes,
+		UncompressedBytes: tab.UncompressedBytes,
+		Force:             true,
+		MoveTs:            in.TxnTs,
+		Shards:            shards,
+	}
+	glog.Infof("Split at Alpha done. Now proposing: %+v", p)
+	if err := s.Node.proposeAndWait(ctx, p); err != nil {
+		return errors.Wrapf(err, "while proposing split tablet. Proposal: %+v", p)
+	}
+	glog.Infof("Predicate split done for: [%v] across groups %v\n", predicate, shards)
+
+	// Like after a move, the group only drops the data of the other sub-tablets once its
+	// members know that the predicate is split. Until then, the group serves them along with the
+	// groups they were sent to, so the cleanup is retried while the commits are still blocked.
+	// Each attempt rewrites the postings at a new timestamp, above the rollups written so far.
+	in.Cleanup = true
+	in.ExpectedChecksum = s.groupChecksums()[shards[0]]
+	for attempt := 1; ; attempt++ {
+		ids, err := s.Timestamps(ctx, &pb.Num{Val: 1})
+		if err != nil || ids.StartId == 0 {
+			return errors.Wrapf(err, "while leasing txn timestamp. Id: %+v", ids)
+		}
+		in.TxnTs = ids.StartId
+		if _, err = wc.SplitPredicate(ctx, in); err == nil {
+			break
+		}
+		glog.Warningf("Attempt %d: while dropping data of other sub-tablets of predicate [%v] "+
+			"in group %d. Error: %v", attempt, predicate, shards[0], err)
+		select {
+		case <-ctx.Done():
+			return errors.Wrapf(err, "while dropping data of other sub-tablets of predicate "+
+				"[%v] in group %d", predicate, shards[0])
+		case <-time.After(time.Duration(attempt) * time.Second):
+		}
+	}
+	glog.Infof("Dropped data of other sub-tablets of predicate [%v] in group %d", predicate,
+		shards[0])
+	return nil
 }
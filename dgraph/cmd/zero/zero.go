@@ -49,6 +49,7 @@ type Server struct {
 	readOnlyTs  uint64
 	leaseLock   sync.Mutex // protects nextUID, nextTxnTs, nextNsID and corresponding proposals.
 	rateLimiter *x.RateLimiter
+	quotaLock   sync.Mutex // serializes the leases of UIDs of the namespaces with a UID quota.
 
 	// groupMap    map[uint32]*Group
 	nextGroup      uint32
@@ -339,6 +340,22 @@ func (s *Server) servingTablet(tablet string) *pb.Tablet {
 	return nil
 }
 
+// changed returns whether the value d reported for a tablet differs by more than 10% from the
+// value s known to Zero.
+func changed(s, d float64) bool {
+	return (s == 0 && d > 0) || (s > 0 && math.Abs(d/s-1) > 0.1)
+}
+
+// minRateChange is the smallest change of the reads or writes per second of a tablet that is
+// proposed. The rates of lightly used tablets swing by more than 10% all the time.
+const minRateChange = 1.0
+
+// rateChanged returns whether the rate d reported for a tablet differs enough from the rate s
+// known to Zero to be proposed.
+func rateChanged(s, d float64) bool {
+	return changed(s, d) && math.Abs(d-s) >= minRateChange
+}
+
 func (s *Server) createProposals(dst *pb.Group) ([]*pb.ZeroProposal, error) {
 	var res []*pb.ZeroProposal
 	if len(dst.Members) > 1 {
@@ -349,6 +366,18 @@ func (s *Server) createProposals(dst *pb.Group) ([]*pb.ZeroProposal, error) {
 	defer s.RUnlock()
 	// There is only one member. We use for loop because we don't know what the mid is.
 	for mid, dstMember := range dst.Members {
+		if dstMember.GroupId == 0 {
+			// A Zero follower updating its release and feature version.
+			srcMember, has := s.state.Zeros[mid]
+			if !has {
+				return res, errors.Errorf("Unknown Zero: %+v", dstMember)
+			}
+			if srcMember.Version != dstMember.Version ||
+				srcMember.FeatureVersion != dstMember.FeatureVersion {
+				res = append(res, &pb.ZeroProposal{Member: dstMember})
+			}
+			return res, nil
+		}
 		group, has := s.state.Groups[dstMember.GroupId]
 		if !has {
 			return res, errors.Errorf("Unknown group for member: %+v", dstMember)
@@ -358,7 +387,9 @@ func (s *Server) createProposals(dst *pb.Group) ([]*pb.ZeroProposal, error) {
 			return res, errors.Errorf("Unknown member: %+v", dstMember)
 		}
 		if srcMember.Addr != dstMember.Addr ||
-			srcMember.Leader != dstMember.Leader {
+			srcMember.Leader != dstMember.Leader ||
+			srcMember.Version != dstMember.Version ||
+			srcMember.FeatureVersion != dstMember.FeatureVersion {
 
 			proposal := &pb.ZeroProposal{
 				Member: dstMember,
@@ -388,10 +419,12 @@ func (s *Server) createProposals(dst *pb.Group) ([]*pb.ZeroProposal, error) {
 			continue
 		}
 
-		s := float64(srcTablet.OnDiskBytes)
-		d := float64(dstTablet.OnDiskBytes)
-		if dstTablet.Remove || (s == 0 && d > 0) || (s > 0 && math.Abs(d/s-1) > 0.1) {
+		if dstTablet.Remove ||
+			changed(float64(srcTablet.OnDiskBytes), float64(dstTablet.OnDiskBytes)) ||
+			rateChanged(srcTablet.ReadsPerSec, dstTablet.ReadsPerSec) ||
+			rateChanged(srcTablet.WritesPerSec, dstTablet.WritesPerSec) {
 			dstTablet.Force = false
+			dstTablet.Shards = srcTablet.Shards
 			tablets = append(tablets, dstTablet)
 		}
 	}
@@ -488,6 +521,17 @@ func (s *Server) RemoveNode(ctx context.Context, req *pb.RemoveNodeRequest) (*pb
 		return nil, errors.Errorf("Move all tablets from group %d before removing the last node",
 			req.GroupId)
 	}
+	if len(s.state.Groups[req.GroupId].Members) == 1 {
+		for _, group := range s.state.Groups {
+			for _, tab := range group.Tablets {
+				if servesShard(tab, req.GroupId) {
+					return nil, errors.Errorf("Group %d serves a sub-tablet of the predicate %s "+
+						"split across groups %v. It can't be removed", req.GroupId,
+						x.ParseAttr(tab.Predicate), tab.Shards)
+				}
+			}
+		}
+	}
 	if err := s.Node.proposeAndWait(ctx, zp); err != nil {
 		return nil, err
 	}
@@ -495,6 +539,37 @@ func (s *Server) RemoveNode(ctx context.Context, req *pb.RemoveNodeRequest) (*pb
 	return &pb.Status{}, nil
 }
 
+// DecommissionGroup marks the group as being decommissioned, or not. The tablets of a
+// decommissioning group are moved off it before its last member is removed, and it isn't given
+// new tablets meanwhile.
+func (s *Server) DecommissionGroup(ctx context.Context,
+	req *pb.DecommissionGroupRequest) (*pb.Status, error) {
+	if !s.Node.AmLeader() {
+		return &pb.Status{Code: 1, Msg: x.Error}, errNotLeader
+	}
+	s.RLock()
+	_, ok := s.state.Groups[req.GroupId]
+	s.RUnlock()
+	if !ok {
+		return nil, errors.Errorf("No group with groupId %d found", req.GroupId)
+	}
+	if err := s.Node.proposeAndWait(ctx, &pb.ZeroProposal{Decommission: req}); err != nil {
+		return nil, err
+	}
+	return &pb.Status{}, nil
+}
+
+// applyDecommission marks the group as being decommissioned, or not.
+func (n *node) applyDecommission(req *pb.DecommissionGroupRequest) {
+	n.server.AssertLock()
+	group := n.server.state.Groups[req.GroupId]
+	if group == nil {
+		return
+	}
+	group.Decommissioning = req.Decommissioning
+	glog.Infof("Set decommissioning of group %d to %v", req.GroupId, req.Decommissioning)
+}
+
 // Connect is used by Alpha nodes to connect the very first time with group zero.
 func (s *Server) Connect(ctx context.Context,
 	m *pb.Member) (resp *pb.ConnectionState, err error) {
@@ -525,6 +600,9 @@ func (s *Server) Connect(ctx context.Context,
 	if m.Addr == "" {
 		return &emptyConnectionState, errors.Errorf("NO_ADDR: No address provided: %+v", m)
 	}
+	if err := s.checkJoin(m.Addr, m.FeatureVersion); err != nil {
+		return &emptyConnectionState, err
+	}
 
 	for _, member := range ms.Removed {
 		// It is not recommended to reuse RAFT ids.
@@ -703,6 +781,10 @@ func (s *Server) ShouldServe(
 		// This will also make it easier to restore the reserved predicates after
 		// a DropAll operation.
 		tablet.GroupId = 1
+	} else if gid := s.placeTablet(tablet.Predicate, tablet.GroupId); gid != 0 {
+		// The tablet is pinned to a group, or colocated with tablets served by a group, or the
+		// group asking for it is being decommissioned.
+		tablet.GroupId = gid
 	}
 	proposal.Tablet = tablet
 	if err := s.Node.proposeAndWait(ctx, &proposal); err != nil && err != errTabletAlreadyServed {
@@ -803,6 +885,9 @@ func (s *Server) deletePredicates(ctx context.Context, group *pb.Group) error {
 		if _, found := sg.Tablets[pred]; found {
 			continue
 		}
+		if tab := s.ServingTablet(pred); tab != nil && servesShard(tab, gid) {
+			continue
+		}
 		glog.Infof("Tablet: %v does not belong to group: %d. Sending delete instruction.",
 			pred, gid)
 		in := &pb.MovePredicatePayload{
@@ -410,6 +410,41 @@ func (hc *HTTPClient) AddNamespace() (uint64, error) {
 	return 0, errors.New(result.AddNamespace.Message)
 }
 
+// CloneNamespace clones the namespace nsID as of readTs into a new namespace, the latest state
+// of the namespace if readTs is 0. It returns the id of the new namespace.
+func (hc *HTTPClient) CloneNamespace(nsID, readTs uint64) (uint64, error) {
+	const cloneReq = `mutation cloneNamespace($namespaceId: UInt64!, $readTs: UInt64) {
+		cloneNamespace(input: {namespaceId: $namespaceId, readTs: $readTs}) {
+			namespaceId
+			message
+		}
+	}`
+
+	vars := map[string]interface{}{"namespaceId": nsID}
+	if readTs != 0 {
+		vars["readTs"] = readTs
+	}
+	params := GraphQLParams{Query: cloneReq, Variables: vars}
+	resp, err := hc.RunGraphqlQuery(params, true)
+	if err != nil {
+		return 0, err
+	}
+
+	var result struct {
+		CloneNamespace struct {
+			NamespaceId uint64 `json:"namespaceId"`
+			Message     string `json:"message"`
+		}
+	}
+	if err := json.Unmarshal(resp, &result); err != nil {
+		return 0, errors.Wrap(err, "error unmarshalling response")
+	}
+	if strings.Contains(result.CloneNamespace.Message, "Cloned namespace successfully") {
+		return result.CloneNamespace.NamespaceId, nil
+	}
+	return 0, errors.New(result.CloneNamespace.Message)
+}
+
 func (hc *HTTPClient) DeleteNamespace(nsID uint64) (uint64, error) {
 	const deleteReq = `mutation deleteNamespace($namespaceId: Int!) {
 		deleteNamespace(input: {namespaceId: $namespaceId}) {
@@ -14,7 +14,8 @@ const (
 		{"predicate":"dgraph.user.group", "list":true, "reverse":true, "type":"uid"},
 		{"predicate":"dgraph.acl.rule", "type":"uid", "list":true},
 		{"predicate":"dgraph.rule.predicate", "type":"string", "index":true, "tokenizer":["exact"], "upsert":true},
-		{"predicate":"dgraph.rule.permission", "type":"int"}
+		{"predicate":"dgraph.rule.permission", "type":"int"},
+		{"predicate":"dgraph.rule.filter", "type":"string"}
 	`
 
 	otherInternalPreds = `
@@ -48,7 +49,8 @@ const (
 		{
 			"fields": [
 				{"name": "dgraph.rule.predicate"},
-				{"name": "dgraph.rule.permission"}
+				{"name": "dgraph.rule.permission"},
+				{"name": "dgraph.rule.filter"}
 			],
 			"name": "dgraph.type.Rule"
 		}
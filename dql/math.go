@@ -55,7 +55,8 @@ type MathTree struct {
 
 func isUnary(f string) bool {
 	return f == "exp" || f == "ln" || f == "u-" || f == "sqrt" ||
-		f == "floor" || f == "ceil" || f == "since"
+		f == "floor" || f == "ceil" || f == "since" ||
+		f == "lower" || f == "upper" || f == "length"
 }
 
 func isBinaryMath(f string) bool {
@@ -63,7 +64,7 @@ func isBinaryMath(f string) bool {
 }
 
 func isTernary(f string) bool {
-	return f == "cond"
+	return f == "cond" || f == "substring"
 }
 
 func isZero(f string, rval types.Val) bool {
@@ -153,7 +154,10 @@ func isMathFunc(f string) bool {
 		f == "==" || f == "!=" ||
 		f == "min" || f == "max" || f == "sqrt" ||
 		f == "pow" || f == "logbase" || f == "floor" || f == "ceil" ||
-		f == "since" || f == "dot"
+		f == "since" || f == "dot" ||
+		f == "concat" || f == "lower" || f == "upper" || f == "substring" || f == "length" ||
+		f == "date_trunc" || f == "extract" || f == "date_add" || f == "date_diff" ||
+		f == "coalesce" || f == "geodistance"
 }
 
 func parseMathFunc(gq *GraphQuery, it *lex.ItemIterator, again bool) (*MathTree, bool, error) {
@@ -240,8 +244,21 @@ loop:
 				}
 				continue
 			}
-			// We will try to parse the constant as an Int first, if that fails we move to float
 			child := &MathTree{}
+			if strings.HasPrefix(item.Val, `"`) {
+				// Quoted strings are string constants, for eg. the unit in date_trunc.
+				str, err := unquoteIfQuoted(item.Val)
+				if err != nil {
+					return nil, false, err
+				}
+				child.Const = types.Val{
+					Tid:   types.StringID,
+					Value: str,
+				}
+				valueStack.push(child)
+				continue
+			}
+			// We will try to parse the constant as an Int first, if that fails we move to float
 			i, err := strconv.ParseInt(item.Val, 10, 64)
 			if err != nil {
 				v, err := strconv.ParseFloat(item.Val, 64)
@@ -392,6 +409,8 @@ func (t *MathTree) stringHelper(buf *bytes.Buffer) {
 				t.Const.Value.(float64), 'E', -1, 64))
 		case types.IntID:
 			leafStr, err = buf.WriteString(strconv.FormatInt(t.Const.Value.(int64), 10))
+		case types.StringID:
+			leafStr, err = buf.WriteString(strconv.Quote(t.Const.Value.(string)))
 		}
 		x.Check2(leafStr, err)
 		return
@@ -401,7 +420,8 @@ func (t *MathTree) stringHelper(buf *bytes.Buffer) {
 	switch t.Fn {
 	case "+", "-", "/", "*", "%", "exp", "ln", "cond", "min",
 		"sqrt", "max", "<", ">", "<=", ">=", "==", "!=", "u-",
-		"logbase", "pow", "dot":
+		"logbase", "pow", "dot", "concat", "lower", "upper", "substring", "length",
+		"date_trunc", "extract", "date_add", "date_diff", "coalesce", "geodistance":
 		x.Check2(buf.WriteString(t.Fn))
 	default:
 		x.Fatalf("Unknown operator: %q", t.Fn)
@@ -62,6 +62,7 @@ type GraphQuery struct {
 	ShortestPathArgs ShortestPathArgs
 	Cascade          []string
 	IgnoreReflex     bool
+	AsOf             uint64 // Read timestamp requested with @asof, zero if not set.
 	Facets           *pb.FacetParams
 	FacetsFilter     *FilterTree
 	GroupbyAttrs     []GroupByAttr
@@ -70,6 +71,9 @@ type GraphQuery struct {
 
 	// Used for ACL enabled queries to curtail results to only accessible params
 	AllowedPreds []string
+	// Used for ACL enabled queries to filter the nodes reached through the predicates that
+	// expand() expands to. The filter under the empty predicate applies to all of them.
+	RowFilters map[string]*FilterTree
 
 	// Internal fields below.
 	// If gq.fragment is nonempty, then it is a fragment reference / spread.
@@ -199,6 +203,19 @@ var mathOpPrecedence = map[string]int{
 	"max":     85,
 	"min":     84,
 
+	"coalesce":   83,
+	"concat":     82,
+	"substring":  81,
+	"lower":      80,
+	"upper":      79,
+	"length":     78,
+	"date_trunc": 77,
+	"extract":    76,
+	"date_add":   75,
+	"date_diff":  74,
+
+	"geodistance": 73,
+
 	// NOTE: Previously, we had "/" at precedence 50 and "*" at precedence 49.
 	//       This is problematic because it would evaluate:
 	//              5 * 10 / 50 as: 5 * (10/50). This is fine for floating point, but breaks
@@ -235,6 +252,22 @@ func (f *Function) IsPasswordVerifier() bool {
 	return f.Name == "checkpwd"
 }
 
+// IsScore returns true if the function name is "score".
+func (f *Function) IsScore() bool {
+	return f.Name == "score"
+}
+
+// isScoreFunc returns whether the score name the iterator is at starts the score(pred, "text")
+// function: its parentheses must start with a predicate, not with a key: argument.
+func isScoreFunc(it *lex.ItemIterator) bool {
+	peekIt, err := it.Peek(3)
+	if err != nil {
+		return false
+	}
+	return peekIt[0].Typ == itemLeftRound && peekIt[1].Typ == itemName &&
+		peekIt[2].Typ != itemColon
+}
+
 // DebugPrint is useful for debugging.
 func (gq *GraphQuery) DebugPrint(prefix string) {
 	glog.Infof("%s[%x %q %q]\n", prefix, gq.UID, gq.Attr, gq.Alias)
@@ -608,6 +641,8 @@ type Result struct {
 	Query     []*GraphQuery
 	QueryVars []*Vars
 	Schema    *pb.SchemaRequest
+	// AsOf is the timestamp the whole request should read at, as given by @asof.
+	AsOf uint64
 }
 
 // Parse initializes and runs the lexer. It also constructs the GraphQuery subgraph
@@ -740,6 +775,14 @@ func ParseWithNeedVars(r Request, needVars []string) (res Result, rerr error) {
 func validateResult(res *Result) error {
 	seenQueryAliases := make(map[string]bool)
 	for _, q := range res.Query {
+		if q.AsOf != 0 {
+			// A request is served at a single read timestamp.
+			if res.AsOf != 0 && res.AsOf != q.AsOf {
+				return errors.Errorf("All blocks must use the same @asof ts, got %d and %d",
+					res.AsOf, q.AsOf)
+			}
+			res.AsOf = q.AsOf
+		}
 		if q.Alias == "var" || q.Alias == "shortest" {
 			continue
 		}
@@ -919,6 +962,31 @@ func parseVarName(it *lex.ItemIterator) (string, error) {
 	return val, nil
 }
 
+// parseAsOf parses @asof(ts: 1234).
+func parseAsOf(it *lex.ItemIterator, gq *GraphQuery) error {
+	if ok := trySkipItemTyp(it, itemLeftRound); !ok {
+		return it.Errorf("Expected ( after @asof")
+	}
+	if !it.Next() || it.Item().Typ != itemName || strings.ToLower(it.Item().Val) != "ts" {
+		return it.Errorf("Expected ts inside @asof()")
+	}
+	if ok := trySkipItemTyp(it, itemColon); !ok {
+		return it.Errorf("Expected colon(:) after ts")
+	}
+	if !it.Next() || it.Item().Typ != itemName {
+		return it.Errorf("Expected value for ts inside @asof()")
+	}
+	ts, err := strconv.ParseUint(it.Item().Val, 0, 64)
+	if err != nil || ts == 0 {
+		return it.Item().Errorf("Value of ts inside @asof() should be a positive integer")
+	}
+	if ok := trySkipItemTyp(it, itemRightRound); !ok {
+		return it.Errorf("Expected ) after @asof arguments")
+	}
+	gq.AsOf = ts
+	return nil
+}
+
 func parseRecurseArgs(it *lex.ItemIterator, gq *GraphQuery) error {
 	if ok := trySkipItemTyp(it, itemLeftRound); !ok {
 		// We don't have a (, we can return.
@@ -1056,6 +1124,10 @@ L:
 				if err := parseRecurseArgs(it, gq); err != nil {
 					return nil, err
 				}
+			case "asof":
+				if err := parseAsOf(it, gq); err != nil {
+					return nil, err
+				}
 			default:
 				return nil, item.Errorf("Unknown directive [%s]", item.Val)
 			}
@@ -3099,6 +3171,27 @@ func godeep(it *lex.ItemIterator, gq *GraphQuery) error {
 				gq.Children = append(gq.Children, child)
 				curp = nil
 				continue
+			case valLower == "score" && isScoreFunc(it):
+				// score without the parentheses, or with arguments like score(first: 5), is just
+				// a predicate called score.
+				child := &GraphQuery{
+					Args:  make(map[string]string),
+					Var:   varName,
+					Alias: alias,
+				}
+				varName, alias = "", ""
+				it.Prev()
+				if child.Func, err = parseFunction(it, gq); err != nil {
+					return err
+				}
+				if len(child.Func.Args) != 1 {
+					return it.Errorf("score function requires 1 argument, but got %d",
+						len(child.Func.Args))
+				}
+				child.Attr = child.Func.Attr
+				gq.Children = append(gq.Children, child)
+				curp = nil
+				continue
 			case isAggregator(valLower):
 				child := &GraphQuery{
 					Attr:       valueFunc,
@@ -3415,7 +3508,11 @@ func isMathBlock(name string) bool {
 }
 
 func isGeoFunc(name string) bool {
-	return name == "near" || name == "contains" || name == "within" || name == "intersects"
+	switch name {
+	case "near", "contains", "within", "intersects", "nearest":
+		return true
+	}
+	return false
 }
 
 func IsInequalityFn(name string) bool {
@@ -675,6 +675,37 @@ func TestParseQueryWithVarValAggNestedConditional(t *testing.T) {
 		res.Query[1].Children[0].Children[5].MathExp.debugString())
 }
 
+func TestParseQueryWithVarValMathStringAndDate(t *testing.T) {
+	query := `
+	{
+		me(func: uid(L), orderasc: val(e)) {
+			name
+			val(d)
+			val(f)
+		}
+
+		var(func: uid(0x0a)) {
+			L as friends {
+				n as name
+				nick as nickname
+				dob as birthday
+				d as math(concat(upper(substring(n, 0, 1)), lower(coalesce(nick, n))))
+				e as math(date_diff(date_trunc(dob, "month"), date_add(dob, "24h")))
+				f as math(extract(dob, "year") + length(n))
+			}
+		}
+	}
+`
+	res, err := Parse(Request{Str: query})
+	require.NoError(t, err)
+	require.EqualValues(t, "(concat (upper (substring n 0 1)) (lower (coalesce nick n)))",
+		res.Query[1].Children[0].Children[3].MathExp.debugString())
+	require.EqualValues(t, `(date_diff (date_trunc dob "month") (date_add dob "24h"))`,
+		res.Query[1].Children[0].Children[4].MathExp.debugString())
+	require.EqualValues(t, `(+ (extract dob "year") (length n))`,
+		res.Query[1].Children[0].Children[5].MathExp.debugString())
+}
+
 func TestParseQueryWithVarValAggNested3(t *testing.T) {
 	query := `
 	{
@@ -2556,6 +2587,27 @@ func TestParseFilter_Geo1(t *testing.T) {
 	require.Equal(t, false, resp.Query[0].Children[0].Filter.Func.Args[1].IsValueVar)
 }
 
+func TestParseNearestAndGeoDistance(t *testing.T) {
+	query := `
+	query {
+		me(func: nearest(loc, [-122.41, 37.77], 5), orderasc: val(d)) {
+			name
+			l as loc
+			d as math(geodistance(l, "[-122.41, 37.77]"))
+		}
+	}
+`
+	resp, err := Parse(Request{Str: query})
+	require.NoError(t, err)
+	fn := resp.Query[0].Func
+	require.Equal(t, "nearest", fn.Name)
+	require.Equal(t, "loc", fn.Attr)
+	require.Equal(t, "[-122.41,37.77]", fn.Args[0].Value)
+	require.Equal(t, "5", fn.Args[1].Value)
+	require.EqualValues(t, `(geodistance l "[-122.41, 37.77]")`,
+		resp.Query[0].Children[2].MathExp.debugString())
+}
+
 func TestParseFilter_Geo2(t *testing.T) {
 	query := `
 	query {
@@ -2814,6 +2866,62 @@ func TestParseCheckPwd(t *testing.T) {
 	require.Equal(t, "password", gq.Query[0].Children[0].Attr)
 }
 
+func TestParseScore(t *testing.T) {
+	query := `{
+		var(func: anyoftext(description, "quick fox")) {
+			s as score(description, "quick fox")
+			score
+		}
+		me(func: uid(s), orderdesc: val(s)) {
+			relevance: score(description@en, "quick fox")
+		}
+	}
+`
+	gq, err := Parse(Request{Str: query})
+	require.NoError(t, err)
+	child := gq.Query[0].Children[0]
+	require.Equal(t, "score", child.Func.Name)
+	require.Equal(t, "s", child.Var)
+	require.Equal(t, "description", child.Attr)
+	require.Len(t, child.Func.Args, 1)
+	require.Equal(t, "quick fox", child.Func.Args[0].Value)
+
+	// Without parentheses it's a predicate.
+	require.Nil(t, gq.Query[0].Children[1].Func)
+	require.Equal(t, "score", gq.Query[0].Children[1].Attr)
+
+	child = gq.Query[1].Children[0]
+	require.Equal(t, "relevance", child.Alias)
+	require.Equal(t, "en", child.Func.Lang)
+
+	query = `{
+		me(func: uid(1)) {
+			score(description)
+		}
+	}
+`
+	_, err = Parse(Request{Str: query})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "score function requires 1 argument")
+
+	// With arguments, it's a predicate too.
+	query = `{
+		me(func: uid(1)) {
+			score(first: 5) {
+				name
+			}
+		}
+	}
+`
+	gq, err = Parse(Request{Str: query})
+	require.NoError(t, err)
+	child = gq.Query[0].Children[0]
+	require.Nil(t, child.Func)
+	require.Equal(t, "score", child.Attr)
+	require.Equal(t, "5", child.Args["first"])
+	require.Equal(t, "name", child.Children[0].Attr)
+}
+
 func TestParseComments(t *testing.T) {
 	query := `
 	# Something
@@ -5365,6 +5473,49 @@ func TestRecurseWithError(t *testing.T) {
 	require.Error(t, err)
 	require.Contains(t, err.Error(), "Value inside loop should be type of boolean")
 }
+
+func TestAsOf(t *testing.T) {
+	query := `
+	{
+		me(func: eq(name, "sad")) @asof(ts: 120) {
+			name
+		}
+		you(func: eq(name, "happy")) @asof(ts: 120) @filter(has(age)) {
+			name
+		}
+	}`
+	res, err := Parse(Request{Str: query})
+	require.NoError(t, err)
+	require.Equal(t, uint64(120), res.Query[0].AsOf)
+	require.Equal(t, uint64(120), res.AsOf)
+	require.NotNil(t, res.Query[1].Filter)
+}
+
+func TestAsOfWithError(t *testing.T) {
+	query := `
+	{
+		me(func: eq(name, "sad")) @asof(ts: 10) {
+			name
+		}
+		you(func: eq(name, "happy")) @asof(ts: 12) {
+			name
+		}
+	}`
+	_, err := Parse(Request{Str: query})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "All blocks must use the same @asof ts")
+
+	query = `
+	{
+		me(func: eq(name, "sad")) @asof(ts: yesterday) {
+			name
+		}
+	}`
+	_, err = Parse(Request{Str: query})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "should be a positive integer")
+}
+
 func TestParseExpandFilter(t *testing.T) {
 	query := `
 		{
@@ -114,6 +114,11 @@ func (s *Server) authenticateLogin(ctx context.Context, request *api.LoginReques
 
 	var user *acl.User
 	if len(request.RefreshToken) > 0 {
+		// OIDC tokens are sent directly as access JWTs. They can't be exchanged for Dgraph JWTs
+		// because their users don't need to exist in the DB.
+		if x.IsOIDCToken(request.RefreshToken) {
+			return nil, errors.Errorf("OIDC tokens can't be used as refresh tokens")
+		}
 		userData, err := validateToken(request.RefreshToken)
 		if err != nil {
 			return nil, errors.Wrapf(err, "unable to authenticate the refresh token %v",
@@ -396,6 +401,7 @@ const queryAcls = `
 	dgraph.acl.rule {
 		dgraph.rule.predicate
 		dgraph.rule.permission
+		dgraph.rule.filter
 	}
 	~dgraph.user.group{
 		dgraph.xid
@@ -407,6 +413,7 @@ const queryAcls = `
 var aclPrefixes = [][]byte{
 	x.PredicatePrefix(x.AttrInRootNamespace("dgraph.rule.permission")),
 	x.PredicatePrefix(x.AttrInRootNamespace("dgraph.rule.predicate")),
+	x.PredicatePrefix(x.AttrInRootNamespace("dgraph.rule.filter")),
 	x.PredicatePrefix(x.AttrInRootNamespace("dgraph.acl.rule")),
 	x.PredicatePrefix(x.AttrInRootNamespace("dgraph.user.group")),
 	x.PredicatePrefix(x.AttrInRootNamespace("dgraph.type.Group")),
@@ -668,11 +675,13 @@ func authorizeAlter(ctx context.Context, op *api.Operation) error {
 
 	// extract the list of predicates from the operation object
 	var preds []string
+	// dropAttr is set if the operation drops a predicate along with its data.
+	var dropAttr bool
 	switch {
 	case len(op.DropAttr) > 0:
-		preds = []string{op.DropAttr}
+		preds, dropAttr = []string{op.DropAttr}, true
 	case op.DropOp == api.Operation_ATTR && len(op.DropValue) > 0:
-		preds = []string{op.DropValue}
+		preds, dropAttr = []string{op.DropValue}, true
 	default:
 		update, err := schema.Parse(op.Schema)
 		if err != nil {
@@ -709,6 +718,21 @@ func authorizeAlter(ctx context.Context, op *api.Operation) error {
 				"only guardians are allowed to drop all data, but the current user is %s", userId)
 		}
 
+		if !worker.AclCachePtr.Loaded() {
+			RefreshACLs(ctx)
+		}
+		if !dropAttr && worker.HasAdminPermission(userData.namespace, groupIds, acl.AdminSchema) {
+			// Schema editors are allowed to alter any predicate except the ACL predicates. A
+			// predicate can only be dropped with the permission to modify it.
+			for _, pred := range preds {
+				if x.IsAclPredicate(pred) {
+					return status.Errorf(codes.PermissionDenied,
+						"unauthorized to alter acl predicate: %s", pred)
+				}
+			}
+			return nil
+		}
+
 		result := authorizePreds(ctx, userData, preds, acl.Modify)
 		if len(result.blocked) > 0 {
 			var msg strings.Builder
@@ -1170,6 +1194,81 @@ func AuthorizeGuardians(ctx context.Context) error {
 	return nil
 }
 
+// AuthorizeAdmin authorizes the operation for the users which belong to the guardians group, or
+// to a group that has been granted the admin permission in their namespace.
+// NOTE: The caller should not wrap the error returned. If needed, propagate the GRPC error code.
+func AuthorizeAdmin(ctx context.Context, perm string) error {
+	if worker.Config.AclSecretKey == nil {
+		// the user has not turned on the acl feature
+		return nil
+	}
+
+	userData, err := extractUserAndGroups(ctx)
+	switch {
+	case err == x.ErrNoJwt:
+		return status.Error(codes.PermissionDenied, err.Error())
+	case err != nil:
+		return status.Error(codes.Unauthenticated, err.Error())
+	case x.IsSuperAdmin(userData.groupIds):
+		return nil
+	}
+
+	if !worker.AclCachePtr.Loaded() {
+		RefreshACLs(ctx)
+	}
+	if !worker.HasAdminPermission(userData.namespace, userData.groupIds, perm) {
+		return status.Error(codes.PermissionDenied, fmt.Sprintf("Only guardians and groups "+
+			"with the %s permission are allowed access. User '%v' is not a member of any of "+
+			"them.", perm, userData.userId))
+	}
+	return nil
+}
+
+// AuthGalaxyAdmin authorizes the operation for the users of the galaxy namespace which belong to
+// the guardians group, or to a group that has been granted the admin permission.
+// NOTE: The caller should not wrap the error returned. If needed, propagate the GRPC error code.
+func AuthGalaxyAdmin(ctx context.Context, perm string) error {
+	if !x.WorkerConfig.AclEnabled {
+		return nil
+	}
+	ns, err := x.ExtractNamespaceFrom(ctx)
+	if err != nil {
+		return errors.Wrap(err, "Authorize admin of the galaxy, extracting jwt token, error:")
+	}
+	if ns != 0 {
+		return status.Error(
+			codes.PermissionDenied, "Only superadmin is allowed to do this operation")
+	}
+	if err := AuthorizeAdmin(ctx, perm); err != nil {
+		s := status.Convert(err)
+		return status.Error(
+			s.Code(), "AuthGalaxyAdmin: failed to authorize admin. "+s.Message())
+	}
+	glog.V(3).Infof("Successfully authorised admin of the galaxy with %s", perm)
+	return nil
+}
+
+// authorizeFieldKeys returns a context that restricts the values of @encrypted predicates
+// decrypted by the query to those encrypted with the field keys granted to the groups of the
+// user. Unlike the permissions on predicates, guardians also need to be granted the keys.
+func authorizeFieldKeys(ctx context.Context) (context.Context, error) {
+	if worker.Config.AclSecretKey == nil || len(x.WorkerConfig.FieldKeys) == 0 {
+		return ctx, nil
+	}
+
+	userData, err := extractUserAndGroups(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+	keys := make([]string, 0, len(x.WorkerConfig.FieldKeys))
+	for keyName := range x.WorkerConfig.FieldKeys {
+		if worker.HasFieldKeyAccess(userData.namespace, userData.groupIds, keyName) {
+			keys = append(keys, keyName)
+		}
+	}
+	return worker.WithFieldKeys(ctx, keys), nil
+}
+
 /*
 addUserFilterToQuery applies makes sure that a user can access only its own
 acl info by applying filter of userid and groupid to acl predicates. A query like
@@ -0,0 +1,406 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package edgraph
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/dgraph-io/dgo/v250/protos/api"
+	"github.com/hypermodeinc/dgraph/v25/acl"
+	"github.com/hypermodeinc/dgraph/v25/dql"
+	"github.com/hypermodeinc/dgraph/v25/protos/pb"
+	"github.com/hypermodeinc/dgraph/v25/worker"
+	"github.com/hypermodeinc/dgraph/v25/x"
+)
+
+// rowFilterVarRe matches the variables that can be used in the filters of ACL rules.
+var rowFilterVarRe = regexp.MustCompile(`\$[a-zA-Z_][a-zA-Z0-9_]*`)
+
+// rowFilters holds the filters of the ACL rules that restrict the nodes a user can access.
+//
+// A rule on a predicate restricts the nodes reached through that predicate, e.g. a rule on
+// <assigned> with the filter eq(owner, $user) turns
+//
+//	me(func: uid(0x1)) { assigned { name } }
+//
+// into
+//
+//	me(func: uid(0x1)) { assigned @filter(eq(owner, $user)) { name } }
+//
+// A rule on type:<TypeName> restricts the nodes of the type wherever they show up, e.g. a rule
+// on type:Project with the filter eq(owner, $user) adds the following filter to every block.
+//
+//	@filter(not type(Project) or eq(owner, $user))
+//
+// The rule on a predicate also restricts the nodes reached through its reverse edge. The
+// predicates that expand() expands to are filtered when the query runs, and the uid predicates
+// without a selection, like those captured in a variable or counted, are filtered too.
+//
+// A rule on a scalar predicate restricts the nodes whose values of the predicate can be read, so
+// the filter is added to the blocks that query it, e.g. with a rule on <salary>
+//
+//	me(func: has(name)) { name salary }
+//
+// only returns the nodes that match the filter. expand() leaves the scalar predicates with a rule
+// out, as it can't filter the nodes by the predicates it expands to.
+//
+// If more than one group of the user has a filter for the same predicate or type, the user can
+// access the nodes that match any of them.
+type rowFilters struct {
+	// types is the filter built from all the type rules. It is empty if there is none.
+	types string
+	// preds maps a predicate to its filter.
+	preds map[string]string
+	// vars are the values of the variables that can be used in the filters.
+	vars map[string]string
+	// uidPreds are the uid predicates among those queried without a selection.
+	uidPreds map[string]bool
+}
+
+// getRowFilters returns the filters of the ACL rules of the groups of the user. It returns nil if
+// there are none.
+func getRowFilters(userData *userData) *rowFilters {
+	filters := worker.AclCachePtr.GetRowFilters(userData.namespace, userData.groupIds)
+	if len(filters) == 0 {
+		return nil
+	}
+
+	rf := &rowFilters{
+		preds: make(map[string]string),
+		vars: map[string]string{
+			"$user":      userData.userId,
+			"$namespace": strconv.FormatUint(userData.namespace, 10),
+		},
+	}
+	var types []string
+	for pred, groupFilters := range filters {
+		sort.Strings(groupFilters)
+		anyFilter := "(" + strings.Join(groupFilters, ") or (") + ")"
+		if typeName, ok := strings.CutPrefix(pred, acl.TypeRulePrefix); ok {
+			types = append(types, fmt.Sprintf("(not type(%s) or %s)", typeName, anyFilter))
+			continue
+		}
+		rf.preds[pred] = anyFilter
+	}
+	sort.Strings(types)
+	rf.types = strings.Join(types, " and ")
+	return rf
+}
+
+// parse parses the filter, substituting the variables used in it.
+func (rf *rowFilters) parse(filter string) (*dql.FilterTree, error) {
+	used := make(map[string]string)
+	for _, v := range rowFilterVarRe.FindAllString(filter, -1) {
+		val, ok := rf.vars[v]
+		if !ok {
+			return nil, errors.Errorf("unknown variable %s in the filter of an ACL rule", v)
+		}
+		used[v] = val
+	}
+
+	var q strings.Builder
+	if len(used) > 0 {
+		decls := make([]string, 0, len(used))
+		for v := range used {
+			decls = append(decls, v+": string")
+		}
+		sort.Strings(decls)
+		x.Check2(fmt.Fprintf(&q, "query rowFilter(%s) ", strings.Join(decls, ", ")))
+	}
+	x.Check2(fmt.Fprintf(&q, "{ q(func: uid(0x1)) @filter(%s) { uid } }", filter))
+
+	res, err := dql.Parse(dql.Request{Str: q.String(), Variables: used})
+	if err != nil {
+		return nil, errors.Wrapf(err, "while parsing the filter of an ACL rule: %s", filter)
+	}
+	if len(res.Query) != 1 || res.Query[0].Filter == nil || len(res.Query[0].Children) != 1 {
+		return nil, errors.Errorf("invalid filter in an ACL rule: %s", filter)
+	}
+	return res.Query[0].Filter, nil
+}
+
+// addRowFiltersToQuery adds the filters of the ACL rules to all the blocks of the query that
+// return nodes.
+func addRowFiltersToQuery(gqs []*dql.GraphQuery, rf *rowFilters) error {
+	for _, gq := range gqs {
+		// Blocks without a function only aggregate values, and filtering the root of a
+		// shortest path query would hide the whole path.
+		if gq.Func == nil || gq.ShortestPathArgs.From != nil {
+			if err := addRowFiltersToChildren(gq.Children, rf); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := addRowFilters(gq, rf, ""); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func addRowFiltersToChildren(children []*dql.GraphQuery, rf *rowFilters) error {
+	for _, child := range children {
+		switch {
+		case child.Attr == "expand":
+			// The predicates aren't known until the query runs.
+			filters, err := rf.expandFilters()
+			if err != nil {
+				return err
+			}
+			child.RowFilters = filters
+			if err := addRowFiltersToChildren(child.Children, rf); err != nil {
+				return err
+			}
+		case len(child.Children) > 0 || rf.uidPreds[strings.TrimPrefix(child.Attr, "~")]:
+			if err := addRowFilters(child, rf, strings.TrimPrefix(child.Attr, "~")); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func addRowFilters(gq *dql.GraphQuery, rf *rowFilters, pred string) error {
+	filters := append([]string{rf.types, rf.preds[pred]}, rf.scalarFilters(gq.Children)...)
+	for _, filter := range filters {
+		if filter == "" {
+			continue
+		}
+		ft, err := rf.parse(filter)
+		if err != nil {
+			return err
+		}
+		gq.Filter = parentFilter(ft, gq.Filter)
+	}
+	return addRowFiltersToChildren(gq.Children, rf)
+}
+
+// scalarFilters returns the filters of the rules on the scalar predicates among the children.
+func (rf *rowFilters) scalarFilters(children []*dql.GraphQuery) []string {
+	var filters []string
+	for _, child := range children {
+		if len(child.Children) > 0 || strings.HasPrefix(child.Attr, "~") ||
+			rf.uidPreds[child.Attr] {
+			continue
+		}
+		if filter := rf.preds[child.Attr]; filter != "" && !slices.Contains(filters, filter) {
+			filters = append(filters, filter)
+		}
+	}
+	return filters
+}
+
+// expandFilters returns the filters of the predicates that expand() may expand to. The filter of
+// the type rules is under the empty predicate.
+func (rf *rowFilters) expandFilters() (map[string]*dql.FilterTree, error) {
+	filters := make(map[string]*dql.FilterTree)
+	if rf.types != "" {
+		ft, err := rf.parse(rf.types)
+		if err != nil {
+			return nil, err
+		}
+		filters[""] = ft
+	}
+	for pred, filter := range rf.preds {
+		ft, err := rf.parse(filter)
+		if err != nil {
+			return nil, err
+		}
+		filters[pred] = ft
+	}
+	return filters, nil
+}
+
+// leafPreds returns the predicates queried without a selection.
+func leafPreds(gqs []*dql.GraphQuery, preds map[string]struct{}) {
+	for _, gq := range gqs {
+		for _, child := range gq.Children {
+			if len(child.Children) == 0 && child.Attr != "" && child.Attr != "uid" &&
+				child.Attr != "expand" {
+				preds[strings.TrimPrefix(child.Attr, "~")] = struct{}{}
+			}
+		}
+		leafPreds(gq.Children, preds)
+	}
+}
+
+// getUidPreds returns the uid predicates among those queried without a selection. A uid
+// predicate captured in a variable or counted gives access to the nodes, just like with a
+// selection.
+func getUidPreds(ctx context.Context, namespace uint64,
+	gqs []*dql.GraphQuery) (map[string]bool, error) {
+	leaves := make(map[string]struct{})
+	leafPreds(gqs, leaves)
+	if len(leaves) == 0 {
+		return nil, nil
+	}
+	preds := make([]string, 0, len(leaves))
+	for pred := range leaves {
+		preds = append(preds, x.NamespaceAttr(namespace, pred))
+	}
+	schs, err := worker.GetSchemaOverNetwork(ctx, &pb.SchemaRequest{Predicates: preds,
+		Fields: []string{"type"}})
+	if err != nil {
+		return nil, err
+	}
+	uidPreds := make(map[string]bool)
+	for _, sch := range schs {
+		if sch.GetType() == "uid" {
+			uidPreds[x.ParseAttr(sch.GetPredicate())] = true
+		}
+	}
+	return uidPreds, nil
+}
+
+// authorizeRows adds the filters of the ACL rules to the query. The returned filters are used to
+// authorize the mutations of the request once the uids they touch are known.
+func authorizeRows(ctx context.Context, parsedReq *dql.Result) (*rowFilters, error) {
+	if worker.Config.AclSecretKey == nil {
+		// the user has not turned on the acl feature
+		return nil, nil
+	}
+
+	userData, err := extractUserAndGroups(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+	if x.IsSuperAdmin(userData.groupIds) {
+		return nil, nil
+	}
+
+	rf := getRowFilters(userData)
+	if rf == nil {
+		return nil, nil
+	}
+	if rf.uidPreds, err = getUidPreds(ctx, userData.namespace, parsedReq.Query); err != nil {
+		return nil, err
+	}
+	if err := addRowFiltersToQuery(parsedReq.Query, rf); err != nil {
+		return nil, status.Error(codes.PermissionDenied, err.Error())
+	}
+	return rf, nil
+}
+
+// authorizeMutationRows checks that all the existing nodes touched by the mutations match the
+// filters of the ACL rules. Nodes created by the mutations are not checked.
+func authorizeMutationRows(ctx context.Context, qc *queryContext) error {
+	rf := qc.rowFilters
+	if rf == nil {
+		return nil
+	}
+
+	// Every node must match the filter of the type rules, and the objects of a predicate must
+	// also match the filter of the predicate. For a scalar predicate, the subject must.
+	checks := make(map[string]map[uint64]struct{})
+	addUid := func(filter, node string) {
+		if filter == "" || strings.HasPrefix(node, "_:") {
+			return
+		}
+		uid, err := strconv.ParseUint(node, 0, 64)
+		if err != nil || uid == 0 {
+			return
+		}
+		if _, ok := checks[filter]; !ok {
+			checks[filter] = make(map[uint64]struct{})
+		}
+		checks[filter][uid] = struct{}{}
+	}
+	for _, gmu := range qc.gmuList {
+		for _, nquads := range [][]*api.NQuad{gmu.Set, gmu.Del} {
+			for _, nq := range nquads {
+				addUid(rf.types, nq.Subject)
+				switch {
+				case nq.ObjectId == x.Star || nq.ObjectValue.GetDefaultVal() == x.Star:
+					continue
+				case nq.ObjectId == "":
+					addUid(rf.preds[nq.Predicate], nq.Subject)
+					continue
+				}
+				addUid(rf.types, nq.ObjectId)
+				addUid(rf.preds[nq.Predicate], nq.ObjectId)
+			}
+		}
+	}
+	if len(checks) == 0 {
+		return nil
+	}
+
+	filters := make([]string, 0, len(checks))
+	for filter := range checks {
+		filters = append(filters, filter)
+	}
+	sort.Strings(filters)
+
+	used := make(map[string]string)
+	var blocks strings.Builder
+	for i, filter := range filters {
+		uids := make([]string, 0, len(checks[filter]))
+		for uid := range checks[filter] {
+			uids = append(uids, fmt.Sprintf("%#x", uid))
+		}
+		for _, v := range rowFilterVarRe.FindAllString(filter, -1) {
+			val, ok := rf.vars[v]
+			if !ok {
+				return status.Errorf(codes.PermissionDenied,
+					"unknown variable %s in the filter of an ACL rule", v)
+			}
+			used[v] = val
+		}
+		x.Check2(fmt.Fprintf(&blocks, "  f%d(func: uid(%s)) @filter(%s) { count(uid) }\n",
+			i, strings.Join(uids, ", "), filter))
+	}
+	decls := make([]string, 0, len(used))
+	for v := range used {
+		decls = append(decls, v+": string")
+	}
+	sort.Strings(decls)
+	q := fmt.Sprintf("query rowCheck(%s) {\n%s}", strings.Join(decls, ", "), blocks.String())
+	if len(decls) == 0 {
+		q = fmt.Sprintf("{\n%s}", blocks.String())
+	}
+
+	resp, err := (&Server{}).doQuery(ctx, &Request{
+		req: &api.Request{
+			Query:    q,
+			Vars:     used,
+			StartTs:  qc.req.StartTs,
+			ReadOnly: true,
+		},
+		doAuth: NoAuthorize,
+	})
+	if err != nil {
+		return errors.Wrapf(err, "while checking the filters of the ACL rules")
+	}
+
+	var counts map[string][]struct {
+		Count int `json:"count"`
+	}
+	if err := json.Unmarshal(resp.GetJson(), &counts); err != nil {
+		return errors.Wrapf(err, "while checking the filters of the ACL rules")
+	}
+	for i, filter := range filters {
+		var count int
+		if res := counts[fmt.Sprintf("f%d", i)]; len(res) > 0 {
+			count = res[0].Count
+		}
+		if count != len(checks[filter]) {
+			return status.Errorf(codes.PermissionDenied,
+				"unauthorized to mutate nodes that don't match the filter: %s", filter)
+		}
+	}
+	return nil
+}
@@ -6,13 +6,17 @@
 package edgraph
 
 import (
+	"context"
 	"testing"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
 
+	"github.com/dgraph-io/dgo/v250/protos/api"
 	"github.com/hypermodeinc/dgraph/v25/acl"
+	"github.com/hypermodeinc/dgraph/v25/dql"
 	"github.com/hypermodeinc/dgraph/v25/worker"
 	"github.com/hypermodeinc/dgraph/v25/x"
 )
@@ -106,6 +110,145 @@ func TestGetRefreshJwt(t *testing.T) {
 	}
 }
 
+func TestAddRowFiltersToQuery(t *testing.T) {
+	worker.AclCachePtr.Update(x.RootNamespace, []acl.Group{
+		{
+			GroupID: "dev",
+			Rules: []acl.Acl{
+				{Predicate: "assigned", Perm: 4, Filter: "eq(owner, $user)"},
+				{Predicate: "type:Project", Filter: `eq(team, "dev")`},
+				{Predicate: "salary", Perm: 4, Filter: "eq(manager, $user)"},
+			},
+		},
+	})
+	defer worker.AclCachePtr.Update(x.RootNamespace, nil)
+
+	require.Nil(t, getRowFilters(&userData{userId: "alice", groupIds: []string{"ops"}}))
+	rf := getRowFilters(&userData{userId: "alice", groupIds: []string{"dev"}})
+	require.NotNil(t, rf)
+	require.Equal(t, `(not type(Project) or (eq(team, "dev")))`, rf.types)
+	require.Equal(t, "(eq(owner, $user))", rf.preds["assigned"])
+
+	res, err := dql.Parse(dql.Request{Str: `{
+		me(func: has(name)) @filter(eq(name, "x")) {
+			name
+			assigned {
+				name
+			}
+		}
+		var(func: has(age)) {
+			a as age
+		}
+		total() {
+			s: sum(val(a))
+		}
+	}`})
+	require.NoError(t, err)
+	require.NoError(t, addRowFiltersToQuery(res.Query, rf))
+
+	me := res.Query[0]
+	require.Equal(t, "AND", me.Filter.Op)
+	require.Equal(t, "name", me.Filter.Child[0].Func.Attr)
+	require.Equal(t, "or", me.Filter.Child[1].Op)
+	require.Equal(t, "type", me.Filter.Child[1].Child[0].Child[0].Func.Name)
+
+	require.Nil(t, me.Children[0].Filter)
+	assigned := me.Children[1]
+	require.Equal(t, "AND", assigned.Filter.Op)
+	require.Equal(t, "or", assigned.Filter.Child[0].Op)
+	owner := assigned.Filter.Child[1].Func
+	require.Equal(t, "owner", owner.Attr)
+	require.Equal(t, "alice", owner.Args[0].Value)
+	require.Equal(t, "or", res.Query[1].Filter.Op)
+	require.Nil(t, res.Query[2].Filter)
+
+	// The uid predicates without a selection, the reverse edges and expand() are filtered too.
+	res, err = dql.Parse(dql.Request{Str: `{
+		var(func: has(name)) {
+			a as assigned
+			n: count(assigned)
+			name
+		}
+		rev(func: has(name)) {
+			~assigned {
+				name
+			}
+		}
+		all(func: uid(a)) {
+			expand(_all_) {
+				expand(_all_)
+			}
+		}
+	}`})
+	require.NoError(t, err)
+	rf.uidPreds = map[string]bool{"assigned": true}
+	require.NoError(t, addRowFiltersToQuery(res.Query, rf))
+
+	leaves := res.Query[0].Children
+	for _, leaf := range leaves[:2] {
+		require.Equal(t, "assigned", leaf.Attr)
+		require.Equal(t, "owner", leaf.Filter.Child[1].Func.Attr, leaf.Alias)
+	}
+	require.Nil(t, leaves[2].Filter)
+	rev := res.Query[1].Children[0]
+	require.Equal(t, "~assigned", rev.Attr)
+	require.Equal(t, "owner", rev.Filter.Child[1].Func.Attr)
+	expand := res.Query[2].Children[0]
+	require.Equal(t, "owner", expand.RowFilters["assigned"].Func.Attr)
+	require.Equal(t, "or", expand.RowFilters[""].Op)
+	require.Equal(t, "owner", expand.Children[0].RowFilters["assigned"].Func.Attr)
+
+	// The blocks querying a scalar predicate with a rule only return the nodes matching it.
+	res, err = dql.Parse(dql.Request{Str: `{
+		me(func: has(name)) {
+			name
+			salary
+			assigned {
+				s: salary
+				max: salary
+			}
+		}
+	}`})
+	require.NoError(t, err)
+	require.NoError(t, addRowFiltersToQuery(res.Query, rf))
+	me = res.Query[0]
+	require.Equal(t, "AND", me.Filter.Op)
+	require.Equal(t, "or", me.Filter.Child[0].Op)
+	require.Equal(t, "manager", me.Filter.Child[1].Func.Attr)
+	require.Equal(t, "alice", me.Filter.Child[1].Func.Args[0].Value)
+	require.Nil(t, me.Children[1].Filter)
+	assigned = me.Children[2]
+	require.Len(t, assigned.Filter.Child, 2)
+	require.Equal(t, "AND", assigned.Filter.Child[0].Op)
+	require.Equal(t, "owner", assigned.Filter.Child[0].Child[1].Func.Attr)
+	require.Equal(t, "manager", assigned.Filter.Child[1].Func.Attr)
+
+	rf.preds["assigned"] = "eq(owner, $unknown)"
+	require.Error(t, addRowFiltersToQuery(res.Query[:1], rf))
+	rf.preds["assigned"] = "eq(owner, "
+	require.Error(t, addRowFiltersToQuery(res.Query[:1], rf))
+}
+
+func TestAuthorizeAlterAdminSchema(t *testing.T) {
+	worker.AclCachePtr.Update(x.RootNamespace, []acl.Group{
+		{GroupID: "editors", Rules: []acl.Acl{{Predicate: acl.AdminSchema, Perm: 1}}},
+	})
+	worker.AclCachePtr.Set()
+	defer worker.AclCachePtr.Update(x.RootNamespace, nil)
+
+	token := generateJWT(x.RootNamespace, "alice", []string{"editors"},
+		time.Now().Add(time.Minute).Unix())
+	ctx := metadata.NewIncomingContext(context.Background(),
+		metadata.New(map[string]string{"accessJwt": token}))
+
+	// Schema editors can change the schema of any predicate, but not drop its data.
+	require.NoError(t, authorizeAlter(ctx, &api.Operation{Schema: "name: string @index(exact) ."}))
+	require.Error(t, authorizeAlter(ctx, &api.Operation{DropAttr: "name"}))
+	require.Error(t, authorizeAlter(ctx, &api.Operation{DropOp: api.Operation_ATTR,
+		DropValue: "name"}))
+	require.Error(t, authorizeAlter(ctx, &api.Operation{DropOp: api.Operation_DATA}))
+}
+
 func TestMain(m *testing.M) {
 	worker.Config.AclJwtAlg = jwt.SigningMethodHS256
 	x.WorkerConfig.AclJwtAlg = jwt.SigningMethodHS256
@@ -12,6 +12,7 @@ import (
 
 	"github.com/dgraph-io/dgo/v250/protos/api"
 	apiv2 "github.com/dgraph-io/dgo/v250/protos/api.v2"
+	"github.com/hypermodeinc/dgraph/v25/acl"
 	"github.com/hypermodeinc/dgraph/v25/dql"
 	"github.com/hypermodeinc/dgraph/v25/protos/pb"
 	"github.com/hypermodeinc/dgraph/v25/query"
@@ -259,8 +260,13 @@ func (s *ServerV25) Alter(ctx context.Context, req *apiv2.AlterRequest) (*apiv2.
 	glog.Infof("Received ALTER op: %+v", req)
 	defer glog.Infof("ALTER op: %+v done", req)
 
-	// For now, we only allow guadian of galaxies to do this operation in v25
-	if err := AuthSuperAdmin(ctx); err != nil {
+	// For now, we only allow guadian of galaxies to do this operation in v25. Schema editors of
+	// the galaxy are also allowed to set the schema.
+	err := AuthSuperAdmin(ctx)
+	if err != nil && req.Op == apiv2.AlterOp_SCHEMA_IN_NS {
+		err = AuthGalaxyAdmin(ctx, acl.AdminSchema)
+	}
+	if err != nil {
 		s := status.Convert(err)
 		return nil, status.Error(s.Code(),
 			"v25.Alter can only be called by the guardian of the galaxy. "+s.Message())
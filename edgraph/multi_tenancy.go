@@ -80,20 +80,37 @@ func (s *Server) ResetPassword(ctx context.Context, inp *ResetPasswordInput) err
 	return nil
 }
 
+func leaseNamespace(ctx context.Context) (uint64, error) {
+	num := &pb.Num{Val: 1, Type: pb.Num_NS_ID}
+	ids, err := worker.AssignNsIdsOverNetwork(ctx, num)
+	if err != nil {
+		return 0, errors.Wrapf(err, "Creating namespace, got error:")
+	}
+	glog.V(2).Infof("Got a lease for NsID: %d", ids.StartId)
+	return ids.StartId, nil
+}
+
 // CreateNamespaceInternal creates a new namespace. Only superadmin is authorized to do so.
 // Authorization is handled by middlewares.
 func (s *Server) CreateNamespaceInternal(ctx context.Context, passwd string) (uint64, error) {
+	return s.createNamespace(ctx, passwd, true)
+}
+
+// CreateNamespaceWithoutUsers creates a new namespace without its groot user and guardians
+// group. It is used to import an exported namespace, whose users are part of its data.
+func (s *Server) CreateNamespaceWithoutUsers(ctx context.Context) (uint64, error) {
+	return s.createNamespace(ctx, "", false)
+}
+
+func (s *Server) createNamespace(ctx context.Context, passwd string,
+	createUsers bool) (uint64, error) {
 	glog.V(2).Info("Got create namespace request.")
 
-	num := &pb.Num{Val: 1, Type: pb.Num_NS_ID}
-	ids, err := worker.AssignNsIdsOverNetwork(ctx, num)
+	ns, err := leaseNamespace(ctx)
 	if err != nil {
-		return 0, errors.Wrapf(err, "Creating namespace, got error:")
+		return 0, err
 	}
 
-	ns := ids.StartId
-	glog.V(2).Infof("Got a lease for NsID: %d", ns)
-
 	// Attach the newly leased NsID in the context in order to create guardians/groot for it.
 	ctx = x.AttachNamespace(ctx, ns)
 	m := &pb.Mutations{StartTs: worker.State.GetTimestamp(false)}
@@ -103,6 +120,10 @@ func (s *Server) CreateNamespaceInternal(ctx context.Context, passwd string) (ui
 	if err != nil {
 		return 0, err
 	}
+	if !createUsers {
+		glog.V(2).Infof("Created namespace without users: %d", ns)
+		return ns, nil
+	}
 
 	err = x.RetryUntilSuccess(10, 100*time.Millisecond, func() error {
 		return createGuardianAndGroot(ctx, passwd)
@@ -115,6 +136,64 @@ func (s *Server) CreateNamespaceInternal(ctx context.Context, passwd string) (ui
 	return ns, nil
 }
 
+// CloneNamespace copies the namespace src, as of readTs, into a new namespace. The copy has the
+// data, schema and types of the namespace, which includes its GraphQL schema and its ACL users
+// and groups. A readTs of 0 clones the latest state of the namespace, an older readTs must still
+// be within the retained history of every group. Only superadmin is authorized to do so.
+// Authorization is handled by middlewares.
+//
+// There is no rename: the namespace is part of every key, so a rename is a clone followed by the
+// deletion of the original. A namespace is moved to another cluster with an export of it, loaded
+// into a namespace created there without users, see CreateNamespaceWithoutUsers.
+func (s *Server) CloneNamespace(ctx context.Context, src, readTs uint64) (uint64, error) {
+	glog.Infof("Got clone namespace request for namespace %#x at ts %d", src, readTs)
+	if _, ok := schema.State().Namespaces()[src]; !ok {
+		return 0, errors.Errorf("error cloning non-existing namespace %#x", src)
+	}
+
+	// The copy is written at a fresh timestamp, like a predicate move, so that it is above
+	// everything read from the source namespace.
+	ts, err := worker.Timestamps(ctx, &pb.Num{Val: 1})
+	if err != nil {
+		return 0, errors.Wrapf(err, "Cloning namespace, got error:")
+	}
+	txnTs := ts.StartId
+	if readTs == 0 {
+		readTs = txnTs - 1
+	}
+	if readTs >= txnTs {
+		return 0, errors.Errorf("cannot clone namespace %#x at ts %d, the latest ts is %d",
+			src, readTs, txnTs-1)
+	}
+
+	ns, err := leaseNamespace(ctx)
+	if err != nil {
+		return 0, err
+	}
+	clone := func() error {
+		if err := worker.CloneNamespaceOverNetwork(ctx, src, ns, readTs, txnTs); err != nil {
+			return err
+		}
+		types, err := worker.ReadNamespaceTypes(ctx, src, ns, readTs)
+		if err != nil {
+			return err
+		}
+		m := &pb.Mutations{StartTs: worker.State.GetTimestamp(false), Types: types}
+		_, err = query.ApplyMutations(x.AttachNamespace(ctx, ns), m)
+		return err
+	}
+	if err := clone(); err != nil {
+		// Don't leave a partial copy behind.
+		if derr := worker.ProcessDeleteNsRequest(ctx, ns); derr != nil {
+			glog.Errorf("While deleting partial clone %#x of namespace %#x: %v", ns, src, derr)
+		}
+		return 0, errors.Wrapf(err, "Cloning namespace %#x", src)
+	}
+
+	glog.Infof("Cloned namespace %#x at ts %d into namespace %#x", src, readTs, ns)
+	return ns, nil
+}
+
 // This function is used while creating new namespace. New namespace creation is only allowed
 // by the guardians of the galaxy group.
 func createGuardianAndGroot(ctx context.Context, passwd string) error {
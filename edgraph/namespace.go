@@ -21,6 +21,7 @@ import (
 
 	"github.com/dgraph-io/dgo/v250/protos/api"
 	apiv2 "github.com/dgraph-io/dgo/v250/protos/api.v2"
+	"github.com/hypermodeinc/dgraph/v25/acl"
 	"github.com/hypermodeinc/dgraph/v25/schema"
 	"github.com/hypermodeinc/dgraph/v25/x"
 )
@@ -79,7 +80,7 @@ func (s *ServerV25) SignInUser(ctx context.Context,
 func (s *ServerV25) CreateNamespace(ctx context.Context, in *apiv2.CreateNamespaceRequest) (
 	*apiv2.CreateNamespaceResponse, error) {
 
-	if err := AuthSuperAdmin(ctx); err != nil {
+	if err := AuthGalaxyAdmin(ctx, acl.AdminNamespace); err != nil {
 		s := status.Convert(err)
 		return nil, status.Error(s.Code(),
 			"Non superadmin user cannot create namespace. "+s.Message())
@@ -118,7 +119,7 @@ func (s *ServerV25) CreateNamespace(ctx context.Context, in *apiv2.CreateNamespa
 func (s *ServerV25) DropNamespace(ctx context.Context, in *apiv2.DropNamespaceRequest) (
 	*apiv2.DropNamespaceResponse, error) {
 
-	if err := AuthSuperAdmin(ctx); err != nil {
+	if err := AuthGalaxyAdmin(ctx, acl.AdminNamespace); err != nil {
 		s := status.Convert(err)
 		return nil, status.Error(s.Code(),
 			"Non superadmin user cannot drop namespace. "+s.Message())
@@ -173,7 +174,7 @@ func (s *ServerV25) DropNamespace(ctx context.Context, in *apiv2.DropNamespaceRe
 func (s *ServerV25) UpdateNamespace(ctx context.Context, in *apiv2.UpdateNamespaceRequest) (
 	*apiv2.UpdateNamespaceResponse, error) {
 
-	if err := AuthSuperAdmin(ctx); err != nil {
+	if err := AuthGalaxyAdmin(ctx, acl.AdminNamespace); err != nil {
 		s := status.Convert(err)
 		return nil, status.Error(s.Code(),
 			"Non superadmin user cannot rename a namespace. "+s.Message())
@@ -202,7 +203,7 @@ func (s *ServerV25) UpdateNamespace(ctx context.Context, in *apiv2.UpdateNamespa
 func (s *ServerV25) ListNamespaces(ctx context.Context, in *apiv2.ListNamespacesRequest) (
 	*apiv2.ListNamespacesResponse, error) {
 
-	if err := AuthSuperAdmin(ctx); err != nil {
+	if err := AuthGalaxyAdmin(ctx, acl.AdminNamespace); err != nil {
 		s := status.Convert(err)
 		return nil, status.Error(s.Code(),
 			"Non superadmin user cannot list namespaces. "+s.Message())
@@ -36,6 +36,7 @@ import (
 	"github.com/dgraph-io/dgo/v250"
 	"github.com/dgraph-io/dgo/v250/protos/api"
 	apiv2 "github.com/dgraph-io/dgo/v250/protos/api.v2"
+	"github.com/hypermodeinc/dgraph/v25/acl"
 	"github.com/hypermodeinc/dgraph/v25/chunker"
 	"github.com/hypermodeinc/dgraph/v25/conn"
 	"github.com/hypermodeinc/dgraph/v25/dql"
@@ -208,6 +209,9 @@ func validateAlterOperation(ctx context.Context, op *api.Operation) error {
 	if !isMutationAllowed(ctx) {
 		return errors.Errorf("No mutations allowed by server.")
 	}
+	if err := worker.CheckWritable(); err != nil {
+		return err
+	}
 
 	if _, err := hasAdminAuth(ctx, "Alter"); err != nil {
 		glog.Warningf("Alter denied with error: %v\n", err)
@@ -284,6 +288,12 @@ func parseSchemaFromAlterOperation(ctx context.Context, sch string) (
 				" which is reserved as the namespace for dgraph's internal types/predicates.",
 				x.ParseAttr(update.Predicate))
 		}
+		if key := update.EncryptionKey; key != "" {
+			if _, ok := x.WorkerConfig.FieldKeys[key]; !ok {
+				return nil, errors.Errorf("Field key %q of predicate %s is not configured",
+					key, x.ParseAttr(update.Predicate))
+			}
+		}
 	}
 
 	types := make(map[string]struct{})
@@ -534,6 +544,9 @@ func (s *Server) doMutate(ctx context.Context, qc *queryContext, resp *api.Respo
 	if !isMutationAllowed(ctx) {
 		return errors.Errorf("no mutations allowed")
 	}
+	if err := worker.CheckWritable(); err != nil {
+		return err
+	}
 
 	// update mutations from the query results before assigning UIDs
 	if err := updateMutations(qc); err != nil {
@@ -544,6 +557,10 @@ func (s *Server) doMutate(ctx context.Context, qc *queryContext, resp *api.Respo
 		return err
 	}
 
+	if err := authorizeMutationRows(ctx, qc); err != nil {
+		return err
+	}
+
 	newUids, err := query.AssignUids(ctx, qc.gmuList)
 	if err != nil {
 		return err
@@ -1046,6 +1063,9 @@ type queryContext struct {
 	// uniqueVar stores the mapping between the indexes of gmuList and gmu.Set,
 	// along with their respective uniqueQueryVariables.
 	uniqueVars map[uint64]uniquePredMeta
+	// rowFilters stores the filters of the ACL rules of the user. They are used to authorize
+	// the nodes touched by the mutations. It is nil if there are none.
+	rowFilters *rowFilters
 }
 
 // Request represents a query request sent to the doQuery() method on the Server.
@@ -1067,7 +1087,7 @@ func (s *Server) Health(ctx context.Context, all bool) (*api.Response, error) {
 
 	var healthAll []pb.HealthInfo
 	if all {
-		if err := AuthorizeGuardians(ctx); err != nil {
+		if err := AuthorizeAdmin(ctx, acl.AdminCluster); err != nil {
 			return nil, err
 		}
 		pool := conn.GetPools().GetAll()
@@ -1134,7 +1154,7 @@ func (s *Server) State(ctx context.Context) (*api.Response, error) {
 		return nil, ctx.Err()
 	}
 
-	if err := AuthorizeGuardians(ctx); err != nil {
+	if err := AuthorizeAdmin(ctx, acl.AdminCluster); err != nil {
 		return nil, err
 	}
 
@@ -1146,6 +1166,7 @@ func (s *Server) State(ctx context.Context) (*api.Response, error) {
 	if err := filterTablets(ctx, ms); err != nil {
 		return nil, err
 	}
+	ms.RedactSchedules()
 
 	m := protojson.MarshalOptions{EmitUnpopulated: true}
 	jsonState, err := m.Marshal(ms)
@@ -1326,6 +1347,19 @@ func (s *Server) doQuery(ctx context.Context, req *Request) (resp *api.Response,
 		if rerr = authorizeRequest(ctx, qc); rerr != nil {
 			return
 		}
+		if ctx, rerr = authorizeFieldKeys(ctx); rerr != nil {
+			return
+		}
+	}
+	// The internal requests, like the ones of the logins and of the ACL refresh, don't count
+	// against the quota, a namespace over it must still be able to log in.
+	if ns, err := x.ExtractNamespace(ctx); err == nil && ns != x.RootNamespace &&
+		req.doAuth == NeedAuthorize {
+		release, err := worker.AcquireQuota(ns, isQuery, isMutation)
+		if err != nil {
+			return nil, err
+		}
+		defer release()
 	}
 
 	// We use defer here because for queries, startTs will be
@@ -1414,6 +1448,22 @@ func processQuery(ctx context.Context, qc *queryContext) (*api.Response, error)
 		qc.span.AddEvent("", trace.WithAttributes(attribute.Bool("no", true)))
 	}
 
+	if asOf := qc.dqlRes.AsOf; asOf != 0 {
+		// @asof reads the graph at an older timestamp, so it can't take part in a txn.
+		switch {
+		case len(qc.gmuList) > 0:
+			return resp, errors.Errorf("@asof cannot be used in a request with mutations")
+		case qc.req.StartTs != 0:
+			return resp, errors.Errorf("@asof cannot be used inside a transaction")
+		}
+		if err := worker.ValidateAsOfTs(asOf); err != nil {
+			return resp, err
+		}
+		ctx = worker.WithAsOf(ctx)
+		qc.req.StartTs = asOf
+		qr.Cache = worker.NoCache
+	}
+
 	if qc.req.BestEffort {
 		// Sanity: check that request is read-only too.
 		if !qc.req.ReadOnly {
@@ -1423,6 +1473,27 @@ func processQuery(ctx context.Context, qc *queryContext) (*api.Response, error)
 			qc.req.StartTs = posting.Oracle().MaxAssigned()
 		}
 		qr.Cache = worker.NoCache
+		ctx = worker.WithStaleRead(ctx)
+	}
+
+	// A query with a staleness bound reads at the max known transaction ts too, as long as this
+	// alpha, say a learner in another region, isn't staler than the bound. Otherwise it falls
+	// back to getting TS from Zero.
+	maxStaleness, err := x.ExtractMaxStaleness(ctx)
+	if err != nil {
+		return resp, err
+	}
+	if maxStaleness > 0 {
+		if !qc.req.ReadOnly {
+			return resp, errors.Errorf("A query with a max staleness must be read-only.")
+		}
+		if qc.req.StartTs == 0 {
+			if ts, ok := worker.StaleReadTs(maxStaleness); ok {
+				qc.req.StartTs = ts
+				qr.Cache = worker.NoCache
+			}
+		}
+		ctx = worker.WithStaleRead(ctx)
 	}
 
 	if qc.req.StartTs == 0 {
@@ -1792,7 +1863,9 @@ func authorizeRequest(ctx context.Context, qc *queryContext) error {
 		}
 	}
 
-	return nil
+	var err error
+	qc.rowFilters, err = authorizeRows(ctx, &qc.dqlRes)
+	return err
 }
 
 func getHash(ns, startTs uint64) string {
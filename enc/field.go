@@ -0,0 +1,101 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package enc
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"strings"
+
+	"github.com/pkg/errors"
+
+	"github.com/hypermodeinc/dgraph/v25/x"
+)
+
+// fieldPrefix is the prefix of the values encrypted by EncryptField. The version lets the format
+// change without breaking the values already stored.
+const fieldPrefix = "dgenc1:"
+
+// fieldNonceSize is the size of the nonce of AES-GCM.
+const fieldNonceSize = 12
+
+// fieldCipher derives the AES-GCM cipher and the MAC key from a named key. The subkeys are
+// derived so that the same key material is never used both for encryption and for the MAC.
+func fieldCipher(key x.Sensitive) (cipher.AEAD, []byte, error) {
+	derive := func(label string) []byte {
+		h := hmac.New(sha256.New, key)
+		x.Check2(h.Write([]byte(label)))
+		return h.Sum(nil)
+	}
+	block, err := aes.NewCipher(derive("dgraph field encryption"))
+	if err != nil {
+		return nil, nil, err
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, nil, err
+	}
+	return aead, derive("dgraph field nonce"), nil
+}
+
+// EncryptField encrypts the value of an @encrypted predicate with the named key.
+//
+// The encryption is deterministic: the nonce is a MAC of the value, so that equal values have
+// equal ciphertexts. This is what allows the exact and hash indexes and the eq function to work
+// on the stored values without decrypting them, at the cost of revealing which values are equal.
+func EncryptField(name string, key x.Sensitive, value []byte) (string, error) {
+	aead, macKey, err := fieldCipher(key)
+	if err != nil {
+		return "", errors.Wrapf(err, "while encrypting with field key %q", name)
+	}
+	mac := hmac.New(sha256.New, macKey)
+	x.Check2(mac.Write([]byte(name)))
+	x.Check2(mac.Write([]byte{0}))
+	x.Check2(mac.Write(value))
+	nonce := mac.Sum(nil)[:fieldNonceSize]
+
+	out := aead.Seal(nonce, nonce, value, []byte(name))
+	return fieldPrefix + name + ":" + base64.RawURLEncoding.EncodeToString(out), nil
+}
+
+// DecryptField decrypts a value returned by EncryptField.
+func DecryptField(key x.Sensitive, value string) ([]byte, error) {
+	name, data, ok := parseField(value)
+	if !ok {
+		return nil, errors.Errorf("value is not encrypted with a field key")
+	}
+	raw, err := base64.RawURLEncoding.DecodeString(data)
+	if err != nil || len(raw) < fieldNonceSize {
+		return nil, errors.Errorf("invalid value encrypted with field key %q", name)
+	}
+	aead, _, err := fieldCipher(key)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while decrypting with field key %q", name)
+	}
+	plain, err := aead.Open(nil, raw[:fieldNonceSize], raw[fieldNonceSize:], []byte(name))
+	if err != nil {
+		return nil, errors.Errorf("unable to decrypt value with field key %q", name)
+	}
+	return plain, nil
+}
+
+// FieldKeyName returns the name of the key a value was encrypted with by EncryptField. It
+// returns false if the value isn't encrypted.
+func FieldKeyName(value string) (string, bool) {
+	name, _, ok := parseField(value)
+	return name, ok
+}
+
+func parseField(value string) (string, string, bool) {
+	rest, ok := strings.CutPrefix(value, fieldPrefix)
+	if !ok {
+		return "", "", false
+	}
+	return strings.Cut(rest, ":")
+}
@@ -0,0 +1,58 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package enc
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hypermodeinc/dgraph/v25/x"
+)
+
+func TestFieldEncryption(t *testing.T) {
+	key := x.Sensitive("0123456789abcdef0123456789abcdef")
+
+	val, err := EncryptField("pii", key, []byte("123-45-6789"))
+	require.NoError(t, err)
+	require.True(t, strings.HasPrefix(val, "dgenc1:pii:"))
+	require.NotContains(t, val, "123-45-6789")
+
+	name, ok := FieldKeyName(val)
+	require.True(t, ok)
+	require.Equal(t, "pii", name)
+	_, ok = FieldKeyName("123-45-6789")
+	require.False(t, ok)
+
+	plain, err := DecryptField(key, val)
+	require.NoError(t, err)
+	require.Equal(t, "123-45-6789", string(plain))
+
+	// Equal values have equal ciphertexts, so that they can be indexed and compared.
+	again, err := EncryptField("pii", key, []byte("123-45-6789"))
+	require.NoError(t, err)
+	require.Equal(t, val, again)
+	other, err := EncryptField("pii", key, []byte("987-65-4321"))
+	require.NoError(t, err)
+	require.NotEqual(t, val, other)
+
+	// The same value encrypted with another key name doesn't match.
+	renamed, err := EncryptField("pii-v2", key, []byte("123-45-6789"))
+	require.NoError(t, err)
+	require.NotEqual(t, strings.TrimPrefix(val, "dgenc1:pii:"),
+		strings.TrimPrefix(renamed, "dgenc1:pii-v2:"))
+
+	_, err = DecryptField(x.Sensitive("fedcba9876543210fedcba9876543210"), val)
+	require.ErrorContains(t, err, "unable to decrypt")
+
+	// The key name is authenticated.
+	_, err = DecryptField(key, strings.Replace(val, ":pii:", ":pii-v2:", 1))
+	require.ErrorContains(t, err, "unable to decrypt")
+
+	_, err = DecryptField(key, "dgenc1:pii:!!")
+	require.ErrorContains(t, err, "invalid value")
+}
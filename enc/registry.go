@@ -0,0 +1,60 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package enc
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+
+	"github.com/dgraph-io/badger/v4"
+	"github.com/hypermodeinc/dgraph/v25/x"
+)
+
+// The key registry of Badger holds the data keys that encrypt the files of the store, each of
+// them wrapped with the master key. Rotating the master key only needs the data keys to be
+// wrapped again, the data itself stays as it is. A running Badger keeps the registry open and
+// appends the data keys it creates to it, so the registry is only rewritten before the store is
+// opened: to rotate the key of an alpha, restart it with the new key, and with the previous key
+// among the old keys, whether they are read from files or from Vault.
+
+// RotateKeyRegistry wraps the data keys of the key registry in dir with key, if they are wrapped
+// with one of the keys of the ring. It must be called before the store in dir is opened.
+func RotateKeyRegistry(dir string, key x.Sensitive, ring []x.Sensitive) error {
+	if len(key) == 0 {
+		return nil
+	}
+	if _, err := os.Stat(filepath.Join(dir, badger.KeyRegistryFileName)); os.IsNotExist(err) {
+		return nil
+	}
+
+	opt := badger.KeyRegistryOptions{Dir: dir, ReadOnly: true}
+	for _, k := range append([]x.Sensitive{key}, ring...) {
+		opt.EncryptionKey = k
+		kr, err := badger.OpenKeyRegistry(opt)
+		switch {
+		case errors.Is(err, badger.ErrEncryptionKeyMismatch):
+			continue
+		case err != nil:
+			return errors.Wrapf(err, "while reading key registry in %s", dir)
+		case bytes.Equal(k, key):
+			return nil
+		}
+
+		opt.EncryptionKey = key
+		if err := badger.WriteKeyRegistry(kr, opt); err != nil {
+			return errors.Wrapf(err, "while rotating key registry in %s", dir)
+		}
+		glog.Infof("Rotated the encryption key of the key registry in %s from key %s to key %s",
+			dir, x.KeyFingerprint(k), x.KeyFingerprint(key))
+		return nil
+	}
+	return errors.Errorf("key registry in %s is not encrypted with the encryption key or any of "+
+		"the old keys", dir)
+}
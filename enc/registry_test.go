@@ -0,0 +1,98 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package enc
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dgraph-io/badger/v4"
+	"github.com/hypermodeinc/dgraph/v25/x"
+)
+
+func openEncryptedDB(t *testing.T, dir string, key x.Sensitive) (*badger.DB, error) {
+	opt := badger.DefaultOptions(dir).
+		WithEncryptionKey(key).
+		WithEncryptionKeyRotationDuration(time.Millisecond).
+		WithIndexCacheSize(1 << 20).
+		WithLogger(nil)
+	return badger.Open(opt)
+}
+
+func writeKeys(t *testing.T, db *badger.DB, prefix string, n int) {
+	for i := 0; i < n; i++ {
+		require.NoError(t, db.Update(func(txn *badger.Txn) error {
+			return txn.Set([]byte(fmt.Sprintf("%s-%d", prefix, i)), []byte(prefix))
+		}))
+	}
+	// Flush the memtable, so that tables are written with new data keys.
+	require.NoError(t, db.DropPrefix([]byte("none")))
+}
+
+func checkKeys(t *testing.T, db *badger.DB, prefix string, n int) {
+	require.NoError(t, db.View(func(txn *badger.Txn) error {
+		for i := 0; i < n; i++ {
+			item, err := txn.Get([]byte(fmt.Sprintf("%s-%d", prefix, i)))
+			if err != nil {
+				return err
+			}
+			val, err := item.ValueCopy(nil)
+			if err != nil {
+				return err
+			}
+			require.Equal(t, prefix, string(val))
+		}
+		return nil
+	}))
+}
+
+func TestRotateKeyRegistry(t *testing.T) {
+	dir := t.TempDir()
+	keyA := x.Sensitive("0123456789abcdef")
+	keyB := x.Sensitive("0123456789abcdef0123456789abcdef")
+	keyC := x.Sensitive("fedcba9876543210")
+
+	// There's nothing to rotate before the store is created.
+	require.NoError(t, RotateKeyRegistry(dir, keyB, []x.Sensitive{keyA}))
+
+	db, err := openEncryptedDB(t, dir, keyA)
+	require.NoError(t, err)
+	writeKeys(t, db, "before", 10)
+	require.NoError(t, db.Close())
+
+	// The store is rotated on startup when its key is in the ring.
+	require.ErrorContains(t, RotateKeyRegistry(dir, keyB, []x.Sensitive{keyC}),
+		"not encrypted with the encryption key or any of the old keys")
+	require.NoError(t, RotateKeyRegistry(dir, keyB, []x.Sensitive{keyC, keyA}))
+	_, err = openEncryptedDB(t, dir, keyA)
+	require.Error(t, err)
+	db, err = openEncryptedDB(t, dir, keyB)
+	require.NoError(t, err)
+	checkKeys(t, db, "before", 10)
+	time.Sleep(10 * time.Millisecond)
+	writeKeys(t, db, "after", 10)
+	require.NoError(t, db.Close())
+
+	// Nothing changes once it's wrapped with the key.
+	info, err := os.Stat(filepath.Join(dir, badger.KeyRegistryFileName))
+	require.NoError(t, err)
+	require.NoError(t, RotateKeyRegistry(dir, keyB, []x.Sensitive{keyA}))
+	info2, err := os.Stat(filepath.Join(dir, badger.KeyRegistryFileName))
+	require.NoError(t, err)
+	require.Equal(t, info.ModTime(), info2.ModTime())
+
+	require.NoError(t, RotateKeyRegistry(dir, keyC, []x.Sensitive{keyB}))
+	db, err = openEncryptedDB(t, dir, keyC)
+	require.NoError(t, err)
+	checkKeys(t, db, "before", 10)
+	checkKeys(t, db, "after", 10)
+	require.NoError(t, db.Close())
+}
@@ -18,6 +18,7 @@ import (
 
 	badgerpb "github.com/dgraph-io/badger/v4/pb"
 	"github.com/dgraph-io/ristretto/v2/z"
+	"github.com/hypermodeinc/dgraph/v25/acl"
 	"github.com/hypermodeinc/dgraph/v25/edgraph"
 	"github.com/hypermodeinc/dgraph/v25/graphql/resolve"
 	"github.com/hypermodeinc/dgraph/v25/graphql/schema"
@@ -229,12 +230,30 @@ const (
 		Set to true to allow backing up to S3 or Minio bucket that requires no credentials.
 		"""
 		anonymous: Boolean
+
+		"""
+		Limit on the bytes per second read from the store by each group. 0 means no limit.
+		"""
+		readBytesPerSec: Int
+
+		"""
+		Limit on the bytes per second written to the destination by each group. 0 means no limit.
+		"""
+		writeBytesPerSec: Int
 	}
 
 	input TaskInput {
 		id: String!
 	}
 
+	input TasksInput {
+		"""
+		Name of the schedule to list the runs of. The runs of every schedule are listed if it's
+		not given.
+		"""
+		schedule: String
+	}
+
 	type Response {
 		code: String
 		message: String
@@ -257,6 +276,23 @@ const (
 		kind: TaskKind
 		status: TaskStatus
 		lastUpdated: DateTime
+
+		"""
+		ID of the task. Only set for the runs of a schedule.
+		"""
+		id: String
+
+		"""
+		Name of the schedule of the task, and the time it was scheduled at. Only set for the runs
+		of a schedule.
+		"""
+		schedule: String
+		scheduledAt: DateTime
+
+		"""
+		Why the run of a schedule wasn't queued, e.g. because its previous run was still running.
+		"""
+		error: String
 	}
 
 	enum TaskStatus {
@@ -312,6 +348,10 @@ const (
 		response: Response
 	}
 
+	type DecommissionPayload {
+		response: Response
+	}
+
 	input MoveTabletInput {
 		"""
 		Namespace in which the predicate exists.
@@ -333,6 +373,70 @@ const (
 		response: Response
 	}
 
+	input SplitTabletInput {
+		"""
+		Namespace in which the predicate exists.
+		"""
+		namespace: UInt64
+
+		"""
+		Name of the predicate to split.
+		"""
+		tablet: String!
+
+		"""
+		IDs of the groups that serve sub-tablets of the predicate, along with the group serving
+		it now. The predicate is split by the hash of the subject UIDs, and its reverse edges by the
+		hash of the object UIDs.
+		"""
+		groups: [UInt64!]!
+	}
+
+	type SplitTabletPayload {
+		response: Response
+	}
+
+	input SetTabletPlacementInput {
+		"""
+		Namespace in which the predicate exists.
+		"""
+		namespace: UInt64
+
+		"""
+		Name of the predicate to place.
+		"""
+		tablet: String!
+
+		"""
+		ID of the group the predicate is kept in. Not pinned if not given.
+		"""
+		pinnedGroup: UInt64
+
+		"""
+		Predicates with the same colocation are kept in the same group.
+		"""
+		colocation: String
+	}
+
+	type SetTabletPlacementPayload {
+		response: Response
+	}
+
+	input PromoteClusterInput {
+		"""
+		Address of a Zero of the secondary cluster to replicate to. Not replicated if not given.
+		"""
+		target: String
+	}
+
+	type PromoteClusterPayload {
+		response: Response
+	}
+
+	type DemoteClusterPayload {
+		response: Response
+	}
+
 	enum AssignKind {
 		UID
 		TIMESTAMP
@@ -380,6 +484,11 @@ const (
 		state: MembershipState
 		config: Config
 		task(input: TaskInput!): TaskPayload
+
+		"""
+		List the latest runs of the scheduled jobs, from the latest.
+		"""
+		tasks(input: TasksInput): [TaskPayload]
 		` + adminQueries + `
 	}
 
@@ -418,11 +527,44 @@ const (
 		"""
 		removeNode(input: RemoveNodeInput!): RemoveNodePayload
 
+		"""
+		Remove a node from the cluster gracefully. The node stops accepting requests, hands the
+		leadership of its group over, applies the committed entries and is removed from its
+		group. If it's the last member of its group, the tablets of the group are moved to the
+		other groups first.
+		"""
+		decommission(input: RemoveNodeInput!): DecommissionPayload
+
 		"""
 		Move a predicate from one group to another.
 		"""
 		moveTablet(input: MoveTabletInput!): MoveTabletPayload
 
+		"""
+		Split a predicate across groups by the hash of the subject UIDs, and its reverse edges by
+		the hash of the object UIDs.
+		"""
+		splitTablet(input: SplitTabletInput!): SplitTabletPayload
+
+		"""
+		Pin a predicate to a group, or colocate it with other predicates. Zero moves the
+		predicate in the next rebalance. Without pinnedGroup and colocation, the placement of the
+		predicate is removed.
+		"""
+		setTabletPlacement(input: SetTabletPlacementInput!): SetTabletPlacementPayload
+
+		"""
+		Make this cluster the primary of a cross-cluster replication. It accepts writes, and
+		ships them asynchronously to the secondary cluster at the target.
+		"""
+		promoteCluster(input: PromoteClusterInput): PromoteClusterPayload
+
+		"""
+		Make this cluster a replication secondary, which only accepts the writes replicated from
+		its primary.
+		"""
+		demoteCluster: DemoteClusterPayload
+
 		"""
 		Lease UIDs, Timestamps or Namespace IDs in advance.
 		"""
@@ -434,20 +576,6 @@ const (
 )
 
 var (
-	// gogQryMWs are the middlewares which should be applied to queries served by
-	// admin server for superadmin unless some exceptional behaviour is required
-	gogQryMWs = resolve.QueryMiddlewares{
-		resolve.IpWhitelistingMW4Query,
-		resolve.GuardianOfTheGalaxyAuthMW4Query,
-		resolve.LoggingMWQuery,
-	}
-	// gogMutMWs are the middlewares which should be applied to mutations
-	// served by admin server for superadmin unless some exceptional behaviour is required
-	gogMutMWs = resolve.MutationMiddlewares{
-		resolve.IpWhitelistingMW4Mutation,
-		resolve.GuardianOfTheGalaxyAuthMW4Mutation,
-		resolve.LoggingMWMutation,
-	}
 	// gogAclMutMWs are the middlewares which should be applied to mutations
 	// served by the admin server for superadmin with ACL enabled.
 	gogAclMutMWs = resolve.MutationMiddlewares{
@@ -456,20 +584,6 @@ var (
 		resolve.GuardianOfTheGalaxyAuthMW4Mutation,
 		resolve.LoggingMWMutation,
 	}
-	// stdAdminQryMWs are the middlewares which should be applied to queries served by admin
-	// server unless some exceptional behaviour is required
-	stdAdminQryMWs = resolve.QueryMiddlewares{
-		resolve.IpWhitelistingMW4Query, // good to apply ip whitelisting before Guardian auth
-		resolve.GuardianAuthMW4Query,
-		resolve.LoggingMWQuery,
-	}
-	// stdAdminMutMWs are the middlewares which should be applied to mutations served by
-	// admin server unless some exceptional behaviour is required
-	stdAdminMutMWs = resolve.MutationMiddlewares{
-		resolve.IpWhitelistingMW4Mutation, // good to apply ip whitelisting before Guardian auth
-		resolve.GuardianAuthMW4Mutation,
-		resolve.LoggingMWMutation,
-	}
 	// minimalAdminQryMWs is the minimal set of middlewares that should be applied to any query
 	// served by the admin server
 	minimalAdminQryMWs = resolve.QueryMiddlewares{
@@ -483,11 +597,15 @@ var (
 		resolve.LoggingMWMutation,
 	}
 	adminQueryMWConfig = map[string]resolve.QueryMiddlewares{
-		"health":       minimalAdminQryMWs, // dgraph checks Guardian auth for health
-		"state":        minimalAdminQryMWs, // dgraph checks Guardian auth for state
-		"config":       gogQryMWs,
-		"listBackups":  gogQryMWs,
-		"getGQLSchema": stdAdminQryMWs,
+		"health":        minimalAdminQryMWs, // dgraph checks Guardian auth for health
+		"state":         minimalAdminQryMWs, // dgraph checks Guardian auth for state
+		"config":        galaxyAdminQryMWs(acl.AdminCluster),
+		"listBackups":   galaxyAdminQryMWs(acl.AdminBackup),
+		"verifyBackups": galaxyAdminQryMWs(acl.AdminBackup),
+		"schedules":     galaxyAdminQryMWs(acl.AdminBackup),
+		"tasks":         galaxyAdminQryMWs(acl.AdminBackup),
+		"upgradeStatus": galaxyAdminQryMWs(acl.AdminCluster),
+		"getGQLSchema":  adminQryMWs(acl.AdminSchema),
 		// for queries and mutations related to User/Group, dgraph handles Guardian auth,
 		// so no need to apply GuardianAuth Middleware
 		"queryUser":      minimalAdminQryMWs,
@@ -497,20 +615,32 @@ var (
 		"getGroup":       minimalAdminQryMWs,
 	}
 	adminMutationMWConfig = map[string]resolve.MutationMiddlewares{
-		"backup":          gogMutMWs,
-		"config":          gogMutMWs,
-		"draining":        gogMutMWs,
-		"export":          stdAdminMutMWs, // dgraph handles the export for other namespaces by superadmin
-		"login":           minimalAdminMutMWs,
-		"restore":         gogMutMWs,
-		"shutdown":        gogMutMWs,
-		"removeNode":      gogMutMWs,
-		"moveTablet":      gogMutMWs,
-		"assign":          gogMutMWs,
-		"updateGQLSchema": stdAdminMutMWs,
-		"addNamespace":    gogAclMutMWs,
-		"deleteNamespace": gogAclMutMWs,
-		"resetPassword":   gogAclMutMWs,
+		"backup":   galaxyAdminMutMWs(acl.AdminBackup),
+		"config":   galaxyAdminMutMWs(acl.AdminCluster),
+		"draining": galaxyAdminMutMWs(acl.AdminCluster),
+		// dgraph handles the export for other namespaces by superadmin
+		"export":               adminMutMWs(acl.AdminBackup),
+		"login":                minimalAdminMutMWs,
+		"restore":              galaxyAdminMutMWs(acl.AdminRestore),
+		"pruneBackups":         galaxyAdminMutMWs(acl.AdminBackup),
+		"updateSchedule":       galaxyAdminMutMWs(acl.AdminBackup),
+		"deleteSchedule":       galaxyAdminMutMWs(acl.AdminBackup),
+		"finalizeUpgrade":      galaxyAdminMutMWs(acl.AdminCluster),
+		"shutdown":             galaxyAdminMutMWs(acl.AdminCluster),
+		"removeNode":           galaxyAdminMutMWs(acl.AdminCluster),
+		"decommission":         galaxyAdminMutMWs(acl.AdminCluster),
+		"moveTablet":           galaxyAdminMutMWs(acl.AdminCluster),
+		"splitTablet":          galaxyAdminMutMWs(acl.AdminCluster),
+		"setTabletPlacement":   galaxyAdminMutMWs(acl.AdminCluster),
+		"promoteCluster":       galaxyAdminMutMWs(acl.AdminCluster),
+		"demoteCluster":        galaxyAdminMutMWs(acl.AdminCluster),
+		"assign":               galaxyAdminMutMWs(acl.AdminCluster),
+		"updateGQLSchema":      adminMutMWs(acl.AdminSchema),
+		"addNamespace":         galaxyAdminAclMutMWs(acl.AdminNamespace),
+		"cloneNamespace":       galaxyAdminAclMutMWs(acl.AdminNamespace),
+		"deleteNamespace":      galaxyAdminAclMutMWs(acl.AdminNamespace),
+		"updateNamespaceQuota": galaxyAdminAclMutMWs(acl.AdminNamespace),
+		"resetPassword":        gogAclMutMWs,
 		// for queries and mutations related to User/Group, dgraph handles Guardian auth,
 		// so no need to apply GuardianAuth Middleware
 		"addUser":     minimalAdminMutMWs,
@@ -526,6 +656,57 @@ var (
 	adminServerVar *adminServer
 )
 
+// galaxyAdminQryMWs returns the middlewares for queries served by the admin server for superadmin,
+// or for the groups of the galaxy namespace that have been granted the admin permission.
+func galaxyAdminQryMWs(perm string) resolve.QueryMiddlewares {
+	return resolve.QueryMiddlewares{
+		resolve.IpWhitelistingMW4Query,
+		resolve.GalaxyAdminAuthMW4Query(perm),
+		resolve.LoggingMWQuery,
+	}
+}
+
+// galaxyAdminMutMWs returns the middlewares for mutations served by the admin server for
+// superadmin, or for the groups of the galaxy namespace that have been granted the admin
+// permission.
+func galaxyAdminMutMWs(perm string) resolve.MutationMiddlewares {
+	return resolve.MutationMiddlewares{
+		resolve.IpWhitelistingMW4Mutation,
+		resolve.GalaxyAdminAuthMW4Mutation(perm),
+		resolve.LoggingMWMutation,
+	}
+}
+
+// galaxyAdminAclMutMWs is like galaxyAdminMutMWs, for the mutations that need ACL enabled.
+func galaxyAdminAclMutMWs(perm string) resolve.MutationMiddlewares {
+	return resolve.MutationMiddlewares{
+		resolve.IpWhitelistingMW4Mutation,
+		resolve.AclOnlyMW4Mutation,
+		resolve.GalaxyAdminAuthMW4Mutation(perm),
+		resolve.LoggingMWMutation,
+	}
+}
+
+// adminQryMWs returns the middlewares for queries served by the admin server for guardians, or for
+// the groups that have been granted the admin permission in their namespace.
+func adminQryMWs(perm string) resolve.QueryMiddlewares {
+	return resolve.QueryMiddlewares{
+		resolve.IpWhitelistingMW4Query,
+		resolve.AdminAuthMW4Query(perm),
+		resolve.LoggingMWQuery,
+	}
+}
+
+// adminMutMWs returns the middlewares for mutations served by the admin server for guardians, or
+// for the groups that have been granted the admin permission in their namespace.
+func adminMutMWs(perm string) resolve.MutationMiddlewares {
+	return resolve.MutationMiddlewares{
+		resolve.IpWhitelistingMW4Mutation,
+		resolve.AdminAuthMW4Mutation(perm),
+		resolve.LoggingMWMutation,
+	}
+}
+
 func SchemaValidate(sch string) error {
 	schHandler, err := schema.NewHandler(sch, false)
 	if err != nil {
@@ -728,20 +909,31 @@ func newAdminResolver(
 
 func newAdminResolverFactory() resolve.ResolverFactory {
 	adminMutationResolvers := map[string]resolve.MutationResolverFunc{
-		"addNamespace":    resolveAddNamespace,
-		"backup":          resolveBackup,
-		"config":          resolveUpdateConfig,
-		"deleteNamespace": resolveDeleteNamespace,
-		"draining":        resolveDraining,
-		"export":          resolveExport,
-		"login":           resolveLogin,
-		"resetPassword":   resolveResetPassword,
-		"restore":         resolveRestore,
-		"shutdown":        resolveShutdown,
-		"removeNode":      resolveRemoveNode,
-		"moveTablet":      resolveMoveTablet,
-		"assign":          resolveAssign,
-		"restoreTenant":   resolveTenantRestore,
+		"addNamespace":         resolveAddNamespace,
+		"cloneNamespace":       resolveCloneNamespace,
+		"backup":               resolveBackup,
+		"config":               resolveUpdateConfig,
+		"deleteNamespace":      resolveDeleteNamespace,
+		"updateNamespaceQuota": resolveUpdateNamespaceQuota,
+		"draining":             resolveDraining,
+		"export":               resolveExport,
+		"login":                resolveLogin,
+		"resetPassword":        resolveResetPassword,
+		"restore":              resolveRestore,
+		"pruneBackups":         resolvePruneBackups,
+		"updateSchedule":       resolveUpdateSchedule,
+		"deleteSchedule":       resolveDeleteSchedule,
+		"finalizeUpgrade":      resolveFinalizeUpgrade,
+		"shutdown":             resolveShutdown,
+		"removeNode":           resolveRemoveNode,
+		"decommission":         resolveDecommission,
+		"moveTablet":           resolveMoveTablet,
+		"splitTablet":          resolveSplitTablet,
+		"setTabletPlacement":   resolveSetTabletPlacement,
+		"promoteCluster":       resolvePromoteCluster,
+		"demoteCluster":        resolveDemoteCluster,
+		"assign":               resolveAssign,
+		"restoreTenant":        resolveTenantRestore,
 	}
 
 	rf := resolverFactoryWithErrorMsg(errResolverNotFound).
@@ -759,9 +951,21 @@ func newAdminResolverFactory() resolve.ResolverFactory {
 		WithQueryResolver("listBackups", func(q schema.Query) resolve.QueryResolver {
 			return resolve.QueryResolverFunc(resolveListBackups)
 		}).
+		WithQueryResolver("verifyBackups", func(q schema.Query) resolve.QueryResolver {
+			return resolve.QueryResolverFunc(resolveVerifyBackups)
+		}).
 		WithQueryResolver("task", func(q schema.Query) resolve.QueryResolver {
 			return resolve.QueryResolverFunc(resolveTask)
 		}).
+		WithQueryResolver("tasks", func(q schema.Query) resolve.QueryResolver {
+			return resolve.QueryResolverFunc(resolveTasks)
+		}).
+		WithQueryResolver("schedules", func(q schema.Query) resolve.QueryResolver {
+			return resolve.QueryResolverFunc(resolveSchedules)
+		}).
+		WithQueryResolver("upgradeStatus", func(q schema.Query) resolve.QueryResolver {
+			return resolve.QueryResolverFunc(resolveUpgradeStatus)
+		}).
 		WithQueryResolver("getGQLSchema", func(q schema.Query) resolve.QueryResolver {
 			return resolve.QueryResolverFunc(
 				func(ctx context.Context, query schema.Query) *resolve.Resolved {
@@ -1064,3 +1268,17 @@ type DestinationFields struct {
 	SessionToken pb.Sensitive
 	Anonymous    bool
 }
+
+// ThrottleFields is used by both export and backup to limit the bytes per second they read from
+// the store and write to the destination, in each group.
+type ThrottleFields struct {
+	ReadBytesPerSec  int64
+	WriteBytesPerSec int64
+}
+
+func (t ThrottleFields) validate() error {
+	if t.ReadBytesPerSec < 0 || t.WriteBytesPerSec < 0 {
+		return errors.Errorf("readBytesPerSec and writeBytesPerSec can't be negative")
+	}
+	return nil
+}
@@ -20,29 +20,21 @@ import (
 
 type backupInput struct {
 	DestinationFields
+	ThrottleFields
 	ForceFull bool
 }
 
 func resolveBackup(ctx context.Context, m schema.Mutation) (*resolve.Resolved, bool) {
 	glog.Info("Got backup request")
 
-	input, err := getBackupInput(m)
+	input, err := getBackupInput(m.ArgValue(schema.InputArgName))
 	if err != nil {
 		return resolve.EmptyResult(m, err), false
 	}
-	if input.Destination == "" {
-		err := fmt.Errorf("you must specify a 'destination' value")
+	req, err := input.request()
+	if err != nil {
 		return resolve.EmptyResult(m, err), false
 	}
-
-	req := &pb.BackupRequest{
-		Destination:  input.Destination,
-		AccessKey:    input.AccessKey,
-		SecretKey:    input.SecretKey,
-		SessionToken: input.SessionToken,
-		Anonymous:    input.Anonymous,
-		ForceFull:    input.ForceFull,
-	}
 	taskId, err := worker.Tasks.Enqueue(req)
 	if err != nil {
 		return resolve.EmptyResult(m, err), false
@@ -58,8 +50,27 @@ func resolveBackup(ctx context.Context, m schema.Mutation) (*resolve.Resolved, b
 	), true
 }
 
-func getBackupInput(m schema.Mutation) (*backupInput, error) {
-	inputArg := m.ArgValue(schema.InputArgName)
+// request returns the backup request for the input.
+func (input *backupInput) request() (*pb.BackupRequest, error) {
+	if input.Destination == "" {
+		return nil, fmt.Errorf("you must specify a 'destination' value")
+	}
+	if err := input.validate(); err != nil {
+		return nil, err
+	}
+	return &pb.BackupRequest{
+		Destination:      input.Destination,
+		AccessKey:        input.AccessKey,
+		SecretKey:        input.SecretKey,
+		SessionToken:     input.SessionToken,
+		Anonymous:        input.Anonymous,
+		ForceFull:        input.ForceFull,
+		ReadBytesPerSec:  uint64(input.ReadBytesPerSec),
+		WriteBytesPerSec: uint64(input.WriteBytesPerSec),
+	}, nil
+}
+
+func getBackupInput(inputArg interface{}) (*backupInput, error) {
 	inputByts, err := json.Marshal(inputArg)
 	if err != nil {
 		return nil, schema.GQLWrapf(err, "couldn't get input argument")
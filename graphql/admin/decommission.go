@@ -0,0 +1,39 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package admin
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/golang/glog"
+
+	"github.com/hypermodeinc/dgraph/v25/graphql/resolve"
+	"github.com/hypermodeinc/dgraph/v25/graphql/schema"
+	"github.com/hypermodeinc/dgraph/v25/protos/pb"
+	"github.com/hypermodeinc/dgraph/v25/worker"
+)
+
+func resolveDecommission(ctx context.Context, m schema.Mutation) (*resolve.Resolved, bool) {
+	input, err := getRemoveNodeInput(m)
+	if err != nil {
+		return resolve.EmptyResult(m, err), false
+	}
+	glog.Infof("Got decommission request through GraphQL admin API, group: %d, node: %#x",
+		input.GroupId, input.NodeId)
+
+	if err := worker.DecommissionOverNetwork(ctx, &pb.RemoveNodeRequest{NodeId: input.NodeId,
+		GroupId: input.GroupId}); err != nil {
+		return resolve.EmptyResult(m, err), false
+	}
+
+	return resolve.DataResult(m,
+		map[string]interface{}{m.Name(): response("Success",
+			fmt.Sprintf("Decommissioned node with group: %v, idx: %v", input.GroupId,
+				input.NodeId))},
+		nil,
+	), true
+}
@@ -37,6 +37,16 @@ const adminTypes = `
 		Force a full backup instead of an incremental backup.
 		"""
 		forceFull: Boolean
+
+		"""
+		Limit on the bytes per second read from the store by each group. 0 means no limit.
+		"""
+		readBytesPerSec: Int
+
+		"""
+		Limit on the bytes per second written to the destination by each group. 0 means no limit.
+		"""
+		writeBytesPerSec: Int
 	}
 
 	type BackupPayload {
@@ -148,6 +158,19 @@ const adminTypes = `
 		Set to true to allow backing up to S3 or Minio bucket that requires no credentials.
 		"""
 		anonymous: Boolean
+
+		"""
+		Restore the data as of this timestamp, by replaying the continuous archive at the
+		location on top of the latest backup taken before it. The alphas must have archived
+		to the location with the --archive flag.
+		"""
+		untilTs: UInt64
+
+		"""
+		Restore the data as of this time, to within the archive frequency. It's resolved to the
+		latest timestamp archived by then. Only one of untilTs and untilTime can be given.
+		"""
+		untilTime: DateTime
 	}
 
 	type RestorePayload {
@@ -190,6 +213,196 @@ const adminTypes = `
 
 	}
 
+	input PruneBackupsInput {
+		"""
+		Destination of the backups: e.g. Minio or S3 bucket.
+		"""
+		location: String!
+
+		"""
+		Access key credential for the destination.
+		"""
+		accessKey: String
+
+		"""
+		Secret key credential for the destination.
+		"""
+		secretKey: String
+
+		"""
+		AWS session token, if required.
+		"""
+		sessionToken: String
+
+		"""
+		Whether the destination doesn't require credentials (e.g. S3 public bucket).
+		"""
+		anonymous: Boolean
+
+		"""
+		Number of latest backup series to keep. The latest series is always kept. At least one
+		of keepSeries, keepDays and keepWeeks is needed to delete backups.
+		"""
+		keepSeries: Int
+
+		"""
+		Keep the series with the latest backup of each of this many last days.
+		"""
+		keepDays: Int
+
+		"""
+		Keep the series with the latest backup of each of this many last weeks.
+		"""
+		keepWeeks: Int
+
+		"""
+		Only report which series would be deleted, without deleting them.
+		"""
+		dryRun: Boolean
+	}
+
+	type BackupSeries {
+		"""
+		Unique ID for the backup series.
+		"""
+		backupId: String
+
+		"""
+		Paths of the backups of the series.
+		"""
+		paths: [String]
+
+		"""
+		Whether the retention policy keeps the series.
+		"""
+		kept: Boolean
+
+		"""
+		The rule which keeps the series: latest, daily, weekly or undated.
+		"""
+		reason: String
+	}
+
+	type PruneBackupsPayload {
+		response: Response
+		series: [BackupSeries]
+	}
+
+	type NodeVersion {
+		id: UInt64
+
+		"""
+		Group of the alpha, or 0 for a Zero.
+		"""
+		groupId: UInt64
+		addr: String
+
+		"""
+		Release of the binary the node runs. Empty for the releases before it was reported.
+		"""
+		version: String
+
+		"""
+		Version of the cluster features the node supports. 0 for the releases before the features
+		were versioned.
+		"""
+		featureVersion: Int
+	}
+
+	type UpgradeStatus {
+		"""
+		Version of the features the cluster can use, supported by its lowest node. The operations
+		using a feature of a later version are refused.
+		"""
+		featureVersion: Int
+
+		"""
+		Feature version the upgrade of the cluster was last finalized at. The nodes supporting a
+		lower version can't join the cluster.
+		"""
+		finalizedFeatureVersion: Int
+
+		"""
+		Whether every node supports the same feature version, above the finalized one.
+		"""
+		safeToFinalize: Boolean
+
+		nodes: [NodeVersion]
+	}
+
+	type FinalizeUpgradePayload {
+		response: Response
+	}
+
+	input ScheduleInput {
+		"""
+		Name of the schedule. An existing schedule with this name is replaced.
+		"""
+		name: String!
+
+		"""
+		Cron expression of five fields (minute, hour, day of month, month and day of week), in
+		UTC, e.g. "0 2 * * *" for every day at 02:00. The macros @hourly, @daily, @weekly,
+		@monthly and @yearly are also accepted.
+		"""
+		cron: String!
+
+		"""
+		The backup to run. Exactly one of backup and export must be given.
+		"""
+		backup: BackupInput
+
+		"""
+		The export to run. Exactly one of backup and export must be given.
+		"""
+		export: ExportInput
+	}
+
+	input DeleteScheduleInput {
+		name: String!
+	}
+
+	type SchedulePayload {
+		response: Response
+	}
+
+	type Schedule {
+		name: String
+		cron: String
+		kind: TaskKind
+		destination: String
+		readBytesPerSec: Int
+		writeBytesPerSec: Int
+
+		"""
+		The time the job was last scheduled at.
+		"""
+		lastRun: DateTime
+	}
+
+	type BackupFile {
+		path: String
+
+		"""
+		One of ok, corrupt, missing, or unverified for the backups taken before the checksums
+		were recorded.
+		"""
+		status: String
+	}
+
+	type BackupVerification {
+		backupId: String
+		backupNum: UInt64
+		path: String
+		files: [BackupFile]
+
+		"""
+		False if a file of the backup, or of an earlier backup of its series, is missing or
+		corrupt.
+		"""
+		restorable: Boolean
+	}
+
 	type BackupGroup {
 		"""
 		The ID of the cluster group.
@@ -302,6 +515,15 @@ const adminTypes = `
 		write and modify operations.
 		"""
 		permission: Int! @dgraph(pred: "dgraph.rule.permission")
+
+		"""
+		DQL filter that restricts the nodes the group can access through the predicate, for
+		example eq(owner, $user). The variables $user and $namespace are set from the JWT of
+		the request. For a scalar predicate, it restricts the nodes whose values of the
+		predicate the group can access. If the predicate is of the form type:<TypeName>, the
+		filter restricts the nodes of the type instead.
+		"""
+		filter: String @dgraph(pred: "dgraph.rule.filter")
 	}
 
 	input StringHashFilter {
@@ -359,6 +581,11 @@ const adminTypes = `
 		write and modify operations.
 		"""
 		permission: Int!
+
+		"""
+		DQL filter that restricts the nodes the group can access through the predicate.
+		"""
+		filter: String
 	}
 
 	input UserFilter {
@@ -435,6 +662,25 @@ const adminTypes = `
 		Enter a new password for groot in that namespace. If you leave it blank, the password will be the default.
 		"""
 		password: String
+
+		"""
+		Set to true to create the namespace without its groot user and guardians group, to import
+		an exported namespace along with its users. This is how a namespace is moved to another
+		cluster: export it, then load the export into the new namespace with the live loader and
+		its --force-namespace flag.
+		"""
+		withoutUsers: Boolean
+	}
+
+	input CloneNamespaceInput {
+		namespaceId: UInt64!
+
+		"""
+		Timestamp to clone the namespace at. If you leave it blank, the latest state of the
+		namespace is cloned. An older timestamp must be within the history retained by the
+		groups, see @history.
+		"""
+		readTs: UInt64
 	}
 
 	input DeleteNamespaceInput {
@@ -446,6 +692,36 @@ const adminTypes = `
 		message: String
 	}
 
+	input NamespaceQuotaInput {
+		namespaceId: UInt64!
+
+		"""
+		Maximum size on disk of the predicates of the namespace, in bytes. Mutations are rejected
+		once it is reached.
+		"""
+		maxDiskBytes: UInt64
+
+		"""
+		Maximum number of UIDs leased for the namespace.
+		"""
+		maxUids: UInt64
+
+		"""
+		Maximum number of queries per second on each alpha.
+		"""
+		queriesPerSec: UInt64
+
+		"""
+		Maximum number of mutations per second on each alpha.
+		"""
+		mutationsPerSec: UInt64
+
+		"""
+		Maximum number of queries running at the same time on each alpha.
+		"""
+		maxConcurrentQueries: UInt64
+	}
+
 	input ResetPasswordInput {
 		userId: String!
 		password: String!
@@ -476,6 +752,29 @@ const adminMutations = `
 	"""
 	restoreTenant(input: RestoreTenantInput!) : RestorePayload
 
+	"""
+	Delete the backup series at a location which the retention policy doesn't keep, with their
+	manifest entries. A series is kept if any of the rules keeps it.
+	"""
+	pruneBackups(input: PruneBackupsInput!) : PruneBackupsPayload
+
+	"""
+	Run a backup or an export on a cron schedule. A run is skipped if the previous run of the
+	schedule is still queued or running. The runs are listed by the tasks query.
+	"""
+	updateSchedule(input: ScheduleInput!) : SchedulePayload
+
+	"""
+	Stop running a scheduled backup or export.
+	"""
+	deleteSchedule(input: DeleteScheduleInput!) : SchedulePayload
+
+	"""
+	Finalize a rolling upgrade of the cluster, once every node runs the new release. The nodes
+	of the older releases can't join the cluster afterwards, so it can't be downgraded.
+	"""
+	finalizeUpgrade: FinalizeUpgradePayload
+
 	"""
 	Login to Dgraph.  Successful login results in a JWT that can be used in future requests.
 	If login is not successful an error is returned.
@@ -518,11 +817,23 @@ const adminMutations = `
 	"""
 	addNamespace(input: AddNamespaceInput): NamespacePayload
 
+	"""
+	Clone a namespace into a new namespace, with its data, schema, GraphQL schema and ACL.
+	Namespaces can't be renamed in place, to rename one, clone it and delete the original.
+	"""
+	cloneNamespace(input: CloneNamespaceInput!): NamespacePayload
+
 	"""
 	Delete a namespace.
 	"""
 	deleteNamespace(input: DeleteNamespaceInput!): NamespacePayload
 
+	"""
+	Set the quota of a namespace. Limits that are not given or are 0 are not enforced, and a
+	quota without any limit is removed.
+	"""
+	updateNamespaceQuota(input: NamespaceQuotaInput!): NamespacePayload
+
 	"""
 	Reset password can only be used by the Guardians of the galaxy to reset password of
 	any user in any namespace.
@@ -546,4 +857,20 @@ const adminQueries = `
 	Get the information about the backups at a given location.
 	"""
 	listBackups(input: ListBackupsInput!) : [Manifest]
+
+	"""
+	Verify every file of the backups at a given location against its recorded checksum.
+	"""
+	verifyBackups(input: ListBackupsInput!) : [BackupVerification]
+
+	"""
+	List the scheduled backups and exports.
+	"""
+	schedules: [Schedule]
+
+	"""
+	Get the release and the feature version of every node, and whether the upgrade of the
+	cluster can be finalized.
+	"""
+	upgradeStatus: UpgradeStatus
 	`
@@ -27,23 +27,48 @@ type exportInput struct {
 	Format    string
 	Namespace int64
 	DestinationFields
+	ThrottleFields
 }
 
 func resolveExport(ctx context.Context, m schema.Mutation) (*resolve.Resolved, bool) {
 	glog.Info("Got export request through GraphQL admin API")
 
-	input, err := getExportInput(m)
+	input, err := getExportInput(m.ArgValue(schema.InputArgName))
 	if err != nil {
 		return resolve.EmptyResult(m, err), false
 	}
+	req, err := input.request(ctx)
+	if err != nil {
+		return resolve.EmptyResult(m, err), false
+	}
+	taskId, err := worker.Tasks.Enqueue(req)
+	if err != nil {
+		return resolve.EmptyResult(m, err), false
+	}
+
+	msg := fmt.Sprintf("Export queued with ID %#x", taskId)
+	data := response("Success", msg)
+	data["taskId"] = fmt.Sprintf("%#x", taskId)
+	return resolve.DataResult(
+		m,
+		map[string]interface{}{m.Name(): data},
+		nil,
+	), true
+}
 
+// request returns the export request for the input, checking the namespace to export against the
+// namespace of the user.
+func (input *exportInput) request(ctx context.Context) (*pb.ExportRequest, error) {
 	format := worker.DefaultExportFormat
 	if input.Format != "" {
 		format = worker.NormalizeExportFormat(input.Format)
 		if format == "" {
-			return resolve.EmptyResult(m, errors.Errorf("invalid export format: %v", input.Format)), false
+			return nil, errors.Errorf("invalid export format: %v", input.Format)
 		}
 	}
+	if err := input.validate(); err != nil {
+		return nil, err
+	}
 
 	validateAndGetNs := func(inputNs int64) (uint64, error) {
 		ns, err := x.ExtractNamespace(ctx)
@@ -68,37 +93,25 @@ func resolveExport(ctx context.Context, m schema.Mutation) (*resolve.Resolved, b
 		return ns, nil
 	}
 
-	var exportNs uint64
-	if exportNs, err = validateAndGetNs(input.Namespace); err != nil {
-		return resolve.EmptyResult(m, err), false
-	}
-
-	req := &pb.ExportRequest{
-		Format:       format,
-		Namespace:    exportNs,
-		Destination:  input.Destination,
-		AccessKey:    input.AccessKey,
-		SecretKey:    input.SecretKey,
-		SessionToken: input.SessionToken,
-		Anonymous:    input.Anonymous,
-	}
-	taskId, err := worker.Tasks.Enqueue(req)
+	exportNs, err := validateAndGetNs(input.Namespace)
 	if err != nil {
-		return resolve.EmptyResult(m, err), false
+		return nil, err
 	}
 
-	msg := fmt.Sprintf("Export queued with ID %#x", taskId)
-	data := response("Success", msg)
-	data["taskId"] = fmt.Sprintf("%#x", taskId)
-	return resolve.DataResult(
-		m,
-		map[string]interface{}{m.Name(): data},
-		nil,
-	), true
+	return &pb.ExportRequest{
+		Format:           format,
+		Namespace:        exportNs,
+		Destination:      input.Destination,
+		AccessKey:        input.AccessKey,
+		SecretKey:        input.SecretKey,
+		SessionToken:     input.SessionToken,
+		Anonymous:        input.Anonymous,
+		ReadBytesPerSec:  uint64(input.ReadBytesPerSec),
+		WriteBytesPerSec: uint64(input.WriteBytesPerSec),
+	}, nil
 }
 
-func getExportInput(m schema.Mutation) (*exportInput, error) {
-	inputArg := m.ArgValue(schema.InputArgName)
+func getExportInput(inputArg interface{}) (*exportInput, error) {
 	inputByts, err := json.Marshal(inputArg)
 	if err != nil {
 		return nil, schema.GQLWrapf(err, "couldn't get input argument")
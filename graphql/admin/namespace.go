@@ -15,11 +15,14 @@ import (
 	"github.com/hypermodeinc/dgraph/v25/edgraph"
 	"github.com/hypermodeinc/dgraph/v25/graphql/resolve"
 	"github.com/hypermodeinc/dgraph/v25/graphql/schema"
+	"github.com/hypermodeinc/dgraph/v25/protos/pb"
+	"github.com/hypermodeinc/dgraph/v25/worker"
 	"github.com/hypermodeinc/dgraph/v25/x"
 )
 
 type addNamespaceInput struct {
-	Password string
+	Password     string
+	WithoutUsers bool
 }
 
 type deleteNamespaceInput struct {
@@ -36,7 +39,12 @@ func resolveAddNamespace(ctx context.Context, m schema.Mutation) (*resolve.Resol
 		req.Password = "password"
 	}
 	var ns uint64
-	if ns, err = (&edgraph.Server{}).CreateNamespaceInternal(ctx, req.Password); err != nil {
+	if req.WithoutUsers {
+		ns, err = (&edgraph.Server{}).CreateNamespaceWithoutUsers(ctx)
+	} else {
+		ns, err = (&edgraph.Server{}).CreateNamespaceInternal(ctx, req.Password)
+	}
+	if err != nil {
 		return resolve.EmptyResult(m, err), false
 	}
 	return resolve.DataResult(
@@ -49,6 +57,25 @@ func resolveAddNamespace(ctx context.Context, m schema.Mutation) (*resolve.Resol
 	), true
 }
 
+func resolveCloneNamespace(ctx context.Context, m schema.Mutation) (*resolve.Resolved, bool) {
+	src, readTs, err := getCloneNamespaceInput(m)
+	if err != nil {
+		return resolve.EmptyResult(m, err), false
+	}
+	ns, err := (&edgraph.Server{}).CloneNamespace(ctx, src, readTs)
+	if err != nil {
+		return resolve.EmptyResult(m, err), false
+	}
+	return resolve.DataResult(
+		m,
+		map[string]interface{}{m.Name(): map[string]interface{}{
+			"namespaceId": json.Number(strconv.FormatUint(ns, 10)),
+			"message":     "Cloned namespace successfully",
+		}},
+		nil,
+	), true
+}
+
 func resolveDeleteNamespace(ctx context.Context, m schema.Mutation) (*resolve.Resolved, bool) {
 	req, err := getDeleteNamespaceInput(m)
 	if err != nil {
@@ -75,6 +102,83 @@ func resolveDeleteNamespace(ctx context.Context, m schema.Mutation) (*resolve.Re
 	), true
 }
 
+func resolveUpdateNamespaceQuota(ctx context.Context, m schema.Mutation) (*resolve.Resolved,
+	bool) {
+	q, err := getNamespaceQuotaInput(m)
+	if err != nil {
+		return resolve.EmptyResult(m, err), false
+	}
+	if q.Namespace == x.RootNamespace {
+		return resolve.EmptyResult(m, errors.New("Cannot set quota of default namespace")), false
+	}
+	if err = worker.SetNamespaceQuotaOverNetwork(ctx, q); err != nil {
+		return resolve.EmptyResult(m, err), false
+	}
+	return resolve.DataResult(
+		m,
+		map[string]interface{}{m.Name(): map[string]interface{}{
+			"namespaceId": json.Number(strconv.FormatUint(q.Namespace, 10)),
+			"message":     "Updated namespace quota successfully",
+		}},
+		nil,
+	), true
+}
+
+func getNamespaceQuotaInput(m schema.Mutation) (*pb.NamespaceQuota, error) {
+	inputArg, ok := m.ArgValue(schema.InputArgName).(map[string]interface{})
+	if !ok {
+		return nil, inputArgError(errors.New("can't convert input to map"))
+	}
+	field := func(name string) (uint64, error) {
+		val, ok := inputArg[name]
+		if !ok || val == nil {
+			return 0, nil
+		}
+		ret, err := parseAsUint64(val)
+		if err != nil {
+			return 0, inputArgError(schema.GQLWrapf(err, "can't convert input.%s to uint64",
+				name))
+		}
+		return ret, nil
+	}
+
+	q := &pb.NamespaceQuota{}
+	for name, dst := range map[string]*uint64{
+		"namespaceId":          &q.Namespace,
+		"maxDiskBytes":         &q.MaxDiskBytes,
+		"maxUids":              &q.MaxUids,
+		"queriesPerSec":        &q.QueriesPerSec,
+		"mutationsPerSec":      &q.MutationsPerSec,
+		"maxConcurrentQueries": &q.MaxConcurrentQueries,
+	} {
+		var err error
+		if *dst, err = field(name); err != nil {
+			return nil, err
+		}
+	}
+	return q, nil
+}
+
+func getCloneNamespaceInput(m schema.Mutation) (uint64, uint64, error) {
+	inputArg, ok := m.ArgValue(schema.InputArgName).(map[string]interface{})
+	if !ok {
+		return 0, 0, inputArgError(errors.New("can't convert input to map"))
+	}
+	src, err := parseAsUint64(inputArg["namespaceId"])
+	if err != nil {
+		return 0, 0, inputArgError(schema.GQLWrapf(err,
+			"can't convert input.namespaceId to uint64"))
+	}
+	var readTs uint64
+	if val, ok := inputArg["readTs"]; ok && val != nil {
+		if readTs, err = parseAsUint64(val); err != nil {
+			return 0, 0, inputArgError(schema.GQLWrapf(err,
+				"can't convert input.readTs to uint64"))
+		}
+	}
+	return src, readTs, nil
+}
+
 func getAddNamespaceInput(m schema.Mutation) (*addNamespaceInput, error) {
 	inputArg := m.ArgValue(schema.InputArgName)
 	inputByts, err := json.Marshal(inputArg)
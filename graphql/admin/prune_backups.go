@@ -0,0 +1,132 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+
+	"github.com/hypermodeinc/dgraph/v25/graphql/resolve"
+	"github.com/hypermodeinc/dgraph/v25/graphql/schema"
+	"github.com/hypermodeinc/dgraph/v25/protos/pb"
+	"github.com/hypermodeinc/dgraph/v25/worker"
+	"github.com/hypermodeinc/dgraph/v25/x"
+)
+
+type pruneBackupsInput struct {
+	Location     string
+	AccessKey    string
+	SecretKey    pb.Sensitive
+	SessionToken pb.Sensitive
+	Anonymous    bool
+	KeepSeries   int
+	KeepDays     int
+	KeepWeeks    int
+	DryRun       bool
+}
+
+func resolvePruneBackups(ctx context.Context, m schema.Mutation) (*resolve.Resolved, bool) {
+	inputByts, err := json.Marshal(m.ArgValue(schema.InputArgName))
+	if err != nil {
+		return resolve.EmptyResult(m, schema.GQLWrapf(err, "couldn't get input argument")), false
+	}
+	var input pruneBackupsInput
+	if err := json.Unmarshal(inputByts, &input); err != nil {
+		return resolve.EmptyResult(m, schema.GQLWrapf(err, "couldn't get input argument")), false
+	}
+	if input.KeepSeries < 0 || input.KeepDays < 0 || input.KeepWeeks < 0 {
+		return resolve.EmptyResult(m, inputArgError(
+			errors.Errorf("keepSeries, keepDays and keepWeeks can't be negative"))), false
+	}
+	glog.Infof("Got prune backups request, location: %v, keepSeries: %d, keepDays: %d, "+
+		"keepWeeks: %d, dryRun: %v", input.Location, input.KeepSeries, input.KeepDays,
+		input.KeepWeeks, input.DryRun)
+
+	creds := &x.MinioCredentials{
+		AccessKey:    input.AccessKey,
+		SecretKey:    input.SecretKey,
+		SessionToken: input.SessionToken,
+		Anonymous:    input.Anonymous,
+	}
+	policy := worker.RetentionPolicy{
+		KeepSeries: input.KeepSeries,
+		KeepDays:   input.KeepDays,
+		KeepWeeks:  input.KeepWeeks,
+	}
+	series, err := worker.PruneBackups(input.Location, creds, policy, input.DryRun)
+	if err != nil {
+		return resolve.EmptyResult(m, errors.Errorf("%s: %s", x.Error, err.Error())), false
+	}
+
+	var deleted int
+	res := make([]interface{}, 0, len(series))
+	for _, s := range series {
+		paths := make([]interface{}, 0, len(s.Manifests))
+		for _, m := range s.Manifests {
+			paths = append(paths, m.Path)
+		}
+		if !s.Keep {
+			deleted++
+		}
+		res = append(res, map[string]interface{}{
+			"backupId": s.BackupId,
+			"paths":    paths,
+			"kept":     s.Keep,
+			"reason":   s.Reason,
+		})
+	}
+	msg := fmt.Sprintf("Deleted %d of %d backup series", deleted, len(series))
+	if input.DryRun {
+		msg = fmt.Sprintf("Would delete %d of %d backup series", deleted, len(series))
+	}
+	payload := response("Success", msg)
+	payload["series"] = res
+	return resolve.DataResult(m, map[string]interface{}{m.Name(): payload}, nil), true
+}
+
+func resolveVerifyBackups(ctx context.Context, q schema.Query) *resolve.Resolved {
+	input, err := getLsBackupInput(q)
+	if err != nil {
+		return resolve.EmptyResult(q, err)
+	}
+	creds := &x.MinioCredentials{
+		AccessKey:    input.AccessKey,
+		SecretKey:    input.SecretKey,
+		SessionToken: input.SessionToken,
+		Anonymous:    input.Anonymous,
+	}
+	verifications, err := worker.VerifyBackups(input.Location, creds)
+	if err != nil {
+		return resolve.EmptyResult(q, errors.Errorf("%s: %s", x.Error, err.Error()))
+	}
+
+	res := make([]interface{}, 0, len(verifications))
+	for _, v := range verifications {
+		paths := make([]string, 0, len(v.Files))
+		for path := range v.Files {
+			paths = append(paths, path)
+		}
+		sort.Strings(paths)
+		files := make([]interface{}, 0, len(paths))
+		for _, path := range paths {
+			files = append(files, map[string]interface{}{"path": path, "status": v.Files[path]})
+		}
+		res = append(res, map[string]interface{}{
+			"backupId":   v.Manifest.BackupId,
+			"backupNum":  json.Number(strconv.FormatUint(v.Manifest.BackupNum, 10)),
+			"path":       v.Manifest.Path,
+			"files":      files,
+			"restorable": v.Restorable,
+		})
+	}
+	return resolve.DataResult(q, map[string]interface{}{q.Name(): res}, nil)
+}
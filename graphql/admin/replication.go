@@ -0,0 +1,52 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package admin
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/hypermodeinc/dgraph/v25/graphql/resolve"
+	"github.com/hypermodeinc/dgraph/v25/graphql/schema"
+	"github.com/hypermodeinc/dgraph/v25/protos/pb"
+	"github.com/hypermodeinc/dgraph/v25/worker"
+)
+
+func resolvePromoteCluster(ctx context.Context, m schema.Mutation) (*resolve.Resolved, bool) {
+	r := &pb.Replication{Role: pb.Replication_PRIMARY}
+	if inputArg, ok := m.ArgValue(schema.InputArgName).(map[string]interface{}); ok {
+		if v, ok := inputArg["target"]; ok && v != nil {
+			if r.Target, ok = v.(string); !ok {
+				return resolve.EmptyResult(m, inputArgError(
+					errors.Errorf("can't convert input.target to string"))), false
+			}
+		}
+	}
+	return setReplication(ctx, m, r)
+}
+
+func resolveDemoteCluster(ctx context.Context, m schema.Mutation) (*resolve.Resolved, bool) {
+	return setReplication(ctx, m, &pb.Replication{Role: pb.Replication_SECONDARY})
+}
+
+func setReplication(ctx context.Context, m schema.Mutation,
+	r *pb.Replication) (*resolve.Resolved, bool) {
+	if err := worker.SetReplicationOverNetwork(ctx, r); err != nil {
+		return resolve.EmptyResult(m, err), false
+	}
+	msg := "Cluster demoted to a replication secondary"
+	if r.Role == pb.Replication_PRIMARY {
+		msg = "Cluster promoted to a replication primary"
+		if r.Target != "" {
+			msg += ", replicating to " + r.Target
+		}
+	}
+	return resolve.DataResult(m,
+		map[string]interface{}{m.Name(): response("Success", msg)},
+		nil,
+	), true
+}
@@ -9,6 +9,7 @@ import (
 	"context"
 	"encoding/json"
 	"sync"
+	"time"
 
 	"github.com/golang/glog"
 	"github.com/pkg/errors"
@@ -18,6 +19,7 @@ import (
 	"github.com/hypermodeinc/dgraph/v25/graphql/schema"
 	"github.com/hypermodeinc/dgraph/v25/protos/pb"
 	"github.com/hypermodeinc/dgraph/v25/worker"
+	"github.com/hypermodeinc/dgraph/v25/x"
 )
 
 type restoreInput struct {
@@ -37,6 +39,29 @@ type restoreInput struct {
 	VaultPath         string
 	VaultField        string
 	VaultFormat       string
+	UntilTs           json.Number
+	UntilTime         string
+}
+
+// untilTs returns the timestamp to restore the data until, or 0 to restore the backups only.
+func (in *restoreInput) untilTs() (uint64, error) {
+	if in.UntilTime == "" {
+		if in.UntilTs == "" {
+			return 0, nil
+		}
+		return parseAsUint64(in.UntilTs)
+	}
+	t, err := time.Parse(time.RFC3339, in.UntilTime)
+	if err != nil {
+		return 0, err
+	}
+	creds := &x.MinioCredentials{
+		AccessKey:    in.AccessKey,
+		SecretKey:    in.SecretKey,
+		SessionToken: in.SessionToken,
+		Anonymous:    in.Anonymous,
+	}
+	return worker.ArchiveTsAt(in.Location, creds, t)
 }
 
 type restoreTenantInput struct {
@@ -71,6 +96,9 @@ func resolveTenantRestore(ctx context.Context, m schema.Mutation) (*resolve.Reso
 		FromNamespace:           input.FromNamespace,
 		IsNamespaceAwareRestore: true,
 	}
+	if req.UntilTs, err = input.RestoreInput.untilTs(); err != nil {
+		return resolve.EmptyResult(m, schema.GQLWrapf(err, "couldn't get input argument")), false
+	}
 	return restore(ctx, m, req)
 }
 
@@ -101,6 +129,9 @@ func resolveRestore(ctx context.Context, m schema.Mutation) (*resolve.Resolved,
 		VaultFormat:             input.VaultFormat,
 		IsNamespaceAwareRestore: false,
 	}
+	if req.UntilTs, err = input.untilTs(); err != nil {
+		return resolve.EmptyResult(m, schema.GQLWrapf(err, "couldn't get input argument")), false
+	}
 
 	return restore(ctx, m, req)
 }
@@ -174,5 +205,9 @@ func verifyRestoreInput(input restoreInput) error {
 		err := errors.Errorf("backupNum value should be equal or greater than zero")
 		return schema.GQLWrapf(err, "couldn't get input argument")
 	}
+	if input.UntilTs != "" && input.UntilTime != "" {
+		err := errors.Errorf("only one of untilTs and untilTime can be given")
+		return schema.GQLWrapf(err, "couldn't get input argument")
+	}
 	return nil
 }
@@ -0,0 +1,145 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package admin
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+
+	"github.com/hypermodeinc/dgraph/v25/graphql/resolve"
+	"github.com/hypermodeinc/dgraph/v25/graphql/schema"
+	"github.com/hypermodeinc/dgraph/v25/protos/pb"
+	"github.com/hypermodeinc/dgraph/v25/worker"
+	"github.com/hypermodeinc/dgraph/v25/x"
+)
+
+func resolveUpdateSchedule(ctx context.Context, m schema.Mutation) (*resolve.Resolved, bool) {
+	js, err := getScheduleInput(ctx, m)
+	if err != nil {
+		return resolve.EmptyResult(m, err), false
+	}
+	glog.Infof("Got update schedule request, name: %q, cron: %q", js.Name, js.Cron)
+	if err := worker.SetJobScheduleOverNetwork(ctx, js); err != nil {
+		return resolve.EmptyResult(m, err), false
+	}
+	return resolve.DataResult(m,
+		map[string]interface{}{m.Name(): response("Success",
+			fmt.Sprintf("Schedule %q set to %q", js.Name, js.Cron))},
+		nil,
+	), true
+}
+
+func resolveDeleteSchedule(ctx context.Context, m schema.Mutation) (*resolve.Resolved, bool) {
+	inputArg, ok := m.ArgValue(schema.InputArgName).(map[string]interface{})
+	if !ok {
+		return resolve.EmptyResult(m, inputArgError(
+			errors.Errorf("can't convert input to map"))), false
+	}
+	name, ok := inputArg["name"].(string)
+	if !ok {
+		return resolve.EmptyResult(m, inputArgError(
+			errors.Errorf("can't convert input.name to string"))), false
+	}
+	if err := worker.SetJobScheduleOverNetwork(ctx,
+		&pb.JobSchedule{Name: name, Remove: true}); err != nil {
+		return resolve.EmptyResult(m, err), false
+	}
+	return resolve.DataResult(m,
+		map[string]interface{}{m.Name(): response("Success",
+			fmt.Sprintf("Schedule %q deleted", name))},
+		nil,
+	), true
+}
+
+func getScheduleInput(ctx context.Context, m schema.Mutation) (*pb.JobSchedule, error) {
+	inputArg, ok := m.ArgValue(schema.InputArgName).(map[string]interface{})
+	if !ok {
+		return nil, inputArgError(errors.Errorf("can't convert input to map"))
+	}
+	js := &pb.JobSchedule{}
+	if js.Name, ok = inputArg["name"].(string); !ok || js.Name == "" {
+		return nil, inputArgError(errors.Errorf("the name of the schedule is missing"))
+	}
+	if js.Cron, ok = inputArg["cron"].(string); !ok {
+		return nil, inputArgError(errors.Errorf("can't convert input.cron to string"))
+	}
+	if _, err := x.ParseCron(js.Cron); err != nil {
+		return nil, inputArgError(err)
+	}
+
+	backupArg, exportArg := inputArg["backup"], inputArg["export"]
+	if (backupArg == nil) == (exportArg == nil) {
+		return nil, inputArgError(errors.Errorf("exactly one of backup and export must be given"))
+	}
+	if backupArg != nil {
+		input, err := getBackupInput(backupArg)
+		if err != nil {
+			return nil, err
+		}
+		if js.Backup, err = input.request(); err != nil {
+			return nil, inputArgError(err)
+		}
+		return js, nil
+	}
+	input, err := getExportInput(exportArg)
+	if err != nil {
+		return nil, err
+	}
+	if js.Export, err = input.request(ctx); err != nil {
+		return nil, inputArgError(err)
+	}
+	return js, nil
+}
+
+func resolveSchedules(ctx context.Context, q schema.Query) *resolve.Resolved {
+	schedules := worker.GetMembershipState().GetSchedules()
+	names := make([]string, 0, len(schedules))
+	for name := range schedules {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	res := make([]interface{}, 0, len(names))
+	for _, name := range names {
+		js := schedules[name]
+		s := map[string]interface{}{
+			"name": js.Name,
+			"cron": js.Cron,
+			"kind": scheduleKind(js).String(),
+		}
+		switch {
+		case js.Backup != nil:
+			s["destination"] = js.Backup.Destination
+			s["readBytesPerSec"] = int64(js.Backup.ReadBytesPerSec)
+			s["writeBytesPerSec"] = int64(js.Backup.WriteBytesPerSec)
+		case js.Export != nil:
+			s["destination"] = js.Export.Destination
+			s["readBytesPerSec"] = int64(js.Export.ReadBytesPerSec)
+			s["writeBytesPerSec"] = int64(js.Export.WriteBytesPerSec)
+		}
+		if h := js.History; len(h) > 0 {
+			s["lastRun"] = time.Unix(h[len(h)-1].Time, 0).UTC().Format(time.RFC3339)
+		}
+		res = append(res, s)
+	}
+	return resolve.DataResult(q, map[string]interface{}{q.Name(): res}, nil)
+}
+
+func scheduleKind(js *pb.JobSchedule) worker.TaskKind {
+	switch {
+	case js.Backup != nil:
+		return worker.TaskKindBackup
+	case js.Export != nil:
+		return worker.TaskKindExport
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,85 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package admin
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/hypermodeinc/dgraph/v25/graphql/resolve"
+	"github.com/hypermodeinc/dgraph/v25/graphql/schema"
+	"github.com/hypermodeinc/dgraph/v25/protos/pb"
+	"github.com/hypermodeinc/dgraph/v25/worker"
+	"github.com/hypermodeinc/dgraph/v25/x"
+)
+
+type splitTabletInput struct {
+	Namespace uint64
+	Tablet    string
+	Groups    []uint32
+}
+
+func resolveSplitTablet(ctx context.Context, m schema.Mutation) (*resolve.Resolved, bool) {
+	input, err := getSplitTabletInput(m)
+	if err != nil {
+		return resolve.EmptyResult(m, err), false
+	}
+
+	// gRPC call returns a nil status if the error is non-nil
+	status, err := worker.SplitTabletOverNetwork(ctx, &pb.SplitTabletRequest{
+		Namespace: input.Namespace,
+		Tablet:    input.Tablet,
+		Groups:    input.Groups,
+	})
+	if err != nil {
+		return resolve.EmptyResult(m, err), false
+	}
+
+	return resolve.DataResult(m,
+		map[string]interface{}{m.Name(): response("Success", status.GetMsg())},
+		nil,
+	), true
+}
+
+func getSplitTabletInput(m schema.Mutation) (*splitTabletInput, error) {
+	inputArg, ok := m.ArgValue(schema.InputArgName).(map[string]interface{})
+	if !ok {
+		return nil, inputArgError(errors.Errorf("can't convert input to map"))
+	}
+
+	inputRef := &splitTabletInput{}
+	// namespace is an optional parameter
+	if _, ok = inputArg["namespace"]; !ok {
+		inputRef.Namespace = x.RootNamespace
+	} else {
+		ns, err := parseAsUint64(inputArg["namespace"])
+		if err != nil {
+			return nil, inputArgError(schema.GQLWrapf(err,
+				"can't convert input.namespace to uint64"))
+		}
+		inputRef.Namespace = ns
+	}
+
+	inputRef.Tablet, ok = inputArg["tablet"].(string)
+	if !ok {
+		return nil, inputArgError(errors.Errorf("can't convert input.tablet to string"))
+	}
+
+	groups, ok := inputArg["groups"].([]interface{})
+	if !ok {
+		return nil, inputArgError(errors.Errorf("can't convert input.groups to a list"))
+	}
+	for _, g := range groups {
+		gId, err := parseAsUint32(g)
+		if err != nil {
+			return nil, inputArgError(schema.GQLWrapf(err, "can't convert input.groups to uint32"))
+		}
+		inputRef.Groups = append(inputRef.Groups, gId)
+	}
+
+	return inputRef, nil
+}
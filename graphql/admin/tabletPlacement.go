@@ -0,0 +1,75 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package admin
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	"github.com/hypermodeinc/dgraph/v25/graphql/resolve"
+	"github.com/hypermodeinc/dgraph/v25/graphql/schema"
+	"github.com/hypermodeinc/dgraph/v25/protos/pb"
+	"github.com/hypermodeinc/dgraph/v25/worker"
+	"github.com/hypermodeinc/dgraph/v25/x"
+)
+
+func resolveSetTabletPlacement(ctx context.Context, m schema.Mutation) (*resolve.Resolved, bool) {
+	placement, err := getTabletPlacementInput(m)
+	if err != nil {
+		return resolve.EmptyResult(m, err), false
+	}
+	if err := worker.SetTabletPlacementOverNetwork(ctx, placement); err != nil {
+		return resolve.EmptyResult(m, err), false
+	}
+
+	return resolve.DataResult(m,
+		map[string]interface{}{m.Name(): response("Success",
+			fmt.Sprintf("Placement of predicate %s set to pinned group: %d, colocation: %q",
+				x.ParseAttr(placement.Predicate), placement.PinnedGroup, placement.Colocation))},
+		nil,
+	), true
+}
+
+func getTabletPlacementInput(m schema.Mutation) (*pb.TabletPlacement, error) {
+	inputArg, ok := m.ArgValue(schema.InputArgName).(map[string]interface{})
+	if !ok {
+		return nil, inputArgError(errors.Errorf("can't convert input to map"))
+	}
+
+	// namespace is an optional parameter
+	namespace := x.RootNamespace
+	if _, ok = inputArg["namespace"]; ok {
+		ns, err := parseAsUint64(inputArg["namespace"])
+		if err != nil {
+			return nil, inputArgError(schema.GQLWrapf(err,
+				"can't convert input.namespace to uint64"))
+		}
+		namespace = ns
+	}
+
+	tablet, ok := inputArg["tablet"].(string)
+	if !ok {
+		return nil, inputArgError(errors.Errorf("can't convert input.tablet to string"))
+	}
+	placement := &pb.TabletPlacement{Predicate: x.NamespaceAttr(namespace, tablet)}
+
+	if v, ok := inputArg["pinnedGroup"]; ok && v != nil {
+		gId, err := parseAsUint32(v)
+		if err != nil {
+			return nil, inputArgError(schema.GQLWrapf(err,
+				"can't convert input.pinnedGroup to uint32"))
+		}
+		placement.PinnedGroup = gId
+	}
+	if v, ok := inputArg["colocation"]; ok && v != nil {
+		if placement.Colocation, ok = v.(string); !ok {
+			return nil, inputArgError(errors.Errorf("can't convert input.colocation to string"))
+		}
+	}
+	return placement, nil
+}
@@ -9,6 +9,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"sort"
 	"strconv"
 	"time"
 
@@ -57,6 +58,60 @@ func resolveTask(ctx context.Context, q schema.Query) *resolve.Resolved {
 	)
 }
 
+// resolveTasks lists the latest runs of the scheduled jobs, with the statuses of their tasks.
+func resolveTasks(ctx context.Context, q schema.Query) *resolve.Resolved {
+	var schedule string
+	if inputArg, ok := q.ArgValue(schema.InputArgName).(map[string]interface{}); ok {
+		if v, ok := inputArg["schedule"]; ok && v != nil {
+			if schedule, ok = v.(string); !ok {
+				return resolve.EmptyResult(q, errors.Errorf("can't convert input.schedule to string"))
+			}
+		}
+	}
+	schedules := worker.GetMembershipState().GetSchedules()
+	if _, ok := schedules[schedule]; schedule != "" && !ok {
+		return resolve.EmptyResult(q, errors.Errorf("schedule %q doesn't exist", schedule))
+	}
+
+	var runs []*pb.JobRun
+	for name, js := range schedules {
+		if schedule == "" || name == schedule {
+			runs = append(runs, js.History...)
+		}
+	}
+	sort.Slice(runs, func(i, j int) bool {
+		if runs[i].Time != runs[j].Time {
+			return runs[i].Time > runs[j].Time
+		}
+		return runs[i].Name < runs[j].Name
+	})
+
+	res := make([]interface{}, 0, len(runs))
+	for _, run := range runs {
+		task := map[string]interface{}{
+			"kind":        scheduleKind(schedules[run.Name]).String(),
+			"schedule":    run.Name,
+			"scheduledAt": time.Unix(run.Time, 0).UTC().Format(time.RFC3339),
+		}
+		if run.Error != "" {
+			task["error"] = run.Error
+		}
+		if run.TaskId != 0 {
+			task["id"] = fmt.Sprintf("%#x", run.TaskId)
+			// The task is gone if it expired, or if the alpha which ran it left the cluster.
+			task["status"] = worker.TaskStatus(0).String()
+			resp, err := worker.TaskStatusOverNetwork(ctx, &pb.TaskStatusRequest{TaskId: run.TaskId})
+			if err == nil {
+				meta := worker.TaskMeta(resp.GetTaskMeta())
+				task["status"] = meta.Status().String()
+				task["lastUpdated"] = meta.Timestamp().Format(time.RFC3339)
+			}
+		}
+		res = append(res, task)
+	}
+	return resolve.DataResult(q, map[string]interface{}{q.Name(): res}, nil)
+}
+
 func getTaskInput(q schema.Query) (*taskInput, error) {
 	inputArg := q.ArgValue(schema.InputArgName)
 	inputBytes, err := json.Marshal(inputArg)
@@ -7,6 +7,7 @@ package admin
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 
 	dgoapi "github.com/dgraph-io/dgo/v250/protos/api"
@@ -71,6 +72,17 @@ func (urw *updateGroupRewriter) Rewrite(
 			variable := urw.VarGen.Next(ruleType, "", "", false)
 			predicate := rule["predicate"]
 			permission := rule["permission"]
+			// An empty filter removes the filter of an existing rule.
+			filter, hasFilter := rule["filter"].(string)
+			var filterJson string
+			if hasFilter && filter != "" {
+				b, err := json.Marshal(filter)
+				if err != nil {
+					return nil, err
+				}
+				filterJson = fmt.Sprintf(`,
+						"dgraph.rule.filter":     %s`, b)
+			}
 
 			addAclRuleQuery(upsertQuery, predicate.(string), variable)
 
@@ -82,26 +94,35 @@ func (urw *updateGroupRewriter) Rewrite(
 						"uid":                    "_:%s",
 						"dgraph.type":            "%s",
 						"dgraph.rule.predicate":  "%s",
-						"dgraph.rule.permission": %v
+						"dgraph.rule.permission": %v%s
 					}
 				]
-			}`, srcUID, variable, ruleType.DgraphName(), predicate, permission))
+			}`, srcUID, variable, ruleType.DgraphName(), predicate, permission, filterJson))
 
 			existsJson := []byte(fmt.Sprintf(`
 			{
 				"uid":                    "uid(%s)",
-				"dgraph.rule.permission": %v
-			}`, variable, permission))
+				"dgraph.rule.permission": %v%s
+			}`, variable, permission, filterJson))
+
+			existsMu := &dgoapi.Mutation{
+				SetJson: existsJson,
+				Cond: fmt.Sprintf(`@if(gt(len(%s),0) AND gt(len(%s),0))`, resolve.MutationQueryVar,
+					variable),
+			}
+			if hasFilter && filter == "" {
+				existsMu.DeleteJson = []byte(fmt.Sprintf(`
+				{
+					"uid":                "uid(%s)",
+					"dgraph.rule.filter": null
+				}`, variable))
+			}
 
 			mutSet = append(mutSet, &dgoapi.Mutation{
 				SetJson: nonExistentJson,
 				Cond: fmt.Sprintf(`@if(gt(len(%s),0) AND eq(len(%s),0))`, resolve.MutationQueryVar,
 					variable),
-			}, &dgoapi.Mutation{
-				SetJson: existsJson,
-				Cond: fmt.Sprintf(`@if(gt(len(%s),0) AND gt(len(%s),0))`, resolve.MutationQueryVar,
-					variable),
-			})
+			}, existsMu)
 		}
 	}
 
@@ -0,0 +1,50 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/hypermodeinc/dgraph/v25/graphql/resolve"
+	"github.com/hypermodeinc/dgraph/v25/graphql/schema"
+	"github.com/hypermodeinc/dgraph/v25/worker"
+)
+
+func resolveUpgradeStatus(ctx context.Context, q schema.Query) *resolve.Resolved {
+	status := worker.GetUpgradeStatus()
+	nodes := make([]interface{}, 0, len(status.Members))
+	for _, m := range status.Members {
+		nodes = append(nodes, map[string]interface{}{
+			"id":             json.Number(strconv.FormatUint(m.Id, 10)),
+			"groupId":        json.Number(strconv.FormatUint(uint64(m.GroupId), 10)),
+			"addr":           m.Addr,
+			"version":        m.Version,
+			"featureVersion": int64(m.FeatureVersion),
+		})
+	}
+	return resolve.DataResult(q, map[string]interface{}{q.Name(): map[string]interface{}{
+		"featureVersion":          int64(status.FeatureVersion),
+		"finalizedFeatureVersion": int64(status.FinalizedFeatureVersion),
+		"safeToFinalize":          status.SafeToFinalize,
+		"nodes":                   nodes,
+	}}, nil)
+}
+
+func resolveFinalizeUpgrade(ctx context.Context, m schema.Mutation) (*resolve.Resolved, bool) {
+	if err := worker.FinalizeUpgradeOverNetwork(ctx); err != nil {
+		return resolve.EmptyResult(m, err), false
+	}
+	status := worker.GetUpgradeStatus()
+	return resolve.DataResult(m,
+		map[string]interface{}{m.Name(): response("Success",
+			fmt.Sprintf("Upgrade of the cluster finalized at feature version %d",
+				status.FeatureVersion))},
+		nil,
+	), true
+}
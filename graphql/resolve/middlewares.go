@@ -129,6 +129,24 @@ func resolveGuardianAuth(ctx context.Context, f schema.Field) *Resolved {
 	return nil
 }
 
+// resolveAdminAuth returns a Resolved with error if the context doesn't contain any Guardian auth
+// or auth of a group with the admin permission, otherwise it returns nil
+func resolveAdminAuth(ctx context.Context, f schema.Field, perm string) *Resolved {
+	if err := edgraph.AuthorizeAdmin(ctx, perm); err != nil {
+		return EmptyResult(f, err)
+	}
+	return nil
+}
+
+// resolveGalaxyAdminAuth returns a Resolved with error if the context doesn't contain any
+// superadmin auth or auth of a galaxy group with the admin permission, otherwise it returns nil
+func resolveGalaxyAdminAuth(ctx context.Context, f schema.Field, perm string) *Resolved {
+	if err := edgraph.AuthGalaxyAdmin(ctx, perm); err != nil {
+		return EmptyResult(f, err)
+	}
+	return nil
+}
+
 func resolveIpWhitelisting(ctx context.Context, f schema.Field) *Resolved {
 	if _, err := x.HasWhitelistedIP(ctx); err != nil {
 		return EmptyResult(f, err)
@@ -158,6 +176,33 @@ func GuardianAuthMW4Query(resolver QueryResolver) QueryResolver {
 	})
 }
 
+// AdminAuthMW4Query returns a middleware that blocks the resolution of resolverFunc if there is
+// no Guardian auth or auth of a group with the admin permission present in context.
+func AdminAuthMW4Query(perm string) QueryMiddleware {
+	return func(resolver QueryResolver) QueryResolver {
+		return QueryResolverFunc(func(ctx context.Context, query schema.Query) *Resolved {
+			if resolved := resolveAdminAuth(ctx, query, perm); resolved != nil {
+				return resolved
+			}
+			return resolver.Resolve(ctx, query)
+		})
+	}
+}
+
+// GalaxyAdminAuthMW4Query returns a middleware that blocks the resolution of resolverFunc if
+// there is no Guardian of Galaxy auth or auth of a galaxy group with the admin permission present
+// in context.
+func GalaxyAdminAuthMW4Query(perm string) QueryMiddleware {
+	return func(resolver QueryResolver) QueryResolver {
+		return QueryResolverFunc(func(ctx context.Context, query schema.Query) *Resolved {
+			if resolved := resolveGalaxyAdminAuth(ctx, query, perm); resolved != nil {
+				return resolved
+			}
+			return resolver.Resolve(ctx, query)
+		})
+	}
+}
+
 func IpWhitelistingMW4Query(resolver QueryResolver) QueryResolver {
 	return QueryResolverFunc(func(ctx context.Context, query schema.Query) *Resolved {
 		if resolved := resolveIpWhitelisting(ctx, query); resolved != nil {
@@ -196,6 +241,35 @@ func GuardianAuthMW4Mutation(resolver MutationResolver) MutationResolver {
 	})
 }
 
+// AdminAuthMW4Mutation returns a middleware that blocks the resolution of resolverFunc if there
+// is no Guardian auth or auth of a group with the admin permission present in context.
+func AdminAuthMW4Mutation(perm string) MutationMiddleware {
+	return func(resolver MutationResolver) MutationResolver {
+		return MutationResolverFunc(func(ctx context.Context,
+			mutation schema.Mutation) (*Resolved, bool) {
+			if resolved := resolveAdminAuth(ctx, mutation, perm); resolved != nil {
+				return resolved, false
+			}
+			return resolver.Resolve(ctx, mutation)
+		})
+	}
+}
+
+// GalaxyAdminAuthMW4Mutation returns a middleware that blocks the resolution of resolverFunc if
+// there is no Guardian of Galaxy auth or auth of a galaxy group with the admin permission present
+// in context.
+func GalaxyAdminAuthMW4Mutation(perm string) MutationMiddleware {
+	return func(resolver MutationResolver) MutationResolver {
+		return MutationResolverFunc(func(ctx context.Context,
+			mutation schema.Mutation) (*Resolved, bool) {
+			if resolved := resolveGalaxyAdminAuth(ctx, mutation, perm); resolved != nil {
+				return resolved, false
+			}
+			return resolver.Resolve(ctx, mutation)
+		})
+	}
+}
+
 func IpWhitelistingMW4Mutation(resolver MutationResolver) MutationResolver {
 	return MutationResolverFunc(func(ctx context.Context, mutation schema.Mutation) (*Resolved,
 		bool) {
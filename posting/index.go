@@ -68,7 +68,7 @@ func indexTokens(ctx context.Context, info *indexMutationInfo) ([]string, error)
 
 	var tokens []string
 	for _, it := range info.tokenizers {
-		toks, err := tok.BuildTokens(sv.Value, tok.GetTokenizerForLang(it, lang))
+		toks, err := tok.BuildIndexTokens(sv.Value, tok.GetTokenizerForLang(it, lang))
 		if err != nil {
 			return tokens, err
 		}
@@ -191,6 +191,10 @@ func (txn *Txn) addIndexMutations(ctx context.Context, info *indexMutationInfo)
 		Attr:    attr,
 		Op:      info.op,
 	}
+	if info.op == pb.DirectedEdge_SET {
+		// The index entry expires along with the value it points to.
+		edge.ExpiresAt = info.edge.ExpiresAt
+	}
 
 	for _, token := range tokens {
 		if err := txn.addIndexMutation(ctx, edge, token); err != nil {
@@ -283,11 +287,12 @@ func (txn *Txn) addReverseMutation(ctx context.Context, t *pb.DirectedEdge) erro
 
 	// We must create a copy here.
 	edge := &pb.DirectedEdge{
-		Entity:  t.ValueId,
-		ValueId: t.Entity,
-		Attr:    t.Attr,
-		Op:      t.Op,
-		Facets:  t.Facets,
+		Entity:    t.ValueId,
+		ValueId:   t.Entity,
+		Attr:      t.Attr,
+		Op:        t.Op,
+		Facets:    t.Facets,
+		ExpiresAt: t.ExpiresAt,
 	}
 	if err := plist.addMutation(ctx, txn, edge); err != nil {
 		return err
@@ -320,9 +325,11 @@ func (txn *Txn) addReverseAndCountMutation(ctx context.Context, t *pb.DirectedEd
 
 	// For single uid predicates, updating the reverse index requires that the existing
 	// entries for this key in the index are removed.
+	// The reverse part of an edge of a split predicate comes with the deletions of the previous
+	// objects, which are served by other sub-tablets.
 	pred, ok := schema.State().Get(ctx, t.Attr)
 	isSingleUidUpdate := ok && !pred.GetList() && pred.GetValueType() == pb.Posting_UID &&
-		t.Op != pb.DirectedEdge_DEL && t.ValueId != 0
+		t.Op != pb.DirectedEdge_DEL && t.ValueId != 0 && t.Part == pb.DirectedEdge_ALL
 	if isSingleUidUpdate {
 		dataKey := x.DataKey(t.Attr, t.Entity)
 		dataList, err := getFn(dataKey)
@@ -347,11 +354,12 @@ func (txn *Txn) addReverseAndCountMutation(ctx context.Context, t *pb.DirectedEd
 
 	// We must create a copy here.
 	edge := &pb.DirectedEdge{
-		Entity:  t.ValueId,
-		ValueId: t.Entity,
-		Attr:    t.Attr,
-		Op:      t.Op,
-		Facets:  t.Facets,
+		Entity:    t.ValueId,
+		ValueId:   t.Entity,
+		Attr:      t.Attr,
+		Op:        t.Op,
+		Facets:    t.Facets,
+		ExpiresAt: t.ExpiresAt,
 	}
 
 	cp, err := txn.addReverseMutationHelper(ctx, plist, hasCountIndex, edge)
@@ -383,6 +391,9 @@ func (l *List) handleDeleteAll(ctx context.Context, edge *pb.DirectedEdge, txn *
 	err := l.Iterate(txn.StartTs, 0, func(p *pb.Posting) error {
 		plen++
 		switch {
+		case isReversed && edge.Part == pb.DirectedEdge_FORWARD:
+			// The reverse edges are deleted by the sub-tablets serving them.
+			return nil
 		case isReversed:
 			// Delete reverse edge for each posting.
 			delEdge.ValueId = p.Uid
@@ -588,6 +599,12 @@ func (l *List) AddMutationWithIndex(ctx context.Context, edge *pb.DirectedEdge,
 			" and value: [%v]", edge.Entity, edge.ValueId, edge.Value)
 	}
 
+	if edge.Part == pb.DirectedEdge_REVERSE {
+		if pstore == nil || edge.ValueId == 0 || !schema.State().IsReversed(ctx, edge.Attr) {
+			return nil
+		}
+		return txn.addReverseAndCountMutation(ctx, edge)
+	}
 	if edge.Op == pb.DirectedEdge_DEL && string(edge.Value) == x.Star {
 		return l.handleDeleteAll(ctx, edge, txn)
 	}
@@ -597,7 +614,8 @@ func (l *List) AddMutationWithIndex(ctx context.Context, edge *pb.DirectedEdge,
 
 	// Add reverse mutation irrespective of hasMutated, server crash can happen after
 	// mutation is synced and before reverse edge is synced
-	if (pstore != nil) && (edge.ValueId != 0) && schema.State().IsReversed(ctx, edge.Attr) {
+	if (pstore != nil) && (edge.ValueId != 0) && edge.Part == pb.DirectedEdge_ALL &&
+		schema.State().IsReversed(ctx, edge.Attr) {
 		if err := txn.addReverseAndCountMutation(ctx, edge); err != nil {
 			return err
 		}
@@ -1469,6 +1487,7 @@ func rebuildTokIndex(ctx context.Context, rb *IndexRebuild) error {
 				Tid:   types.TypeID(p.ValType),
 			}
 			edge.Lang = string(p.LangTag)
+			edge.ExpiresAt = p.ExpiresAt
 
 			newEdges, err := processAddIndexMutation(&edge, val)
 			if err != nil {
@@ -1722,6 +1741,7 @@ func rebuildReverseEdges(ctx context.Context, rb *IndexRebuild) error {
 			edge.ValueId = puid
 			edge.Op = pb.DirectedEdge_SET
 			edge.Facets = pp.Facets
+			edge.ExpiresAt = pp.ExpiresAt
 
 			for {
 				// we only need to build reverse index here.
@@ -259,6 +259,37 @@ func addEdgeToUID(t *testing.T, attr string, src uint64,
 	addMutation(t, l, edge, Set, startTs, commitTs, false)
 }
 
+func TestReverseEdgeParts(t *testing.T) {
+	require.NoError(t, schema.ParseBytes([]byte("friend: [uid] @reverse ."), 1))
+	attr := x.AttrInRootNamespace("friend")
+	uids := func(key []byte, readTs uint64) []uint64 {
+		l, err := GetNoStore(key, readTs)
+		require.NoError(t, err)
+		var out []uint64
+		require.NoError(t, l.Iterate(readTs, 0, func(p *pb.Posting) error {
+			out = append(out, p.Uid)
+			return nil
+		}))
+		return out
+	}
+
+	// The forward part only updates the data key of the subject.
+	l, err := GetNoStore(x.DataKey(attr, 1), 20)
+	require.NoError(t, err)
+	edge := &pb.DirectedEdge{Attr: attr, Entity: 1, ValueId: 5, Part: pb.DirectedEdge_FORWARD}
+	addMutation(t, l, edge, Set, 20, 21, true)
+	require.Equal(t, []uint64{5}, uids(x.DataKey(attr, 1), 22))
+	require.Empty(t, uids(x.ReverseKey(attr, 5), 22))
+
+	// The reverse part only updates the reverse key of the object.
+	l, err = GetNoStore(x.DataKey(attr, 2), 22)
+	require.NoError(t, err)
+	edge = &pb.DirectedEdge{Attr: attr, Entity: 2, ValueId: 6, Part: pb.DirectedEdge_REVERSE}
+	addMutation(t, l, edge, Set, 22, 23, true)
+	require.Empty(t, uids(x.DataKey(attr, 2), 24))
+	require.Equal(t, []uint64{2}, uids(x.ReverseKey(attr, 6), 24))
+}
+
 func TestCountReverseIndexWithData(t *testing.T) {
 	require.NoError(t, pstore.DropAll())
 	memoryLayer.clear()
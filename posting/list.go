@@ -13,6 +13,7 @@ import (
 	"log"
 	"math"
 	"sort"
+	"time"
 
 	"github.com/dgryski/go-farm"
 	"github.com/golang/glog"
@@ -743,10 +744,17 @@ func NewPosting(t *pb.DirectedEdge) *pb.Posting {
 		LangTag:     []byte(t.Lang),
 		Op:          op,
 		Facets:      t.Facets,
+		ExpiresAt:   t.ExpiresAt,
 	}
 	return p
 }
 
+// isExpired returns true if the posting has an expiry which has already passed at
+// now, given as Unix time in seconds.
+func isExpired(p *pb.Posting, now int64) bool {
+	return p.ExpiresAt != 0 && p.ExpiresAt <= uint64(now)
+}
+
 func createDeleteAllPosting() *pb.Posting {
 	return &pb.Posting{
 		Op:    Del,
@@ -1140,6 +1148,9 @@ func (l *List) iterate(readTs uint64, afterUid uint64, f func(obj *pb.Posting) e
 		prevUid uint64
 		err     error
 	)
+	// Postings past their expiry are skipped here, which hides them from reads and drops
+	// them from the list on the next rollup.
+	now := time.Now().Unix()
 
 	// pitr iterates through immutable postings
 	err = pitr.seek(l, afterUid, deleteBelowTs)
@@ -1175,10 +1186,12 @@ loop:
 			return nil
 		case mp.Uid == 0 || (pp.Uid > 0 && pp.Uid < mp.Uid):
 			// Either mp is empty, or pp is lower than mp.
-			err = f(pp)
-			numNormalPostingsRead += 1
-			if err != nil {
-				break loop
+			if !isExpired(pp, now) {
+				err = f(pp)
+				numNormalPostingsRead += 1
+				if err != nil {
+					break loop
+				}
 			}
 
 			if err = pitr.next(); err != nil {
@@ -1186,7 +1199,7 @@ loop:
 			}
 		case pp.Uid == 0 || (mp.Uid > 0 && mp.Uid < pp.Uid):
 			// Either pp is empty, or mp is lower than pp.
-			if mp.Op != Del {
+			if mp.Op != Del && !isExpired(mp, now) {
 				err = f(mp)
 				numNormalPostingsRead += 1
 				if err != nil {
@@ -1198,7 +1211,7 @@ loop:
 			prevUid = mp.Uid
 			midx++
 		case pp.Uid == mp.Uid:
-			if mp.Op != Del {
+			if mp.Op != Del && !isExpired(mp, now) {
 				err = f(mp)
 				numNormalPostingsRead += 1
 				if err != nil {
@@ -1447,6 +1460,35 @@ func (l *List) Rollup(alloc *z.Allocator, readTs uint64) ([]*bpb.KV, error) {
 	return kvs, nil
 }
 
+// RollupFiltered generates a single list with no splits that only has the postings whose UID
+// satisfies keep. It's used to split the posting lists of a predicate across groups.
+func (l *List) RollupFiltered(alloc *z.Allocator, readTs uint64,
+	keep func(uid uint64) bool) (*bpb.KV, error) {
+	l.RLock()
+	defer l.RUnlock()
+
+	plist := &pb.PostingList{}
+	enc := codec.Encoder{BlockSize: blockSize}
+	err := l.iterate(readTs, 0, func(p *pb.Posting) error {
+		if !keep(p.Uid) {
+			return nil
+		}
+		enc.Add(p.Uid)
+		if p.Facets != nil || p.PostingType != pb.Posting_REF || p.ExpiresAt != 0 {
+			plist.Postings = append(plist.Postings, p)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot iterate through the list")
+	}
+	plist.Pack = enc.Done()
+
+	kv := MarshalPostingList(plist, alloc)
+	kv.Key = alloc.Copy(l.key)
+	return kv, nil
+}
+
 // ToBackupPostingList uses rollup to generate a single list with no splits.
 // It's used during backup so that each backed up posting list is stored in a single key.
 func (l *List) ToBackupPostingList(
@@ -1618,7 +1660,7 @@ func (l *List) encode(out *rollupOutput, readTs uint64, split bool) error {
 		}
 
 		enc.Add(p.Uid)
-		if p.Facets != nil || p.PostingType != pb.Posting_REF {
+		if p.Facets != nil || p.PostingType != pb.Posting_REF || p.ExpiresAt != 0 {
 			plist.Postings = append(plist.Postings, p)
 		}
 		return nil
@@ -1659,7 +1701,8 @@ func (l *List) rollup(readTs uint64, split bool) (*rollupOutput, error) {
 		parts: make(map[uint64]*pb.PostingList),
 	}
 
-	if len(out.plist.Splits) > 0 || l.mutationMap.len() > 0 {
+	if len(out.plist.Splits) > 0 || l.mutationMap.len() > 0 ||
+		(l.plist.MinExpiresAt != 0 && l.plist.MinExpiresAt <= uint64(time.Now().Unix())) {
 		// In case there were splits, this would read all the splits from
 		// Badger.
 		if err := l.encode(out, readTs, split); err != nil {
@@ -1695,6 +1738,7 @@ func (l *List) rollup(readTs uint64, split bool) (*rollupOutput, error) {
 	} else {
 		out.plist.Splits = nil
 	}
+	out.plist.MinExpiresAt = out.minExpiresAt()
 
 	return out, nil
 }
@@ -1714,10 +1758,12 @@ func (l *List) calculateUids() error {
 	}
 	res := make([]uint64, 0, l.ApproxLen())
 
+	var expiring bool
 	err := l.iterate(l.mutationMap.committedUidsTime, 0, func(p *pb.Posting) error {
 		if p.PostingType == pb.Posting_REF {
 			res = append(res, p.Uid)
 		}
+		expiring = expiring || p.ExpiresAt != 0
 		return nil
 	})
 
@@ -1730,6 +1776,10 @@ func (l *List) calculateUids() error {
 	l.Lock()
 	defer l.Unlock()
 
+	// A cached list would keep returning uids after they expire, so don't cache it.
+	if expiring {
+		return nil
+	}
 	l.mutationMap.calculatedUids = res
 	l.mutationMap.isUidsCalculated = true
 
@@ -1783,7 +1833,8 @@ func (l *List) Uids(opt ListOptions) (*pb.List, error) {
 		res := make([]uint64, 0, l.ApproxLen())
 		out := &pb.List{}
 
-		if l.mutationMap.len() == 0 && opt.Intersect != nil && len(l.plist.Splits) == 0 {
+		if l.mutationMap.len() == 0 && opt.Intersect != nil && len(l.plist.Splits) == 0 &&
+			l.plist.MinExpiresAt == 0 {
 			if opt.ReadTs < l.minTs {
 				return out, errors.Wrapf(ErrTsTooOld, "While reading UIDs"), false
 			}
@@ -2155,8 +2206,12 @@ func (l *List) FindPosting(readTs uint64, uid uint64) (found bool, pos *pb.Posti
 func (l *List) findPostingWithItr(readTs uint64, uid uint64, pitr pIterator) (found bool, pos *pb.Posting, err error) {
 	// Iterate starts iterating after the given argument, so we pass UID - 1
 	// TODO Find what happens when uid = math.MaxUint64
+	now := time.Now().Unix()
 	searchFurther, pos := l.mutationMap.findPosting(readTs, uid)
 	if pos != nil {
+		if isExpired(pos, now) {
+			return false, nil, nil
+		}
 		return true, pos, nil
 	}
 	if !searchFurther {
@@ -2178,7 +2233,7 @@ func (l *List) findPostingWithItr(readTs uint64, uid uint64, pitr pIterator) (fo
 	}
 	if valid {
 		pp := pitr.posting()
-		if pp.Uid == uid {
+		if pp.Uid == uid && !isExpired(pp, now) {
 			return true, pp, nil
 		}
 		return false, nil, nil
@@ -2383,6 +2438,26 @@ func (out *rollupOutput) splits() []uint64 {
 	return splits
 }
 
+// minExpiresAt returns the earliest expiry among the postings of the rolled up list, or
+// zero if none of them expire.
+func (out *rollupOutput) minExpiresAt() uint64 {
+	var minTs uint64
+	update := func(plist *pb.PostingList) {
+		for _, p := range plist.Postings {
+			if p.ExpiresAt != 0 && (minTs == 0 || p.ExpiresAt < minTs) {
+				minTs = p.ExpiresAt
+			}
+		}
+	}
+	if len(out.parts) == 0 {
+		update(out.plist)
+	}
+	for _, part := range out.parts {
+		update(part)
+	}
+	return minTs
+}
+
 // isPlistEmpty returns true if the given plist is empty. Plists with splits are
 // considered non-empty.
 func isPlistEmpty(plist *pb.PostingList) bool {
@@ -14,6 +14,7 @@ import (
 	"sort"
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/require"
@@ -213,6 +214,35 @@ func TestAddMutation(t *testing.T) {
 	require.EqualValues(t, "anti-testing", p.Facets[0].Key)
 }
 
+func TestAddMutationExpiry(t *testing.T) {
+	key := x.DataKey(x.AttrInRootNamespace("session"), 1)
+	ol, err := readPostingListFromDisk(key, ps, math.MaxUint64)
+	require.NoError(t, err)
+
+	now := uint64(time.Now().Unix())
+	txn := &Txn{StartTs: 1}
+	addMutationHelper(t, ol, &pb.DirectedEdge{ValueId: 2, ExpiresAt: now - 10}, Set, txn)
+	addMutationHelper(t, ol, &pb.DirectedEdge{ValueId: 3, ExpiresAt: now + 3600}, Set, txn)
+	addMutationHelper(t, ol, &pb.DirectedEdge{ValueId: 4}, Set, txn)
+	require.NoError(t, ol.commitMutation(1, 2))
+	checkUids(t, ol, []uint64{3, 4}, 3)
+
+	found, _, err := ol.findPosting(3, 2)
+	require.NoError(t, err)
+	require.False(t, found)
+
+	// Rollup drops the expired posting and keeps the expiry of the live one.
+	kvs, err := ol.Rollup(nil, math.MaxUint64)
+	require.NoError(t, err)
+	require.NoError(t, writePostingListToDisk(kvs))
+	ol, err = readPostingListFromDisk(key, ps, math.MaxUint64)
+	require.NoError(t, err)
+	require.Equal(t, now+3600, ol.plist.MinExpiresAt)
+	require.Equal(t, 1, len(ol.plist.Postings))
+	require.Equal(t, uint64(3), ol.plist.Postings[0].Uid)
+	checkUids(t, ol, []uint64{3, 4}, 4)
+}
+
 func getFirst(t *testing.T, l *List, readTs uint64) (res pb.Posting) {
 	require.NoError(t, l.Iterate(readTs, 0, func(p *pb.Posting) error {
 		res = *p
@@ -373,13 +373,14 @@ func (lc *LocalCache) GetSinglePosting(key []byte) (*pb.PostingList, error) {
 		return nil, err
 	}
 
-	// Filter and remove STAR_ALL and OP_DELETE Postings
+	// Filter and remove STAR_ALL, OP_DELETE and expired Postings
 	idx := 0
+	now := time.Now().Unix()
 	for _, postings := range pl.Postings {
 		if hasDeleteAll(postings) {
 			return nil, nil
 		}
-		if postings.Op != Del {
+		if postings.Op != Del && !isExpired(postings, now) {
 			pl.Postings[idx] = postings
 			idx++
 		}
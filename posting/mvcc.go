@@ -161,6 +161,12 @@ func (ir *incrRollupi) Process(closer *z.Closer, getNewTs func(bool) uint64) {
 
 	defer closer.Done()
 
+	// Expired postings don't write a delta, so the keys holding them are queued by a periodic
+	// sweep instead, to have their rollups drop them.
+	expiredCh := make(chan *[][]byte)
+	closer.AddRunning(1)
+	go ir.sweepExpired(closer, expiredCh)
+
 	writer := NewTxnWriter(pstore)
 	defer writer.Flush()
 
@@ -215,8 +221,135 @@ func (ir *incrRollupi) Process(closer *z.Closer, getNewTs func(bool) uint64) {
 			doRollup(batch, 1)
 			// throttle to 1 batch = 16 rollups per 1 ms.
 			<-limiter.C
+		case batch := <-expiredCh:
+			doRollup(batch, 1)
+			<-limiter.C
+		}
+	}
+}
+
+// sweepExpired periodically sends the batches of keys holding expired postings to keysCh.
+func (ir *incrRollupi) sweepExpired(closer *z.Closer, keysCh chan<- *[][]byte) {
+	defer closer.Done()
+
+	ticker := time.NewTicker(expirySweepInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-closer.HasBeenClosed():
+			return
+		case <-ticker.C:
+			if err := ir.queueExpired(closer, keysCh); err != nil {
+				glog.Warningf("Error while sweeping expired postings: %v", err)
+			}
+		}
+	}
+}
+
+// expirySweepInterval is how often the keys holding expired postings are looked for.
+const expirySweepInterval = 30 * time.Minute
+
+// queueExpired sends the batches of data, index and reverse keys with postings expired by now
+// to keysCh.
+func (ir *incrRollupi) queueExpired(closer *z.Closer, keysCh chan<- *[][]byte) error {
+	readTs := ir.getNewTs(true)
+	if err := o.WaitForTs(closer.Ctx(), readTs); err != nil {
+		return err
+	}
+	now := time.Now().Unix()
+	pool := ir.priorityKeys[1].keysPool
+	send := func(batch *[][]byte) error {
+		select {
+		case keysCh <- batch:
+			return nil
+		case <-closer.HasBeenClosed():
+			return ErrHighPriorityOp
+		}
+	}
+
+	var count int
+	batch := pool.Get().(*[][]byte)
+	stream := pstore.NewStreamAt(readTs)
+	stream.LogPrefix = "Sweeping expired postings"
+	stream.ChooseKey = func(item *badger.Item) bool {
+		pk, err := x.Parse(item.Key())
+		if err != nil || pk.HasStartUid {
+			return false
+		}
+		return pk.IsData() || pk.IsIndex() || pk.IsReverse()
+	}
+	stream.KeyToList = func(key []byte, itr *badger.Iterator) (*bpb.KVList, error) {
+		expired, err := hasExpired(key, itr, now)
+		if err != nil || !expired {
+			return nil, err
+		}
+		return &bpb.KVList{Kv: []*bpb.KV{{Key: key}}}, nil
+	}
+	stream.Send = func(buf *z.Buffer) error {
+		list, err := badger.BufferToKVList(buf)
+		if err != nil {
+			return err
+		}
+		for _, kv := range list.Kv {
+			*batch = append(*batch, bytes.Clone(kv.Key))
+			count++
+			if len(*batch) < 16 {
+				continue
+			}
+			if err := send(batch); err != nil {
+				return err
+			}
+			batch = pool.Get().(*[][]byte)
+		}
+		return nil
+	}
+	if err := stream.Orchestrate(closer.Ctx()); err != nil {
+		return err
+	}
+	if len(*batch) > 0 {
+		if err := send(batch); err != nil {
+			return err
+		}
+	}
+	glog.V(2).Infof("Queued %d keys with expired postings for rollup", count)
+	return nil
+}
+
+// hasExpired returns whether the posting list of the key, read from itr, holds a posting expired
+// at now.
+func hasExpired(key []byte, itr *badger.Iterator, now int64) (bool, error) {
+	for ; itr.Valid(); itr.Next() {
+		item := itr.Item()
+		if !bytes.Equal(item.Key(), key) || item.IsDeletedOrExpired() {
+			return false, nil
+		}
+		pl := &pb.PostingList{}
+		switch item.UserMeta() {
+		case BitCompletePosting:
+			if err := unmarshalOrCopy(pl, item); err != nil {
+				return false, err
+			}
+			return pl.MinExpiresAt != 0 && pl.MinExpiresAt <= uint64(now), nil
+		case BitDeltaPosting:
+			err := item.Value(func(val []byte) error {
+				return proto.Unmarshal(val, pl)
+			})
+			if err != nil {
+				return false, err
+			}
+			for _, p := range pl.Postings {
+				if isExpired(p, now) {
+					return true, nil
+				}
+			}
+		default:
+			return false, nil
+		}
+		if item.DiscardEarlierVersions() {
+			return false, nil
 		}
 	}
+	return false, nil
 }
 
 // ShouldAbort returns whether the transaction should be aborted.
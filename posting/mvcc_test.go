@@ -222,3 +222,39 @@ func TestPostingListRead(t *testing.T) {
 	addEdgeToUID(t, attr, 1, 7, 15, 16)
 	assertLength(17, 3)
 }
+
+func TestHasExpired(t *testing.T) {
+	key := x.DataKey(x.AttrInRootNamespace("sweep"), 1)
+	now := time.Now().Unix()
+	write := func(pl *pb.PostingList, meta byte, ts uint64) {
+		data, err := proto.Marshal(pl)
+		require.NoError(t, err)
+		writer := NewTxnWriter(pstore)
+		require.NoError(t, writer.SetAt(key, data, meta, ts))
+		require.NoError(t, writer.Flush())
+	}
+	expired := func(readTs uint64) bool {
+		txn := pstore.NewTransactionAt(readTs, false)
+		defer txn.Discard()
+		iterOpts := badger.DefaultIteratorOptions
+		iterOpts.AllVersions = true
+		itr := txn.NewKeyIterator(key, iterOpts)
+		defer itr.Close()
+		itr.Seek(key)
+		ok, err := hasExpired(key, itr, now)
+		require.NoError(t, err)
+		return ok
+	}
+
+	write(&pb.PostingList{MinExpiresAt: uint64(now + 3600)}, BitCompletePosting, 1)
+	require.False(t, expired(1))
+	// A delta with an expired posting on top of the rolled up list.
+	write(&pb.PostingList{Postings: []*pb.Posting{{Uid: 2, ExpiresAt: uint64(now - 10)}}},
+		BitDeltaPosting, 2)
+	require.True(t, expired(2))
+	// A rolled up list with an expired posting.
+	write(&pb.PostingList{MinExpiresAt: uint64(now)}, BitCompletePosting, 3)
+	require.True(t, expired(3))
+	write(&pb.PostingList{}, BitCompletePosting, 4)
+	require.False(t, expired(4))
+}
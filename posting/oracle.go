@@ -199,6 +199,8 @@ type oracle struct {
 
 	// max start ts given out by Zero. Do not use mutex on this, only use atomics.
 	maxAssigned uint64
+	// Unix nanos when the group leader proposed the last delta applied. Only use atomics.
+	proposedAt int64
 
 	// Keeps track of all the startTs we have seen so far, based on the mutations. Then as
 	// transactions are committed or aborted, we delete entries from the startTs map. When taking a
@@ -293,6 +295,21 @@ func (o *oracle) MaxAssigned() uint64 {
 	return atomic.LoadUint64(&o.maxAssigned)
 }
 
+// Staleness returns how long ago the group leader proposed the last delta applied here. Reads at
+// MaxAssigned miss at most the commits of that long. It returns false if no delta with a proposal
+// time has been applied yet. The clocks of the leader and this alpha are assumed to be in sync.
+func (o *oracle) Staleness() (time.Duration, bool) {
+	proposedAt := atomic.LoadInt64(&o.proposedAt)
+	if proposedAt == 0 {
+		return 0, false
+	}
+	staleness := time.Since(time.Unix(0, proposedAt))
+	if staleness < 0 {
+		staleness = 0
+	}
+	return staleness, true
+}
+
 func (o *oracle) WaitForTs(ctx context.Context, startTs uint64) error {
 	ch, ok := o.addToWaiters(startTs)
 	if !ok {
@@ -330,6 +347,10 @@ func (o *oracle) ProcessDelta(delta *pb.OracleDelta) {
 		}
 		delete(o.pendingTxns, status.StartTs)
 	}
+	if delta.ProposedAt > 0 {
+		// Having applied the delta, this alpha has all the commits proposed before it.
+		atomic.StoreInt64(&o.proposedAt, delta.ProposedAt)
+	}
 	curMax := o.MaxAssigned()
 	if delta.MaxAssigned < curMax {
 		return
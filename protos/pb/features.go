@@ -0,0 +1,48 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package pb
+
+// Members returns the Zeros and the alphas of the cluster.
+func (m *MembershipState) Members() []*Member {
+	var members []*Member
+	for _, z := range m.GetZeros() {
+		members = append(members, z)
+	}
+	for _, g := range m.GetGroups() {
+		for _, member := range g.GetMembers() {
+			members = append(members, member)
+		}
+	}
+	return members
+}
+
+// FeatureVersion returns the lowest feature version supported by the members of the cluster,
+// which is the version of the features the cluster can use.
+func (m *MembershipState) FeatureVersion() uint32 {
+	var version uint32
+	for i, member := range m.Members() {
+		if v := member.GetFeatureVersion(); i == 0 || v < version {
+			version = v
+		}
+	}
+	return version
+}
+
+// SafeToFinalize returns whether the upgrade of the cluster can be finalized, at the feature
+// version it returns. It's safe once every member supports the same feature version, above the
+// finalized one. After that, the members supporting a lower version can't join the cluster, so
+// the cluster can't be downgraded anymore.
+func (m *MembershipState) SafeToFinalize() (uint32, bool) {
+	var lowest, highest uint32
+	for i, member := range m.Members() {
+		v := member.GetFeatureVersion()
+		if i == 0 || v < lowest {
+			lowest = v
+		}
+		highest = max(highest, v)
+	}
+	return lowest, lowest == highest && lowest > m.GetFinalizedFeatureVersion()
+}
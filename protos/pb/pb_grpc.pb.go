@@ -294,19 +294,27 @@ var Raft_ServiceDesc = grpc.ServiceDesc{
 }
 
 const (
-	Zero_Connect_FullMethodName          = "/pb.Zero/Connect"
-	Zero_UpdateMembership_FullMethodName = "/pb.Zero/UpdateMembership"
-	Zero_StreamMembership_FullMethodName = "/pb.Zero/StreamMembership"
-	Zero_Oracle_FullMethodName           = "/pb.Zero/Oracle"
-	Zero_ShouldServe_FullMethodName      = "/pb.Zero/ShouldServe"
-	Zero_Inform_FullMethodName           = "/pb.Zero/Inform"
-	Zero_AssignIds_FullMethodName        = "/pb.Zero/AssignIds"
-	Zero_Timestamps_FullMethodName       = "/pb.Zero/Timestamps"
-	Zero_CommitOrAbort_FullMethodName    = "/pb.Zero/CommitOrAbort"
-	Zero_TryAbort_FullMethodName         = "/pb.Zero/TryAbort"
-	Zero_DeleteNamespace_FullMethodName  = "/pb.Zero/DeleteNamespace"
-	Zero_RemoveNode_FullMethodName       = "/pb.Zero/RemoveNode"
-	Zero_MoveTablet_FullMethodName       = "/pb.Zero/MoveTablet"
+	Zero_Connect_FullMethodName            = "/pb.Zero/Connect"
+	Zero_UpdateMembership_FullMethodName   = "/pb.Zero/UpdateMembership"
+	Zero_StreamMembership_FullMethodName   = "/pb.Zero/StreamMembership"
+	Zero_Oracle_FullMethodName             = "/pb.Zero/Oracle"
+	Zero_ShouldServe_FullMethodName        = "/pb.Zero/ShouldServe"
+	Zero_Inform_FullMethodName             = "/pb.Zero/Inform"
+	Zero_AssignIds_FullMethodName          = "/pb.Zero/AssignIds"
+	Zero_Timestamps_FullMethodName         = "/pb.Zero/Timestamps"
+	Zero_CommitOrAbort_FullMethodName      = "/pb.Zero/CommitOrAbort"
+	Zero_TryAbort_FullMethodName           = "/pb.Zero/TryAbort"
+	Zero_DeleteNamespace_FullMethodName    = "/pb.Zero/DeleteNamespace"
+	Zero_RemoveNode_FullMethodName         = "/pb.Zero/RemoveNode"
+	Zero_MoveTablet_FullMethodName         = "/pb.Zero/MoveTablet"
+	Zero_SetNamespaceQuota_FullMethodName  = "/pb.Zero/SetNamespaceQuota"
+	Zero_SplitTablet_FullMethodName        = "/pb.Zero/SplitTablet"
+	Zero_SetTabletPlacement_FullMethodName = "/pb.Zero/SetTabletPlacement"
+	Zero_SetReplication_FullMethodName     = "/pb.Zero/SetReplication"
+	Zero_SetJobSchedule_FullMethodName     = "/pb.Zero/SetJobSchedule"
+	Zero_RecordJobRun_FullMethodName       = "/pb.Zero/RecordJobRun"
+	Zero_FinalizeUpgrade_FullMethodName    = "/pb.Zero/FinalizeUpgrade"
+	Zero_DecommissionGroup_FullMethodName  = "/pb.Zero/DecommissionGroup"
 )
 
 // ZeroClient is the client API for Zero service.
@@ -327,6 +335,14 @@ type ZeroClient interface {
 	DeleteNamespace(ctx context.Context, in *DeleteNsRequest, opts ...grpc.CallOption) (*Status, error)
 	RemoveNode(ctx context.Context, in *RemoveNodeRequest, opts ...grpc.CallOption) (*Status, error)
 	MoveTablet(ctx context.Context, in *MoveTabletRequest, opts ...grpc.CallOption) (*Status, error)
+	SetNamespaceQuota(ctx context.Context, in *NamespaceQuota, opts ...grpc.CallOption) (*Status, error)
+	SplitTablet(ctx context.Context, in *SplitTabletRequest, opts ...grpc.CallOption) (*Status, error)
+	SetTabletPlacement(ctx context.Context, in *TabletPlacement, opts ...grpc.CallOption) (*Status, error)
+	SetReplication(ctx context.Context, in *Replication, opts ...grpc.CallOption) (*Status, error)
+	SetJobSchedule(ctx context.Context, in *JobSchedule, opts ...grpc.CallOption) (*Status, error)
+	RecordJobRun(ctx context.Context, in *JobRun, opts ...grpc.CallOption) (*Status, error)
+	FinalizeUpgrade(ctx context.Context, in *api.Payload, opts ...grpc.CallOption) (*Status, error)
+	DecommissionGroup(ctx context.Context, in *DecommissionGroupRequest, opts ...grpc.CallOption) (*Status, error)
 }
 
 type zeroClient struct {
@@ -500,6 +516,78 @@ func (c *zeroClient) MoveTablet(ctx context.Context, in *MoveTabletRequest, opts
 	return out, nil
 }
 
+func (c *zeroClient) SetNamespaceQuota(ctx context.Context, in *NamespaceQuota, opts ...grpc.CallOption) (*Status, error) {
+	out := new(Status)
+	err := c.cc.Invoke(ctx, Zero_SetNamespaceQuota_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *zeroClient) SplitTablet(ctx context.Context, in *SplitTabletRequest, opts ...grpc.CallOption) (*Status, error) {
+	out := new(Status)
+	err := c.cc.Invoke(ctx, Zero_SplitTablet_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *zeroClient) SetTabletPlacement(ctx context.Context, in *TabletPlacement, opts ...grpc.CallOption) (*Status, error) {
+	out := new(Status)
+	err := c.cc.Invoke(ctx, Zero_SetTabletPlacement_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *zeroClient) SetReplication(ctx context.Context, in *Replication, opts ...grpc.CallOption) (*Status, error) {
+	out := new(Status)
+	err := c.cc.Invoke(ctx, Zero_SetReplication_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *zeroClient) SetJobSchedule(ctx context.Context, in *JobSchedule, opts ...grpc.CallOption) (*Status, error) {
+	out := new(Status)
+	err := c.cc.Invoke(ctx, Zero_SetJobSchedule_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *zeroClient) RecordJobRun(ctx context.Context, in *JobRun, opts ...grpc.CallOption) (*Status, error) {
+	out := new(Status)
+	err := c.cc.Invoke(ctx, Zero_RecordJobRun_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *zeroClient) FinalizeUpgrade(ctx context.Context, in *api.Payload, opts ...grpc.CallOption) (*Status, error) {
+	out := new(Status)
+	err := c.cc.Invoke(ctx, Zero_FinalizeUpgrade_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *zeroClient) DecommissionGroup(ctx context.Context, in *DecommissionGroupRequest, opts ...grpc.CallOption) (*Status, error) {
+	out := new(Status)
+	err := c.cc.Invoke(ctx, Zero_DecommissionGroup_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ZeroServer is the server API for Zero service.
 // All implementations must embed UnimplementedZeroServer
 // for forward compatibility
@@ -518,6 +606,14 @@ type ZeroServer interface {
 	DeleteNamespace(context.Context, *DeleteNsRequest) (*Status, error)
 	RemoveNode(context.Context, *RemoveNodeRequest) (*Status, error)
 	MoveTablet(context.Context, *MoveTabletRequest) (*Status, error)
+	SetNamespaceQuota(context.Context, *NamespaceQuota) (*Status, error)
+	SplitTablet(context.Context, *SplitTabletRequest) (*Status, error)
+	SetTabletPlacement(context.Context, *TabletPlacement) (*Status, error)
+	SetReplication(context.Context, *Replication) (*Status, error)
+	SetJobSchedule(context.Context, *JobSchedule) (*Status, error)
+	RecordJobRun(context.Context, *JobRun) (*Status, error)
+	FinalizeUpgrade(context.Context, *api.Payload) (*Status, error)
+	DecommissionGroup(context.Context, *DecommissionGroupRequest) (*Status, error)
 	mustEmbedUnimplementedZeroServer()
 }
 
@@ -564,6 +660,30 @@ func (UnimplementedZeroServer) RemoveNode(context.Context, *RemoveNodeRequest) (
 func (UnimplementedZeroServer) MoveTablet(context.Context, *MoveTabletRequest) (*Status, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MoveTablet not implemented")
 }
+func (UnimplementedZeroServer) SetNamespaceQuota(context.Context, *NamespaceQuota) (*Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetNamespaceQuota not implemented")
+}
+func (UnimplementedZeroServer) SplitTablet(context.Context, *SplitTabletRequest) (*Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SplitTablet not implemented")
+}
+func (UnimplementedZeroServer) SetTabletPlacement(context.Context, *TabletPlacement) (*Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTabletPlacement not implemented")
+}
+func (UnimplementedZeroServer) SetReplication(context.Context, *Replication) (*Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetReplication not implemented")
+}
+func (UnimplementedZeroServer) SetJobSchedule(context.Context, *JobSchedule) (*Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetJobSchedule not implemented")
+}
+func (UnimplementedZeroServer) RecordJobRun(context.Context, *JobRun) (*Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RecordJobRun not implemented")
+}
+func (UnimplementedZeroServer) FinalizeUpgrade(context.Context, *api.Payload) (*Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FinalizeUpgrade not implemented")
+}
+func (UnimplementedZeroServer) DecommissionGroup(context.Context, *DecommissionGroupRequest) (*Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DecommissionGroup not implemented")
+}
 func (UnimplementedZeroServer) mustEmbedUnimplementedZeroServer() {}
 
 // UnsafeZeroServer may be embedded to opt out of forward compatibility for this service.
@@ -817,6 +937,150 @@ func _Zero_MoveTablet_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Zero_SetNamespaceQuota_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(NamespaceQuota)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ZeroServer).SetNamespaceQuota(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Zero_SetNamespaceQuota_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ZeroServer).SetNamespaceQuota(ctx, req.(*NamespaceQuota))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Zero_SplitTablet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SplitTabletRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ZeroServer).SplitTablet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Zero_SplitTablet_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ZeroServer).SplitTablet(ctx, req.(*SplitTabletRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Zero_SetTabletPlacement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TabletPlacement)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ZeroServer).SetTabletPlacement(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Zero_SetTabletPlacement_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ZeroServer).SetTabletPlacement(ctx, req.(*TabletPlacement))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Zero_SetReplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Replication)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ZeroServer).SetReplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Zero_SetReplication_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ZeroServer).SetReplication(ctx, req.(*Replication))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Zero_SetJobSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JobSchedule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ZeroServer).SetJobSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Zero_SetJobSchedule_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ZeroServer).SetJobSchedule(ctx, req.(*JobSchedule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Zero_RecordJobRun_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(JobRun)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ZeroServer).RecordJobRun(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Zero_RecordJobRun_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ZeroServer).RecordJobRun(ctx, req.(*JobRun))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Zero_FinalizeUpgrade_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(api.Payload)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ZeroServer).FinalizeUpgrade(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Zero_FinalizeUpgrade_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ZeroServer).FinalizeUpgrade(ctx, req.(*api.Payload))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Zero_DecommissionGroup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DecommissionGroupRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ZeroServer).DecommissionGroup(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Zero_DecommissionGroup_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ZeroServer).DecommissionGroup(ctx, req.(*DecommissionGroupRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Zero_ServiceDesc is the grpc.ServiceDesc for Zero service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -868,6 +1132,38 @@ var Zero_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "MoveTablet",
 			Handler:    _Zero_MoveTablet_Handler,
 		},
+		{
+			MethodName: "SetNamespaceQuota",
+			Handler:    _Zero_SetNamespaceQuota_Handler,
+		},
+		{
+			MethodName: "SplitTablet",
+			Handler:    _Zero_SplitTablet_Handler,
+		},
+		{
+			MethodName: "SetTabletPlacement",
+			Handler:    _Zero_SetTabletPlacement_Handler,
+		},
+		{
+			MethodName: "SetReplication",
+			Handler:    _Zero_SetReplication_Handler,
+		},
+		{
+			MethodName: "SetJobSchedule",
+			Handler:    _Zero_SetJobSchedule_Handler,
+		},
+		{
+			MethodName: "RecordJobRun",
+			Handler:    _Zero_RecordJobRun_Handler,
+		},
+		{
+			MethodName: "FinalizeUpgrade",
+			Handler:    _Zero_FinalizeUpgrade_Handler,
+		},
+		{
+			MethodName: "DecommissionGroup",
+			Handler:    _Zero_DecommissionGroup_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -895,11 +1191,16 @@ const (
 	Worker_Export_FullMethodName              = "/pb.Worker/Export"
 	Worker_ReceivePredicate_FullMethodName    = "/pb.Worker/ReceivePredicate"
 	Worker_MovePredicate_FullMethodName       = "/pb.Worker/MovePredicate"
+	Worker_ClonePredicate_FullMethodName      = "/pb.Worker/ClonePredicate"
+	Worker_SplitPredicate_FullMethodName      = "/pb.Worker/SplitPredicate"
+	Worker_ReceiveReplica_FullMethodName      = "/pb.Worker/ReceiveReplica"
 	Worker_Subscribe_FullMethodName           = "/pb.Worker/Subscribe"
 	Worker_UpdateGraphQLSchema_FullMethodName = "/pb.Worker/UpdateGraphQLSchema"
 	Worker_DeleteNamespace_FullMethodName     = "/pb.Worker/DeleteNamespace"
 	Worker_TaskStatus_FullMethodName          = "/pb.Worker/TaskStatus"
 	Worker_ApplyDrainmode_FullMethodName      = "/pb.Worker/ApplyDrainmode"
+	Worker_TabletReads_FullMethodName         = "/pb.Worker/TabletReads"
+	Worker_Decommission_FullMethodName        = "/pb.Worker/Decommission"
 	Worker_InternalStreamPDir_FullMethodName  = "/pb.Worker/InternalStreamPDir"
 )
 
@@ -918,11 +1219,16 @@ type WorkerClient interface {
 	Export(ctx context.Context, in *ExportRequest, opts ...grpc.CallOption) (*ExportResponse, error)
 	ReceivePredicate(ctx context.Context, opts ...grpc.CallOption) (Worker_ReceivePredicateClient, error)
 	MovePredicate(ctx context.Context, in *MovePredicatePayload, opts ...grpc.CallOption) (*api.Payload, error)
+	ClonePredicate(ctx context.Context, in *ClonePredicatePayload, opts ...grpc.CallOption) (*api.Payload, error)
+	SplitPredicate(ctx context.Context, in *SplitPredicatePayload, opts ...grpc.CallOption) (*api.Payload, error)
+	ReceiveReplica(ctx context.Context, opts ...grpc.CallOption) (Worker_ReceiveReplicaClient, error)
 	Subscribe(ctx context.Context, in *SubscriptionRequest, opts ...grpc.CallOption) (Worker_SubscribeClient, error)
 	UpdateGraphQLSchema(ctx context.Context, in *UpdateGraphQLSchemaRequest, opts ...grpc.CallOption) (*UpdateGraphQLSchemaResponse, error)
 	DeleteNamespace(ctx context.Context, in *DeleteNsRequest, opts ...grpc.CallOption) (*Status, error)
 	TaskStatus(ctx context.Context, in *TaskStatusRequest, opts ...grpc.CallOption) (*TaskStatusResponse, error)
 	ApplyDrainmode(ctx context.Context, in *DrainModeRequest, opts ...grpc.CallOption) (*Status, error)
+	TabletReads(ctx context.Context, in *TabletReadsRequest, opts ...grpc.CallOption) (*TabletReadsResponse, error)
+	Decommission(ctx context.Context, in *RemoveNodeRequest, opts ...grpc.CallOption) (*Status, error)
 	InternalStreamPDir(ctx context.Context, opts ...grpc.CallOption) (Worker_InternalStreamPDirClient, error)
 }
 
@@ -1071,8 +1377,60 @@ func (c *workerClient) MovePredicate(ctx context.Context, in *MovePredicatePaylo
 	return out, nil
 }
 
+func (c *workerClient) ClonePredicate(ctx context.Context, in *ClonePredicatePayload, opts ...grpc.CallOption) (*api.Payload, error) {
+	out := new(api.Payload)
+	err := c.cc.Invoke(ctx, Worker_ClonePredicate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workerClient) SplitPredicate(ctx context.Context, in *SplitPredicatePayload, opts ...grpc.CallOption) (*api.Payload, error) {
+	out := new(api.Payload)
+	err := c.cc.Invoke(ctx, Worker_SplitPredicate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workerClient) ReceiveReplica(ctx context.Context, opts ...grpc.CallOption) (Worker_ReceiveReplicaClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Worker_ServiceDesc.Streams[2], Worker_ReceiveReplica_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &workerReceiveReplicaClient{stream}
+	return x, nil
+}
+
+type Worker_ReceiveReplicaClient interface {
+	Send(*ReplicaBatch) error
+	CloseAndRecv() (*api.Payload, error)
+	grpc.ClientStream
+}
+
+type workerReceiveReplicaClient struct {
+	grpc.ClientStream
+}
+
+func (x *workerReceiveReplicaClient) Send(m *ReplicaBatch) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *workerReceiveReplicaClient) CloseAndRecv() (*api.Payload, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(api.Payload)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *workerClient) Subscribe(ctx context.Context, in *SubscriptionRequest, opts ...grpc.CallOption) (Worker_SubscribeClient, error) {
-	stream, err := c.cc.NewStream(ctx, &Worker_ServiceDesc.Streams[2], Worker_Subscribe_FullMethodName, opts...)
+	stream, err := c.cc.NewStream(ctx, &Worker_ServiceDesc.Streams[3], Worker_Subscribe_FullMethodName, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1139,8 +1497,26 @@ func (c *workerClient) ApplyDrainmode(ctx context.Context, in *DrainModeRequest,
 	return out, nil
 }
 
+func (c *workerClient) TabletReads(ctx context.Context, in *TabletReadsRequest, opts ...grpc.CallOption) (*TabletReadsResponse, error) {
+	out := new(TabletReadsResponse)
+	err := c.cc.Invoke(ctx, Worker_TabletReads_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *workerClient) Decommission(ctx context.Context, in *RemoveNodeRequest, opts ...grpc.CallOption) (*Status, error) {
+	out := new(Status)
+	err := c.cc.Invoke(ctx, Worker_Decommission_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *workerClient) InternalStreamPDir(ctx context.Context, opts ...grpc.CallOption) (Worker_InternalStreamPDirClient, error) {
-	stream, err := c.cc.NewStream(ctx, &Worker_ServiceDesc.Streams[3], Worker_InternalStreamPDir_FullMethodName, opts...)
+	stream, err := c.cc.NewStream(ctx, &Worker_ServiceDesc.Streams[4], Worker_InternalStreamPDir_FullMethodName, opts...)
 	if err != nil {
 		return nil, err
 	}
@@ -1188,11 +1564,16 @@ type WorkerServer interface {
 	Export(context.Context, *ExportRequest) (*ExportResponse, error)
 	ReceivePredicate(Worker_ReceivePredicateServer) error
 	MovePredicate(context.Context, *MovePredicatePayload) (*api.Payload, error)
+	ClonePredicate(context.Context, *ClonePredicatePayload) (*api.Payload, error)
+	SplitPredicate(context.Context, *SplitPredicatePayload) (*api.Payload, error)
+	ReceiveReplica(Worker_ReceiveReplicaServer) error
 	Subscribe(*SubscriptionRequest, Worker_SubscribeServer) error
 	UpdateGraphQLSchema(context.Context, *UpdateGraphQLSchemaRequest) (*UpdateGraphQLSchemaResponse, error)
 	DeleteNamespace(context.Context, *DeleteNsRequest) (*Status, error)
 	TaskStatus(context.Context, *TaskStatusRequest) (*TaskStatusResponse, error)
 	ApplyDrainmode(context.Context, *DrainModeRequest) (*Status, error)
+	TabletReads(context.Context, *TabletReadsRequest) (*TabletReadsResponse, error)
+	Decommission(context.Context, *RemoveNodeRequest) (*Status, error)
 	InternalStreamPDir(Worker_InternalStreamPDirServer) error
 	mustEmbedUnimplementedWorkerServer()
 }
@@ -1231,6 +1612,15 @@ func (UnimplementedWorkerServer) ReceivePredicate(Worker_ReceivePredicateServer)
 func (UnimplementedWorkerServer) MovePredicate(context.Context, *MovePredicatePayload) (*api.Payload, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method MovePredicate not implemented")
 }
+func (UnimplementedWorkerServer) ClonePredicate(context.Context, *ClonePredicatePayload) (*api.Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClonePredicate not implemented")
+}
+func (UnimplementedWorkerServer) SplitPredicate(context.Context, *SplitPredicatePayload) (*api.Payload, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SplitPredicate not implemented")
+}
+func (UnimplementedWorkerServer) ReceiveReplica(Worker_ReceiveReplicaServer) error {
+	return status.Errorf(codes.Unimplemented, "method ReceiveReplica not implemented")
+}
 func (UnimplementedWorkerServer) Subscribe(*SubscriptionRequest, Worker_SubscribeServer) error {
 	return status.Errorf(codes.Unimplemented, "method Subscribe not implemented")
 }
@@ -1246,6 +1636,12 @@ func (UnimplementedWorkerServer) TaskStatus(context.Context, *TaskStatusRequest)
 func (UnimplementedWorkerServer) ApplyDrainmode(context.Context, *DrainModeRequest) (*Status, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ApplyDrainmode not implemented")
 }
+func (UnimplementedWorkerServer) TabletReads(context.Context, *TabletReadsRequest) (*TabletReadsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TabletReads not implemented")
+}
+func (UnimplementedWorkerServer) Decommission(context.Context, *RemoveNodeRequest) (*Status, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Decommission not implemented")
+}
 func (UnimplementedWorkerServer) InternalStreamPDir(Worker_InternalStreamPDirServer) error {
 	return status.Errorf(codes.Unimplemented, "method InternalStreamPDir not implemented")
 }
@@ -1458,6 +1854,68 @@ func _Worker_MovePredicate_Handler(srv interface{}, ctx context.Context, dec fun
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Worker_ClonePredicate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClonePredicatePayload)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkerServer).ClonePredicate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Worker_ClonePredicate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerServer).ClonePredicate(ctx, req.(*ClonePredicatePayload))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Worker_SplitPredicate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SplitPredicatePayload)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkerServer).SplitPredicate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Worker_SplitPredicate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerServer).SplitPredicate(ctx, req.(*SplitPredicatePayload))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Worker_ReceiveReplica_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(WorkerServer).ReceiveReplica(&workerReceiveReplicaServer{stream})
+}
+
+type Worker_ReceiveReplicaServer interface {
+	SendAndClose(*api.Payload) error
+	Recv() (*ReplicaBatch, error)
+	grpc.ServerStream
+}
+
+type workerReceiveReplicaServer struct {
+	grpc.ServerStream
+}
+
+func (x *workerReceiveReplicaServer) SendAndClose(m *api.Payload) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *workerReceiveReplicaServer) Recv() (*ReplicaBatch, error) {
+	m := new(ReplicaBatch)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func _Worker_Subscribe_Handler(srv interface{}, stream grpc.ServerStream) error {
 	m := new(SubscriptionRequest)
 	if err := stream.RecvMsg(m); err != nil {
@@ -1551,6 +2009,42 @@ func _Worker_ApplyDrainmode_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Worker_TabletReads_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TabletReadsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkerServer).TabletReads(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Worker_TabletReads_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerServer).TabletReads(ctx, req.(*TabletReadsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Worker_Decommission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveNodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WorkerServer).Decommission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Worker_Decommission_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WorkerServer).Decommission(ctx, req.(*RemoveNodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Worker_InternalStreamPDir_Handler(srv interface{}, stream grpc.ServerStream) error {
 	return srv.(WorkerServer).InternalStreamPDir(&workerInternalStreamPDirServer{stream})
 }
@@ -1616,6 +2110,14 @@ var Worker_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "MovePredicate",
 			Handler:    _Worker_MovePredicate_Handler,
 		},
+		{
+			MethodName: "ClonePredicate",
+			Handler:    _Worker_ClonePredicate_Handler,
+		},
+		{
+			MethodName: "SplitPredicate",
+			Handler:    _Worker_SplitPredicate_Handler,
+		},
 		{
 			MethodName: "UpdateGraphQLSchema",
 			Handler:    _Worker_UpdateGraphQLSchema_Handler,
@@ -1632,6 +2134,14 @@ var Worker_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ApplyDrainmode",
 			Handler:    _Worker_ApplyDrainmode_Handler,
 		},
+		{
+			MethodName: "TabletReads",
+			Handler:    _Worker_TabletReads_Handler,
+		},
+		{
+			MethodName: "Decommission",
+			Handler:    _Worker_Decommission_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -1645,6 +2155,11 @@ var Worker_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _Worker_ReceivePredicate_Handler,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "ReceiveReplica",
+			Handler:       _Worker_ReceiveReplica_Handler,
+			ClientStreams: true,
+		},
 		{
 			StreamName:    "Subscribe",
 			Handler:       _Worker_Subscribe_Handler,
@@ -13,3 +13,16 @@ type Sensitive string
 func (Sensitive) String() string {
 	return "****"
 }
+
+// RedactSchedules clears the credentials in the requests of the scheduled jobs, so that they
+// don't leak through the endpoints showing the membership state.
+func (m *MembershipState) RedactSchedules() {
+	for _, js := range m.GetSchedules() {
+		if b := js.GetBackup(); b != nil {
+			b.AccessKey, b.SecretKey, b.SessionToken = "", "", ""
+		}
+		if e := js.GetExport(); e != nil {
+			e.AccessKey, e.SecretKey, e.SessionToken = "", "", ""
+		}
+	}
+}
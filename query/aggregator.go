@@ -9,9 +9,12 @@ import (
 	"bytes"
 	"math"
 	"math/big"
+	"strings"
 	"time"
+	"unicode/utf8"
 
 	"github.com/pkg/errors"
+	geom "github.com/twpayne/go-geom"
 
 	"github.com/hypermodeinc/dgraph/v25/protos/pb"
 	"github.com/hypermodeinc/dgraph/v25/types"
@@ -26,7 +29,8 @@ type aggregator struct {
 
 func isUnary(f string) bool {
 	return f == "ln" || f == "exp" || f == "u-" || f == "sqrt" ||
-		f == "floor" || f == "ceil" || f == "since"
+		f == "floor" || f == "ceil" || f == "since" ||
+		f == "lower" || f == "upper" || f == "length"
 }
 
 func isBinaryBoolean(f string) bool {
@@ -35,13 +39,21 @@ func isBinaryBoolean(f string) bool {
 }
 
 func isTernary(f string) bool {
-	return f == "cond"
+	return f == "cond" || f == "substring"
 }
 
 func isBinary(f string) bool {
 	return f == "+" || f == "*" || f == "-" || f == "/" || f == "%" ||
 		f == "max" || f == "min" || f == "logbase" || f == "pow" ||
-		f == "dot"
+		f == "dot" || f == "concat" || f == "coalesce" ||
+		f == "date_trunc" || f == "extract" || f == "date_add" || f == "date_diff" ||
+		f == "geodistance"
+}
+
+// isNullAware returns true for the functions that handle a missing value
+// themselves, instead of having it treated as 0.
+func isNullAware(f string) bool {
+	return f == "coalesce" || f == "concat"
 }
 
 func convertTo(from *pb.TaskValue) (types.Val, error) {
@@ -573,17 +585,275 @@ func applySince(a, res *types.Val) error {
 	return errors.Errorf("Wrong type %v encountered for func since", a.Tid)
 }
 
+// stringOf returns the string form of a value. A missing value is an empty string.
+func stringOf(a *types.Val) (string, error) {
+	if a.Value == nil {
+		return "", nil
+	}
+	if str, ok := a.Value.(string); ok {
+		return str, nil
+	}
+	res := types.Val{Tid: types.StringID}
+	if err := types.Marshal(*a, &res); err != nil {
+		return "", err
+	}
+	return res.Value.(string), nil
+}
+
+// timeOf returns the time.Time held by a datetime value, parsing strings if required.
+func timeOf(a *types.Val, funcName string) (time.Time, error) {
+	switch v := a.Value.(type) {
+	case time.Time:
+		return v, nil
+	case string:
+		t, err := types.ParseTime(v)
+		if err != nil {
+			return t, errors.Wrapf(err, "Wrong datetime %q for func %s", v, funcName)
+		}
+		return t, nil
+	}
+	return time.Time{}, errors.Errorf("Wrong type %v encountered for func %s", a.Tid, funcName)
+}
+
+func applyLower(a, res *types.Val) error {
+	str, err := stringOf(a)
+	if err != nil {
+		return errors.Wrapf(err, "Wrong type %v encountered for func lower", a.Tid)
+	}
+	res.Tid = types.StringID
+	res.Value = strings.ToLower(str)
+	return nil
+}
+
+func applyUpper(a, res *types.Val) error {
+	str, err := stringOf(a)
+	if err != nil {
+		return errors.Wrapf(err, "Wrong type %v encountered for func upper", a.Tid)
+	}
+	res.Tid = types.StringID
+	res.Value = strings.ToUpper(str)
+	return nil
+}
+
+func applyLength(a, res *types.Val) error {
+	str, err := stringOf(a)
+	if err != nil {
+		return errors.Wrapf(err, "Wrong type %v encountered for func length", a.Tid)
+	}
+	res.Tid = types.IntID
+	res.Value = int64(utf8.RuneCountInString(str))
+	return nil
+}
+
+// applySubstring returns the substring of a starting at rune offset start
+// with at most length runes. A negative start counts from the end of a.
+func applySubstring(a, start, length, res *types.Val) error {
+	str, err := stringOf(a)
+	if err != nil {
+		return errors.Wrapf(err, "Wrong type %v encountered for func substring", a.Tid)
+	}
+	from, ok := start.Value.(int64)
+	if !ok {
+		return errors.Errorf("Wrong type %v encountered for start of func substring", start.Tid)
+	}
+	n, ok := length.Value.(int64)
+	if !ok || n < 0 {
+		return errors.Errorf("Expected a non-negative int for length of func substring")
+	}
+	runes := []rune(str)
+	if from < 0 {
+		from = max(int64(len(runes))+from, 0)
+	}
+	from = min(from, int64(len(runes)))
+	to := min(from+n, int64(len(runes)))
+	res.Tid = types.StringID
+	res.Value = string(runes[from:to])
+	return nil
+}
+
+func applyConcat(a, b, res *types.Val) error {
+	left, err := stringOf(a)
+	if err != nil {
+		return errors.Wrapf(err, "Wrong type %v encountered for func concat", a.Tid)
+	}
+	right, err := stringOf(b)
+	if err != nil {
+		return errors.Wrapf(err, "Wrong type %v encountered for func concat", b.Tid)
+	}
+	res.Tid = types.StringID
+	res.Value = left + right
+	return nil
+}
+
+func applyDateTrunc(a, b, res *types.Val) error {
+	t, err := timeOf(a, "date_trunc")
+	if err != nil {
+		return err
+	}
+	unit, err := stringOf(b)
+	if err != nil {
+		return err
+	}
+	switch strings.ToLower(unit) {
+	case "year":
+		t = time.Date(t.Year(), 1, 1, 0, 0, 0, 0, t.Location())
+	case "quarter":
+		t = time.Date(t.Year(), (t.Month()-1)/3*3+1, 1, 0, 0, 0, 0, t.Location())
+	case "month":
+		t = time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, t.Location())
+	case "week":
+		// Weeks start on Monday, as in ISO 8601.
+		days := (int(t.Weekday()) + 6) % 7
+		t = time.Date(t.Year(), t.Month(), t.Day()-days, 0, 0, 0, 0, t.Location())
+	case "day":
+		t = time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+	case "hour":
+		t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), 0, 0, 0, t.Location())
+	case "minute":
+		t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), 0, 0, t.Location())
+	case "second":
+		t = time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), 0,
+			t.Location())
+	default:
+		return errors.Errorf("Invalid unit %q for func date_trunc", unit)
+	}
+	res.Tid = types.DateTimeID
+	res.Value = t
+	return nil
+}
+
+func applyExtract(a, b, res *types.Val) error {
+	t, err := timeOf(a, "extract")
+	if err != nil {
+		return err
+	}
+	unit, err := stringOf(b)
+	if err != nil {
+		return err
+	}
+	var v int64
+	switch strings.ToLower(unit) {
+	case "year":
+		v = int64(t.Year())
+	case "quarter":
+		v = int64(t.Month()-1)/3 + 1
+	case "month":
+		v = int64(t.Month())
+	case "week":
+		_, week := t.ISOWeek()
+		v = int64(week)
+	case "day":
+		v = int64(t.Day())
+	case "dow":
+		v = int64(t.Weekday())
+	case "doy":
+		v = int64(t.YearDay())
+	case "hour":
+		v = int64(t.Hour())
+	case "minute":
+		v = int64(t.Minute())
+	case "second":
+		v = int64(t.Second())
+	case "epoch":
+		v = t.Unix()
+	default:
+		return errors.Errorf("Invalid unit %q for func extract", unit)
+	}
+	res.Tid = types.IntID
+	res.Value = v
+	return nil
+}
+
+func applyDateAdd(a, b, res *types.Val) error {
+	t, err := timeOf(a, "date_add")
+	if err != nil {
+		return err
+	}
+	str, ok := b.Value.(string)
+	if !ok {
+		return errors.Errorf("Wrong type %v encountered for duration in func date_add", b.Tid)
+	}
+	d, err := x.ParseDuration(str)
+	if err != nil {
+		return err
+	}
+	res.Tid = types.DateTimeID
+	res.Value = t.Add(d)
+	return nil
+}
+
+// applyDateDiff returns a - b in seconds, the same unit that since() uses.
+func applyDateDiff(a, b, res *types.Val) error {
+	ta, err := timeOf(a, "date_diff")
+	if err != nil {
+		return err
+	}
+	tb, err := timeOf(b, "date_diff")
+	if err != nil {
+		return err
+	}
+	res.Tid = types.FloatID
+	res.Value = ta.Sub(tb).Seconds()
+	return nil
+}
+
+func geoOf(a *types.Val, funcName string) (geom.T, error) {
+	switch v := a.Value.(type) {
+	case geom.T:
+		return v, nil
+	case string:
+		p, err := types.ParseGeoPoint(v)
+		if err != nil {
+			return nil, errors.Wrapf(err, "Wrong point %q for func %s", v, funcName)
+		}
+		return p, nil
+	case []byte:
+		g, err := types.Convert(types.Val{Tid: types.BinaryID, Value: v}, types.GeoID)
+		if err != nil {
+			return nil, errors.Wrapf(err, "Wrong geo value for func %s", funcName)
+		}
+		return g.Value.(geom.T), nil
+	}
+	return nil, errors.Errorf("Wrong type %v encountered for func %s", a.Tid, funcName)
+}
+
+// applyGeoDistance returns the distance in metres between the geometry a and the point b.
+func applyGeoDistance(a, b, res *types.Val) error {
+	g, err := geoOf(a, "geodistance")
+	if err != nil {
+		return err
+	}
+	pg, err := geoOf(b, "geodistance")
+	if err != nil {
+		return err
+	}
+	p, ok := pg.(*geom.Point)
+	if !ok {
+		return errors.Errorf("Expected a point as the second argument of func geodistance")
+	}
+	d, err := types.GeoDistance(g, p)
+	if err != nil {
+		return err
+	}
+	res.Tid = types.FloatID
+	res.Value = d
+	return nil
+}
+
 type unaryFunc func(a, res *types.Val) error
 type binaryFunc func(a, b, res *types.Val) error
 
 var unaryFunctions = map[string]unaryFunc{
-	"ln":    applyLn,
-	"exp":   applyExp,
-	"u-":    applyNeg,
-	"sqrt":  applySqrt,
-	"floor": applyFloor,
-	"ceil":  applyCeil,
-	"since": applySince,
+	"ln":     applyLn,
+	"exp":    applyExp,
+	"u-":     applyNeg,
+	"sqrt":   applySqrt,
+	"floor":  applyFloor,
+	"ceil":   applyCeil,
+	"since":  applySince,
+	"lower":  applyLower,
+	"upper":  applyUpper,
+	"length": applyLength,
 }
 
 var binaryFunctions = map[string]binaryFunc{
@@ -599,6 +869,17 @@ var binaryFunctions = map[string]binaryFunc{
 	"dot":     applyDot,
 }
 
+// valueFunctions are binary functions over non numeric values. Their arguments
+// are passed as is, without going through matchType.
+var valueFunctions = map[string]binaryFunc{
+	"date_trunc": applyDateTrunc,
+	"extract":    applyExtract,
+	"date_add":   applyDateAdd,
+	"date_diff":  applyDateDiff,
+
+	"geodistance": applyGeoDistance,
+}
+
 // mixedScalarVectOps enumerates the binary functions that allow for
 // one argument to be a vector and the other a scalar.
 // In fact, if one of the arguments is a vector then the other *must* be
@@ -750,6 +1031,9 @@ func (ag *aggregator) matchType(left, right *types.Val) error {
 // In other words, for the binary result will replace the prior
 // value of ag.result.
 func (ag *aggregator) ApplyVal(v types.Val) error {
+	if isNullAware(ag.name) {
+		return ag.applyNullAware(v)
+	}
 	if v.Value == nil {
 		// If the value is missing, treat it as 0.
 		v.Value = int64(0)
@@ -773,6 +1057,15 @@ func (ag *aggregator) ApplyVal(v types.Val) error {
 	}
 
 	left := ag.result
+	if function, ok := valueFunctions[ag.name]; ok {
+		res.Tid = left.Tid
+		if err := function(&left, &v, &res); err != nil {
+			return err
+		}
+		ag.result = res
+		return nil
+	}
+
 	if err := ag.matchType(&left, &v); err != nil {
 		return err
 	}
@@ -791,6 +1084,27 @@ func (ag *aggregator) ApplyVal(v types.Val) error {
 	return nil
 }
 
+// applyNullAware evaluates the functions which see missing values as they are.
+// coalesce keeps the first value that is present, concat treats a missing
+// value as the empty string.
+func (ag *aggregator) applyNullAware(v types.Val) error {
+	switch ag.name {
+	case "coalesce":
+		if ag.result.Value == nil {
+			ag.result = v
+		}
+		return nil
+	case "concat":
+		var res types.Val
+		if err := applyConcat(&ag.result, &v, &res); err != nil {
+			return err
+		}
+		ag.result = res
+		return nil
+	}
+	return errors.Errorf("Unhandled aggregator function %q", ag.name)
+}
+
 func (ag *aggregator) Apply(val types.Val) error {
 	if ag.result.Value == nil {
 		if val.Tid == types.VFloatID {
@@ -20,6 +20,9 @@ type mathTree struct {
 	Const types.Val // If its a const value node.
 	Val   *types.ShardedMap
 	Child []*mathTree
+	// Uids are the uids the expression is evaluated for. The functions which handle missing
+	// values, like coalesce, give a value to the uids missing from every variable.
+	Uids []uint64
 }
 
 var (
@@ -35,6 +38,18 @@ var (
 	ErrorBadVectorMult   = errors.New("Cannot multiply vector by vector")
 )
 
+// isRowError returns whether the error only drops the value of the uid it was computed for,
+// instead of failing the query. The other errors, like an invalid argument, fail the query.
+func isRowError(err error) bool {
+	for _, rowErr := range []error{ErrorIntOverflow, ErrorFloat32Overflow, ErrorDivisionByZero,
+		ErrorFractionalPower, ErrorNegativeLog, ErrorNegativeRoot} {
+		if errors.Is(err, rowErr) {
+			return true
+		}
+	}
+	return false
+}
+
 // processBinary handles the binary operands like
 // +, -, *, /, %, max, min, logbase, dot
 func processBinary(mNode *mathTree) error {
@@ -93,9 +108,21 @@ func processBinary(mNode *mathTree) error {
 		mpr = nil
 	}
 
-	if mpl.Len() != 0 || mpr.Len() != 0 {
+	// A null aware function with a constant operand has a value for the uids missing from the
+	// variables too.
+	var uids [types.NumShards][]uint64
+	fillMissing := isNullAware(aggName) && (cl.Value != nil) != (cr.Value != nil) &&
+		len(mNode.Uids) != 0
+	if fillMissing {
+		for _, uid := range mNode.Uids {
+			uids[uid%types.NumShards] = append(uids[uid%types.NumShards], uid)
+		}
+	}
+
+	if mpl.Len() != 0 || mpr.Len() != 0 || fillMissing {
 		var wg sync.WaitGroup
 		returnMap := types.NewShardedMap()
+		errs := make([]error, types.NumShards)
 
 		for i := range types.NumShards {
 			wg.Add(1)
@@ -104,19 +131,37 @@ func processBinary(mNode *mathTree) error {
 			destMapi := returnMap.GetShardOrNil(i)
 			go func(i int) {
 				defer wg.Done()
+				apply := func(k uint64) {
+					if err := f(k, &mlps, &mprs, &destMapi); err != nil && !isRowError(err) &&
+						errs[i] == nil {
+						errs[i] = err
+					}
+				}
 				for k := range mlps {
-					f(k, &mlps, &mprs, &destMapi)
+					apply(k)
 				}
 				for k := range mprs {
 					if _, ok := mlps[k]; ok {
 						continue
 					}
-					f(k, &mlps, &mprs, &destMapi)
+					apply(k)
+				}
+				for _, k := range uids[i] {
+					_, inLeft := mlps[k]
+					_, inRight := mprs[k]
+					if !inLeft && !inRight {
+						apply(k)
+					}
 				}
 			}(i)
 		}
 
 		wg.Wait()
+		for _, err := range errs {
+			if err != nil {
+				return err
+			}
+		}
 		mNode.Val = returnMap
 		return nil
 	}
@@ -247,6 +292,56 @@ func processTernary(mNode *mathTree) error {
 	return nil
 }
 
+// processSubstring handles substring(str, start, length). Any of the arguments
+// can be a constant or a value variable.
+func processSubstring(mNode *mathTree) error {
+	// apply evaluates substring for the uid k. It returns false if a
+	// variable has no value for k.
+	apply := func(k uint64) (types.Val, bool, error) {
+		args := make([]types.Val, len(mNode.Child))
+		for i, ch := range mNode.Child {
+			if ch.Const.Value != nil {
+				args[i] = ch.Const
+				continue
+			}
+			v, ok := ch.Val.Get(k)
+			if !ok {
+				return types.Val{}, false, nil
+			}
+			args[i] = v
+		}
+		var res types.Val
+		err := applySubstring(&args[0], &args[1], &args[2], &res)
+		return res, true, err
+	}
+
+	var srcMap *types.ShardedMap
+	for _, ch := range mNode.Child {
+		if ch.Const.Value == nil {
+			srcMap = ch.Val
+			break
+		}
+	}
+	if srcMap == nil {
+		// All the arguments are constants.
+		var err error
+		mNode.Const, _, err = apply(0)
+		return err
+	}
+
+	destMap := types.NewShardedMap()
+	err := srcMap.Iterate(func(k uint64, _ types.Val) error {
+		res, ok, err := apply(k)
+		if err != nil || !ok {
+			return err
+		}
+		destMap.Set(k, res)
+		return nil
+	})
+	mNode.Val = destMap
+	return err
+}
+
 func evalMathTree(mNode *mathTree) error {
 	if mNode.Const.Value != nil {
 		return nil
@@ -260,6 +355,9 @@ func evalMathTree(mNode *mathTree) error {
 	}
 
 	for _, child := range mNode.Child {
+		if child.Uids == nil {
+			child.Uids = mNode.Uids
+		}
 		// Process the child nodes first.
 		if err := evalMathTree(child); err != nil {
 			return err
@@ -297,6 +395,9 @@ func evalMathTree(mNode *mathTree) error {
 			return errors.Errorf("Function %v expects 3 argument. But got: %v", aggName,
 				len(mNode.Child))
 		}
+		if aggName == "substring" {
+			return processSubstring(mNode)
+		}
 		return processTernary(mNode)
 	}
 
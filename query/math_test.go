@@ -9,9 +9,11 @@ import (
 	"math"
 	"math/big"
 	"testing"
+	"time"
 
 	"github.com/pkg/errors"
 	"github.com/stretchr/testify/require"
+	geom "github.com/twpayne/go-geom"
 
 	"github.com/hypermodeinc/dgraph/v25/types"
 )
@@ -1010,3 +1012,225 @@ func TestProcessTernary(t *testing.T) {
 		require.EqualValues(t, tc.out, val)
 	}
 }
+
+func TestProcessStringFunctions(t *testing.T) {
+	names := types.NewShardedMap()
+	names.Set(1, types.Val{Tid: types.StringID, Value: "Alice"})
+	names.Set(2, types.Val{Tid: types.StringID, Value: "Bjørn"})
+
+	tree := &mathTree{Fn: "upper", Child: []*mathTree{{Var: "n", Val: names}}}
+	require.NoError(t, evalMathTree(tree))
+	val, _ := tree.Val.Get(2)
+	require.Equal(t, types.Val{Tid: types.StringID, Value: "BJØRN"}, val)
+
+	tree = &mathTree{Fn: "length", Child: []*mathTree{{Var: "n", Val: names}}}
+	require.NoError(t, evalMathTree(tree))
+	val, _ = tree.Val.Get(2)
+	require.Equal(t, types.Val{Tid: types.IntID, Value: int64(5)}, val)
+
+	tree = &mathTree{
+		Fn: "concat",
+		Child: []*mathTree{
+			{Fn: "lower", Child: []*mathTree{{Var: "n", Val: names}}},
+			{Const: types.Val{Tid: types.StringID, Value: "@example.com"}},
+		},
+	}
+	require.NoError(t, evalMathTree(tree))
+	val, _ = tree.Val.Get(1)
+	require.Equal(t, types.Val{Tid: types.StringID, Value: "alice@example.com"}, val)
+
+	tree = &mathTree{
+		Fn: "substring",
+		Child: []*mathTree{
+			{Var: "n", Val: names},
+			{Const: types.Val{Tid: types.IntID, Value: int64(1)}},
+			{Const: types.Val{Tid: types.IntID, Value: int64(3)}},
+		},
+	}
+	require.NoError(t, evalMathTree(tree))
+	val, _ = tree.Val.Get(2)
+	require.Equal(t, types.Val{Tid: types.StringID, Value: "jør"}, val)
+
+	tree = &mathTree{
+		Fn: "substring",
+		Child: []*mathTree{
+			{Const: types.Val{Tid: types.StringID, Value: "dgraph"}},
+			{Const: types.Val{Tid: types.IntID, Value: int64(-5)}},
+			{Const: types.Val{Tid: types.IntID, Value: int64(10)}},
+		},
+	}
+	require.NoError(t, evalMathTree(tree))
+	require.Equal(t, types.Val{Tid: types.StringID, Value: "graph"}, tree.Const)
+}
+
+func TestProcessDateFunctions(t *testing.T) {
+	ts := time.Date(2021, 5, 14, 13, 45, 30, 0, time.UTC)
+	dates := createShardedMap(1, types.Val{Tid: types.DateTimeID, Value: ts})
+	str := func(s string) *mathTree {
+		return &mathTree{Const: types.Val{Tid: types.StringID, Value: s}}
+	}
+
+	tests := []struct {
+		in  *mathTree
+		out types.Val
+	}{
+		{
+			in: &mathTree{Fn: "date_trunc", Child: []*mathTree{{Var: "d", Val: dates}, str("month")}},
+			out: types.Val{Tid: types.DateTimeID,
+				Value: time.Date(2021, 5, 1, 0, 0, 0, 0, time.UTC)},
+		},
+		{
+			in: &mathTree{Fn: "date_trunc", Child: []*mathTree{{Var: "d", Val: dates}, str("week")}},
+			out: types.Val{Tid: types.DateTimeID,
+				Value: time.Date(2021, 5, 10, 0, 0, 0, 0, time.UTC)},
+		},
+		{
+			in:  &mathTree{Fn: "extract", Child: []*mathTree{{Var: "d", Val: dates}, str("hour")}},
+			out: types.Val{Tid: types.IntID, Value: int64(13)},
+		},
+		{
+			in:  &mathTree{Fn: "extract", Child: []*mathTree{{Var: "d", Val: dates}, str("quarter")}},
+			out: types.Val{Tid: types.IntID, Value: int64(2)},
+		},
+		{
+			in: &mathTree{Fn: "date_add", Child: []*mathTree{{Var: "d", Val: dates}, str("2d")}},
+			out: types.Val{Tid: types.DateTimeID,
+				Value: time.Date(2021, 5, 16, 13, 45, 30, 0, time.UTC)},
+		},
+		{
+			in: &mathTree{Fn: "date_add", Child: []*mathTree{{Var: "d", Val: dates}, str("-30m")}},
+			out: types.Val{Tid: types.DateTimeID,
+				Value: time.Date(2021, 5, 14, 13, 15, 30, 0, time.UTC)},
+		},
+		{
+			in: &mathTree{
+				Fn:    "date_diff",
+				Child: []*mathTree{{Var: "d", Val: dates}, str("2021-05-14T13:00:00Z")},
+			},
+			out: types.Val{Tid: types.FloatID, Value: 2730.0},
+		},
+	}
+	for _, tc := range tests {
+		t.Logf("Test: %s", tc.in.Fn)
+		require.NoError(t, evalMathTree(tc.in))
+		val, ok := tc.in.Val.Get(1)
+		require.True(t, ok)
+		require.EqualValues(t, tc.out, val)
+	}
+
+	tree := &mathTree{Fn: "date_trunc", Child: []*mathTree{{Var: "d", Val: dates}, str("fortnight")}}
+	require.ErrorContains(t, evalMathTree(tree), `Invalid unit "fortnight"`)
+}
+
+func TestProcessGeoDistance(t *testing.T) {
+	locs := types.NewShardedMap()
+	locs.Set(1, types.Val{Tid: types.GeoID,
+		Value: geom.NewPoint(geom.XY).MustSetCoords(geom.Coord{-118.2437, 34.0522})})
+	locs.Set(2, types.Val{Tid: types.GeoID,
+		Value: geom.NewPoint(geom.XY).MustSetCoords(geom.Coord{-122.4194, 37.7749})})
+
+	tree := &mathTree{
+		Fn: "geodistance",
+		Child: []*mathTree{
+			{Var: "loc", Val: locs},
+			{Const: types.Val{Tid: types.StringID, Value: "[-122.4194, 37.7749]"}},
+		},
+	}
+	require.NoError(t, evalMathTree(tree))
+	val, _ := tree.Val.Get(1)
+	require.Equal(t, types.FloatID, val.Tid)
+	require.InDelta(t, 559000, val.Value.(float64), 2000)
+	val, _ = tree.Val.Get(2)
+	require.Equal(t, types.Val{Tid: types.FloatID, Value: 0.0}, val)
+
+	tree = &mathTree{
+		Fn: "geodistance",
+		Child: []*mathTree{
+			{Var: "loc", Val: locs},
+			{Const: types.Val{Tid: types.StringID, Value: "not a point"}},
+		},
+	}
+	require.Error(t, evalMathTree(tree))
+}
+
+func TestProcessCoalesce(t *testing.T) {
+	nick := createShardedMap(1, types.Val{Tid: types.StringID, Value: "ally"})
+	name := types.NewShardedMap()
+	name.Set(1, types.Val{Tid: types.StringID, Value: "Alice"})
+	name.Set(2, types.Val{Tid: types.StringID, Value: "Bob"})
+
+	tree := &mathTree{
+		Fn: "coalesce",
+		Child: []*mathTree{
+			{Var: "nick", Val: nick},
+			{Var: "name", Val: name},
+		},
+	}
+	require.NoError(t, evalMathTree(tree))
+	val, _ := tree.Val.Get(1)
+	require.Equal(t, types.Val{Tid: types.StringID, Value: "ally"}, val)
+	val, _ = tree.Val.Get(2)
+	require.Equal(t, types.Val{Tid: types.StringID, Value: "Bob"}, val)
+
+	tree = &mathTree{
+		Fn: "coalesce",
+		Child: []*mathTree{
+			{Var: "nick", Val: nick},
+			{Const: types.Val{Tid: types.IntID, Value: int64(0)}},
+		},
+	}
+	require.NoError(t, evalMathTree(tree))
+	val, _ = tree.Val.Get(1)
+	require.Equal(t, types.Val{Tid: types.StringID, Value: "ally"}, val)
+
+	// The constant is the value of the uids missing from the variable.
+	tree = &mathTree{
+		Fn: "coalesce",
+		Child: []*mathTree{
+			{Var: "nick", Val: nick},
+			{Const: types.Val{Tid: types.StringID, Value: "none"}},
+		},
+		Uids: []uint64{1, 2, 3},
+	}
+	require.NoError(t, evalMathTree(tree))
+	require.Equal(t, 3, tree.Val.Len())
+	val, _ = tree.Val.Get(1)
+	require.Equal(t, types.Val{Tid: types.StringID, Value: "ally"}, val)
+	val, _ = tree.Val.Get(3)
+	require.Equal(t, types.Val{Tid: types.StringID, Value: "none"}, val)
+
+	// It's passed down to the nested expressions too.
+	tree = &mathTree{
+		Fn: "upper",
+		Child: []*mathTree{{
+			Fn: "coalesce",
+			Child: []*mathTree{
+				{Var: "nick", Val: types.NewShardedMap()},
+				{Const: types.Val{Tid: types.StringID, Value: "none"}},
+			},
+		}},
+		Uids: []uint64{2},
+	}
+	require.NoError(t, evalMathTree(tree))
+	val, _ = tree.Val.Get(2)
+	require.Equal(t, types.Val{Tid: types.StringID, Value: "NONE"}, val)
+}
+
+func TestProcessBinaryRowErrors(t *testing.T) {
+	nums := types.NewShardedMap()
+	nums.Set(1, types.Val{Tid: types.IntID, Value: int64(0)})
+	nums.Set(2, types.Val{Tid: types.IntID, Value: int64(4)})
+
+	// Division by zero only drops the value of the uid.
+	tree := &mathTree{
+		Fn: "/",
+		Child: []*mathTree{
+			{Const: types.Val{Tid: types.IntID, Value: int64(8)}},
+			{Var: "n", Val: nums},
+		},
+	}
+	require.NoError(t, evalMathTree(tree))
+	require.Equal(t, 1, tree.Val.Len())
+	val, _ := tree.Val.Get(2)
+	require.Equal(t, types.Val{Tid: types.IntID, Value: int64(2)}, val)
+}
@@ -1326,6 +1326,22 @@ func (sg *SubGraph) addCheckPwd(enc *encoder, vals []*pb.TaskValue, dst fastJson
 	return enc.AddValue(dst, enc.idForAttr(fieldName), c)
 }
 
+func (sg *SubGraph) addScore(enc *encoder, vals []*pb.TaskValue, dst fastJsonNode) error {
+	if len(vals) == 0 {
+		return nil
+	}
+	c, err := convertWithBestEffort(vals[0], sg.Attr)
+	if err != nil {
+		return err
+	}
+
+	fieldName := sg.Params.Alias
+	if fieldName == "" {
+		fieldName = fmt.Sprintf("score(%s)", sg.Attr)
+	}
+	return enc.AddValue(dst, enc.idForAttr(fieldName), c)
+}
+
 func alreadySeen(parentIds []uint64, uid uint64) bool {
 	for _, id := range parentIds {
 		if id == uid {
@@ -1408,6 +1424,11 @@ func (sg *SubGraph) preTraverse(enc *encoder, uid uint64, dst fastJsonNode) erro
 				return err
 			}
 
+		case pc.SrcFunc != nil && pc.SrcFunc.Name == "score":
+			if err := pc.addScore(enc, pc.valueMatrix[idx].Values, dst); err != nil {
+				return err
+			}
+
 		case idx < len(pc.uidMatrix) && len(pc.uidMatrix[idx].Uids) > 0:
 			var fcsList []*pb.Facets
 			if pc.Params.Facet != nil {
@@ -216,6 +216,9 @@ func validateSubGraphForRDF(sg *SubGraph) error {
 	if sg.SrcFunc != nil && sg.SrcFunc.Name == "checkpwd" {
 		return errors.New("chkpwd function is not supported in the rdf output format")
 	}
+	if sg.SrcFunc != nil && sg.SrcFunc.Name == "score" {
+		return errors.New("score function is not supported in the rdf output format")
+	}
 	if sg.Params.Facet != nil && !sg.Params.ExpandAll {
 		return errors.New("facets are not supported in the rdf output format")
 	}
@@ -199,6 +199,10 @@ type params struct {
 	Shortest bool
 	// AllowedPreds is a list of predicates accessible to query in context of ACL.
 	AllowedPreds []string
+	// RowFilters are the filters of the ACL rules, applied to the uid predicates that expand()
+	// expands to. The filter under the empty predicate applies to all of them. The scalar
+	// predicates with a filter aren't expanded.
+	RowFilters map[string]*dql.FilterTree
 }
 
 // CascadeArgs stores the arguments needed to process @cascade directive.
@@ -567,6 +571,7 @@ func treeCopy(gq *dql.GraphQuery, sg *SubGraph) error {
 			IsGroupBy:    gchild.IsGroupby,
 			IsInternal:   gchild.IsInternal,
 			Cascade:      &CascadeArgs{},
+			RowFilters:   gchild.RowFilters,
 		}
 
 		// Inherit from the parent.
@@ -617,7 +622,8 @@ func treeCopy(gq *dql.GraphQuery, sg *SubGraph) error {
 		}
 
 		if gchild.Func != nil &&
-			(gchild.Func.IsAggregator() || gchild.Func.IsPasswordVerifier()) {
+			(gchild.Func.IsAggregator() || gchild.Func.IsPasswordVerifier() ||
+				gchild.Func.IsScore()) {
 			if len(gchild.Children) != 0 {
 				return errors.Errorf("Node with %q cant have child attr", gchild.Func.Name)
 			}
@@ -960,6 +966,8 @@ func createTaskQuery(ctx context.Context, sg *SubGraph) (*pb.Query, error) {
 		First:        first,
 		Offset:       offset,
 	}
+	out.FieldKeys, out.RestrictFieldKeys = worker.FieldKeysFromContext(ctx)
+	out.AsOf = worker.AsOfFromContext(ctx)
 
 	if sg.SrcUIDs != nil {
 		out.UidList = sg.SrcUIDs
@@ -1277,6 +1285,11 @@ func (sg *SubGraph) valueVarAggregation(doneVars map[string]varValue, path []*Su
 			return err
 		}
 
+		rangeOver := sg.SrcUIDs
+		if parent == nil {
+			rangeOver = sg.DestUIDs
+		}
+		sg.MathExp.Uids = rangeOver.GetUids()
 		err = evalMathTree(sg.MathExp)
 		if err != nil {
 			return err
@@ -1320,10 +1333,6 @@ func (sg *SubGraph) valueVarAggregation(doneVars map[string]varValue, path []*Su
 		case sg.MathExp.Const.Value != nil:
 			// Assign the const for all the srcUids.
 			mp := types.NewShardedMap()
-			rangeOver := sg.SrcUIDs
-			if parent == nil {
-				rangeOver = sg.DestUIDs
-			}
 			if rangeOver == nil {
 				it := doneVars[sg.Params.Var]
 				it.Vals = types.NewShardedMap()
@@ -2091,7 +2100,25 @@ func expandSubgraph(ctx context.Context, sg *SubGraph) ([]*SubGraph, error) {
 			}
 		}
 
+		// The nodes reached through the uid predicates must match the filters of the ACL rules.
+		var uidPreds map[string]bool
+		if len(child.Params.RowFilters) > 0 {
+			filtered, err := filterUidPredicates(ctx, preds)
+			if err != nil {
+				return out, err
+			}
+			uidPreds = make(map[string]bool, len(filtered))
+			for _, pred := range filtered {
+				uidPreds[pred] = true
+			}
+		}
+
 		for _, pred := range preds {
+			// The nodes can't be filtered by the scalar predicates with a rule here, so their
+			// values aren't expanded.
+			if child.Params.RowFilters[x.ParseAttr(pred)] != nil && !uidPreds[pred] {
+				continue
+			}
 			// Convert attribute name for the given namespace.
 			temp := &SubGraph{
 				ReadTs: sg.ReadTs,
@@ -2114,6 +2141,19 @@ func expandSubgraph(ctx context.Context, sg *SubGraph) ([]*SubGraph, error) {
 				recursiveCopy(s, cf)
 				temp.Filters = append(temp.Filters, s)
 			}
+			if uidPreds[pred] {
+				for _, ft := range []*dql.FilterTree{child.Params.RowFilters[""],
+					child.Params.RowFilters[temp.Attr]} {
+					if ft == nil {
+						continue
+					}
+					s := &SubGraph{}
+					if err := filterCopy(s, ft); err != nil {
+						return out, err
+					}
+					temp.Filters = append(temp.Filters, s)
+				}
+			}
 
 			// Go through each child, create a copy and attach to temp.Children.
 			for _, cc := range child.Children {
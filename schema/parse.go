@@ -7,8 +7,10 @@ package schema
 
 import (
 	"math"
+	"regexp"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/golang/glog"
 	"github.com/pkg/errors"
@@ -73,6 +75,28 @@ func parseDirective(it *lex.ItemIterator, schema *pb.SchemaUpdate, t types.TypeI
 				" Got: [%v] for attr: [%v]", t.Name(), schema.Predicate)
 		}
 		schema.Lang = true
+	case "ttl":
+		ttl, err := parseTtlDirective(it, schema.Predicate)
+		if err != nil {
+			return err
+		}
+		schema.Ttl = ttl
+	case "history":
+		retain, err := parseHistoryDirective(it, schema.Predicate)
+		if err != nil {
+			return err
+		}
+		schema.HistoryRetain = retain
+	case "encrypted":
+		if t != types.StringID {
+			return next.Errorf("@encrypted directive can only be specified for string type."+
+				" Got: [%v] for attr: [%v]", t.Name(), schema.Predicate)
+		}
+		key, err := parseEncryptedDirective(it, schema.Predicate)
+		if err != nil {
+			return err
+		}
+		schema.EncryptionKey = key
 	default:
 		return next.Errorf("Invalid index specification")
 	}
@@ -81,6 +105,111 @@ func parseDirective(it *lex.ItemIterator, schema *pb.SchemaUpdate, t types.TypeI
 	return nil
 }
 
+// parseTtlDirective parses the duration given to @ttl, e.g. @ttl(24h) or @ttl("7d"),
+// and returns it in seconds.
+func parseTtlDirective(it *lex.ItemIterator, predicate string) (uint64, error) {
+	return parseDurationDirective(it, "ttl", "", predicate)
+}
+
+// parseHistoryDirective parses @history(retain: 30d) and returns the retention in seconds.
+func parseHistoryDirective(it *lex.ItemIterator, predicate string) (uint64, error) {
+	return parseDurationDirective(it, "history", "retain", predicate)
+}
+
+// fieldKeyNameRe matches the names of the keys that can be given to @encrypted.
+var fieldKeyNameRe = regexp.MustCompile(`^[a-zA-Z0-9_.-]+$`)
+
+// parseEncryptedDirective parses @encrypted(key: "pii") and returns the name of the key.
+func parseEncryptedDirective(it *lex.ItemIterator, predicate string) (string, error) {
+	if !it.Next() || it.Item().Typ != itemLeftRound {
+		return "", it.Item().Errorf("Missing key in @encrypted for pred: %s", predicate)
+	}
+	if !it.Next() || it.Item().Typ != itemText || it.Item().Val != "key" {
+		return "", it.Item().Errorf("Expected key in @encrypted for pred: %s", predicate)
+	}
+	if !it.Next() || it.Item().Typ != itemColon {
+		return "", it.Item().Errorf("Expected colon after key in @encrypted for pred: %s",
+			predicate)
+	}
+	if !it.Next() {
+		return "", it.Item().Errorf("Invalid ending while parsing @encrypted for pred: %s",
+			predicate)
+	}
+	item := it.Item()
+	var key string
+	switch item.Typ {
+	case itemText:
+		key = item.Val
+	case itemQuotedText:
+		var err error
+		if key, err = strconv.Unquote(item.Val); err != nil {
+			return "", item.Errorf("Invalid key in @encrypted for pred: %s", predicate)
+		}
+	default:
+		return "", item.Errorf("Unexpected token %q in @encrypted for pred: %s",
+			item.Val, predicate)
+	}
+	if !fieldKeyNameRe.MatchString(key) {
+		return "", item.Errorf("Invalid key name %q in @encrypted for pred: %s", key, predicate)
+	}
+	if !it.Next() || it.Item().Typ != itemRightRound {
+		return "", it.Item().Errorf("Expected ) after key in @encrypted for pred: %s", predicate)
+	}
+	return key, nil
+}
+
+// parseDurationDirective parses the bracketed duration of a directive, optionally
+// preceded by "key:", and returns it in seconds.
+func parseDurationDirective(it *lex.ItemIterator, directive, key, predicate string) (uint64, error) {
+	if !it.Next() || it.Item().Typ != itemLeftRound {
+		return 0, it.Item().Errorf("Missing duration in @%s for pred: %s", directive, predicate)
+	}
+	if key != "" {
+		if !it.Next() || it.Item().Typ != itemText || it.Item().Val != key {
+			return 0, it.Item().Errorf("Expected %s in @%s for pred: %s", key, directive, predicate)
+		}
+		if !it.Next() || it.Item().Typ != itemColon {
+			return 0, it.Item().Errorf("Expected colon after %s in @%s for pred: %s",
+				key, directive, predicate)
+		}
+	}
+	// The lexer splits a duration like 24h into a number and a text item, so
+	// join everything up to the closing bracket.
+	var dur strings.Builder
+	for {
+		if !it.Next() {
+			return 0, it.Item().Errorf("Invalid ending while parsing @%s for pred: %s",
+				directive, predicate)
+		}
+		item := it.Item()
+		if item.Typ == itemRightRound {
+			break
+		}
+		switch item.Typ {
+		case itemNumber, itemText:
+			dur.WriteString(item.Val)
+		case itemQuotedText:
+			val, err := strconv.Unquote(item.Val)
+			if err != nil {
+				return 0, item.Errorf("Invalid duration in @%s for pred: %s", directive, predicate)
+			}
+			dur.WriteString(val)
+		default:
+			return 0, item.Errorf("Unexpected token %q in @%s for pred: %s",
+				item.Val, directive, predicate)
+		}
+	}
+	d, err := x.ParseDuration(dur.String())
+	if err != nil {
+		return 0, it.Item().Errorf("Invalid @%s for pred: %s: %v", directive, predicate, err)
+	}
+	if d < time.Second {
+		return 0, it.Item().Errorf("@%s for pred: %s must be at least one second",
+			directive, predicate)
+	}
+	return uint64(d / time.Second), nil
+}
+
 func parseScalarPair(it *lex.ItemIterator, predicate string, ns uint64) (*pb.SchemaUpdate, error) {
 	it.Next()
 	next := it.Item()
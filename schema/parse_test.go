@@ -389,6 +389,76 @@ func TestParseUpsert(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestParseTtl(t *testing.T) {
+	reset()
+	result, err := Parse(`
+		session_token: string @index(exact) @ttl(24h) .
+		cache_entry: string @ttl("1h30m") .
+		visitor: [uid] @reverse @ttl(7d) .
+	`)
+	require.NoError(t, err)
+	require.Equal(t, 3, len(result.Preds))
+	require.Equal(t, uint64(24*3600), result.Preds[0].Ttl)
+	require.Equal(t, uint64(5400), result.Preds[1].Ttl)
+	require.Equal(t, uint64(7*24*3600), result.Preds[2].Ttl)
+}
+
+func TestParseTtlError(t *testing.T) {
+	reset()
+	_, err := Parse(`session_token: string @ttl .`)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "Missing duration in @ttl")
+
+	reset()
+	_, err = Parse(`session_token: string @ttl(forever) .`)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "Invalid @ttl")
+
+	reset()
+	_, err = Parse(`session_token: string @ttl(500ms) .`)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "must be at least one second")
+}
+
+func TestParseHistory(t *testing.T) {
+	reset()
+	result, err := Parse(`
+		balance: float @history(retain: 30d) .
+		owner: uid @history(retain: "12h") @reverse .
+	`)
+	require.NoError(t, err)
+	require.Equal(t, uint64(30*24*3600), result.Preds[0].HistoryRetain)
+	require.Equal(t, uint64(12*3600), result.Preds[1].HistoryRetain)
+
+	reset()
+	_, err = Parse(`balance: float @history(30d) .`)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "Expected retain in @history")
+}
+
+func TestParseEncrypted(t *testing.T) {
+	reset()
+	result, err := Parse(`
+		ssn: string @index(exact) @encrypted(key: "pii") .
+		email: [string] @encrypted(key: pii-v2) @index(hash) .
+	`)
+	require.NoError(t, err)
+	require.Equal(t, "pii", result.Preds[0].EncryptionKey)
+	require.Equal(t, "pii-v2", result.Preds[1].EncryptionKey)
+
+	for schema, msg := range map[string]string{
+		`age: int @encrypted(key: "pii") .`:     "can only be specified for string type",
+		`ssn: string @encrypted("pii") .`:       "Expected key in @encrypted",
+		`ssn: string @encrypted(key: "a:b") .`:  "Invalid key name",
+		`ssn: string @encrypted .`:              "Missing key in @encrypted",
+		`ssn: string @encrypted(key: pii, x) .`: "Expected ) after key",
+	} {
+		reset()
+		_, err = Parse(schema)
+		require.ErrorContains(t, err, msg, schema)
+	}
+}
+
 func TestParseEmptyType(t *testing.T) {
 	reset()
 	result, err := Parse(`
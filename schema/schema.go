@@ -12,6 +12,7 @@ import (
 	"math"
 	"reflect"
 	"sync"
+	"time"
 
 	"github.com/golang/glog"
 	"github.com/pkg/errors"
@@ -512,6 +513,29 @@ func (s *state) HasNoConflict(pred string) bool {
 	return s.predicate[pred].GetNoConflict()
 }
 
+// EncryptionKey returns the name of the field key the values of the predicate are encrypted
+// with, or an empty string if the predicate isn't @encrypted.
+func (s *state) EncryptionKey(pred string) string {
+	s.RLock()
+	defer s.RUnlock()
+	return s.predicate[pred].GetEncryptionKey()
+}
+
+// HistoryRetention returns the longest @history retention among all predicates, or zero
+// if no predicate keeps history.
+func (s *state) HistoryRetention() time.Duration {
+	if s == nil {
+		return 0
+	}
+	s.RLock()
+	defer s.RUnlock()
+	var retain uint64
+	for _, su := range s.predicate {
+		retain = x.Max(retain, su.GetHistoryRetain())
+	}
+	return time.Duration(retain) * time.Second
+}
+
 // IndexingInProgress checks whether indexing is going on for a given predicate.
 func (s *state) IndexingInProgress() bool {
 	s.RLock()
@@ -737,6 +761,10 @@ func initialTypesInternal(namespace uint64, all bool) []*pb.TypeUpdate {
 						Predicate: "dgraph.rule.permission",
 						ValueType: pb.Posting_INT,
 					},
+					{
+						Predicate: "dgraph.rule.filter",
+						ValueType: pb.Posting_STRING,
+					},
 				},
 			})
 	}
@@ -858,6 +886,10 @@ func initialSchemaInternal(namespace uint64, all bool) []*pb.SchemaUpdate {
 				Predicate: "dgraph.rule.permission",
 				ValueType: pb.Posting_INT,
 			},
+			{
+				Predicate: "dgraph.rule.filter",
+				ValueType: pb.Posting_STRING,
+			},
 		}...)
 	}
 	for _, sch := range initialSchema {
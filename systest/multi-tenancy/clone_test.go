@@ -0,0 +1,112 @@
+//go:build integration
+
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package main
+
+import (
+	"context"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dgraph-io/dgo/v250/protos/api"
+	"github.com/hypermodeinc/dgraph/v25/acl"
+	"github.com/hypermodeinc/dgraph/v25/dgraphapi"
+	"github.com/hypermodeinc/dgraph/v25/x"
+)
+
+func (msuite *MultitenancyTestSuite) TestCloneNamespace() {
+	t := msuite.T()
+
+	hcli, err := msuite.dc.HTTPClient()
+	require.NoError(t, err)
+	require.NoError(t, hcli.LoginIntoNamespace(dgraphapi.DefaultUser,
+		dgraphapi.DefaultPassword, x.RootNamespace))
+	ns, err := hcli.AddNamespace()
+	require.NoError(t, err)
+
+	gcli, cleanup, err := msuite.dc.Client()
+	defer cleanup()
+	require.NoError(t, err)
+	require.NoError(t, gcli.LoginIntoNamespace(context.Background(),
+		dgraphapi.DefaultUser, dgraphapi.DefaultPassword, ns))
+	require.NoError(t, gcli.SetupSchema(`name: string @index(exact) .`))
+	msuite.AddData(gcli)
+
+	// A user of the namespace, who can read the names.
+	nsHcli, err := msuite.dc.HTTPClient()
+	require.NoError(t, err)
+	require.NoError(t, nsHcli.LoginIntoNamespace(dgraphapi.DefaultUser,
+		dgraphapi.DefaultPassword, ns))
+	_, err = nsHcli.CreateUser("alice", "newpassword")
+	require.NoError(t, err)
+	_, err = nsHcli.CreateGroup("dev")
+	require.NoError(t, err)
+	require.NoError(t, nsHcli.AddUserToGroup("alice", "dev"))
+	require.NoError(t, nsHcli.AddRulesToGroup("dev",
+		[]dgraphapi.AclRule{{Predicate: "name", Permission: acl.Read.Code}}, true))
+
+	resp, err := gcli.Mutate(&api.Mutation{
+		SetNquads: []byte(`_:c <name> "guy3" .`),
+		CommitNow: true,
+	})
+	require.NoError(t, err)
+	beforeTs := resp.Txn.CommitTs - 1
+
+	const query = `{
+		me(func: has(name), orderasc: name) {
+			name
+		}
+	}`
+	const latest = `{"me": [{"name":"guy1"},{"name":"guy2"},{"name":"guy3"}]}`
+	const before = `{"me": [{"name":"guy1"},{"name":"guy2"}]}`
+
+	clone, err := hcli.CloneNamespace(ns, 0)
+	require.NoError(t, err)
+	require.NotEqual(t, ns, clone)
+
+	// The data, the schema and the users are cloned.
+	cloneCli, cleanup, err := msuite.dc.Client()
+	defer cleanup()
+	require.NoError(t, err)
+	require.NoError(t, cloneCli.LoginIntoNamespace(context.Background(),
+		dgraphapi.DefaultUser, dgraphapi.DefaultPassword, clone))
+	resp, err = cloneCli.Query(query)
+	require.NoError(t, err)
+	require.NoError(t, dgraphapi.CompareJSON(latest, string(resp.Json)))
+	resp, err = cloneCli.Query(`{ me(func: eq(name, "guy2")) { nickname } }`)
+	require.NoError(t, err)
+	require.NoError(t, dgraphapi.CompareJSON(`{"me": [{"nickname":"RG2"}]}`, string(resp.Json)))
+
+	aliceCli, cleanup, err := msuite.dc.Client()
+	defer cleanup()
+	require.NoError(t, err)
+	require.NoError(t, aliceCli.LoginIntoNamespace(context.Background(),
+		"alice", "newpassword", clone))
+	dgraphapi.PollTillPassOrTimeout(aliceCli, query, latest, aclQueryTimeout)
+
+	// The clone is independent of the namespace it was cloned from.
+	_, err = cloneCli.Mutate(&api.Mutation{
+		SetNquads: []byte(`_:d <name> "guy4" .`),
+		CommitNow: true,
+	})
+	require.NoError(t, err)
+	resp, err = gcli.Query(query)
+	require.NoError(t, err)
+	require.NoError(t, dgraphapi.CompareJSON(latest, string(resp.Json)))
+
+	// A clone as of an earlier ts has the data committed up to it.
+	clone, err = hcli.CloneNamespace(ns, beforeTs)
+	require.NoError(t, err)
+	require.NoError(t, cloneCli.LoginIntoNamespace(context.Background(),
+		dgraphapi.DefaultUser, dgraphapi.DefaultPassword, clone))
+	resp, err = cloneCli.Query(query)
+	require.NoError(t, err)
+	require.NoError(t, dgraphapi.CompareJSON(before, string(resp.Json)))
+
+	_, err = hcli.CloneNamespace(12345, 0)
+	require.ErrorContains(t, err, "error cloning non-existing namespace")
+}
@@ -400,6 +400,9 @@ func (ssuite *SystestTestSuite) TestSchemaQueryPredicate1() {
 	  {
 		  "predicate": "dgraph.rule.permission"
 	  },
+	  {
+		  "predicate": "dgraph.rule.filter"
+	  },
 	  {
         "predicate": "dgraph.graphql.schema"
 	  },
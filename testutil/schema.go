@@ -26,7 +26,8 @@ const (
 {"predicate":"dgraph.user.group","list":true, "reverse":true, "type":"uid"},
 {"predicate":"dgraph.acl.rule","type":"uid","list":true},
 {"predicate":"dgraph.rule.predicate","type":"string","index":true,"tokenizer":["exact"],"upsert":true},
-{"predicate":"dgraph.rule.permission","type":"int"}
+{"predicate":"dgraph.rule.permission","type":"int"},
+{"predicate":"dgraph.rule.filter","type":"string"}
 `
 	otherInternalPreds = `
 {"predicate":"dgraph.type","type":"string","index":true,"tokenizer":["exact"],"list":true},
@@ -45,7 +46,7 @@ const (
 	"fields": [{"name": "dgraph.acl.rule"},{"name": "dgraph.xid"}],
 	"name": "dgraph.type.Group"
 },{
-	"fields": [{"name": "dgraph.rule.predicate"},{"name": "dgraph.rule.permission"}],
+	"fields": [{"name": "dgraph.rule.predicate"},{"name": "dgraph.rule.permission"},{"name": "dgraph.rule.filter"}],
 	"name": "dgraph.type.Rule"
 }
 `
@@ -59,6 +59,17 @@ func setupBleve() {
 	x.Check(err)
 }
 
+// analyzeFullText runs str through the fulltext analyzer for the given language.
+func analyzeFullText(str, lang string) analysis.TokenStream {
+	lang = LangBase(lang)
+	// pass 1 - lowercase and normalize input
+	tokens := fulltextAnalyzer.Analyze([]byte(str))
+	// pass 2 - filter stop words
+	tokens = filterStopwords(lang, tokens)
+	// pass 3 - filter stems
+	return filterStemmers(lang, tokens)
+}
+
 // uniqueTerms takes a token stream and returns a string slice of unique terms.
 func uniqueTerms(tokens analysis.TokenStream) []string {
 	var terms []string
@@ -0,0 +1,101 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package tok
+
+import (
+	"encoding/binary"
+	"math"
+)
+
+// The fulltext index also keeps the length of every document, so that matches can be ranked
+// with BM25. Each length is stored as a token whose posting list holds all the documents of that
+// length. Terms never start with a zero byte, so these tokens don't clash with them.
+const (
+	fullTextLengthMarker = 0x00
+	maxFullTextLength    = math.MaxUint16
+
+	// BM25 parameters, set to the values most search engines use.
+	bm25K1 = 1.2
+	bm25B  = 0.75
+)
+
+// FullTextTerms returns the terms of str as the fulltext tokenizer sees them, in order and
+// including duplicates.
+func FullTextTerms(str, lang string) []string {
+	tokens := analyzeFullText(str, lang)
+	terms := make([]string, 0, len(tokens))
+	for i := range tokens {
+		terms = append(terms, string(tokens[i].Term))
+	}
+	return terms
+}
+
+// FullTextTermToken returns the index token of a term returned by FullTextTerms.
+func FullTextTermToken(term string) string {
+	return encodeToken(term, IdentFullText)
+}
+
+// FullTextLengthPrefix returns the prefix shared by all the document length tokens.
+func FullTextLengthPrefix() string {
+	return encodeToken(string([]byte{fullTextLengthMarker}), IdentFullText)
+}
+
+// FullTextLengthToken returns the index token holding the documents with n terms.
+func FullTextLengthToken(n int) string {
+	n = min(max(n, 0), maxFullTextLength)
+	var buf [3]byte
+	buf[0] = fullTextLengthMarker
+	binary.BigEndian.PutUint16(buf[1:], uint16(n))
+	return encodeToken(string(buf[:]), IdentFullText)
+}
+
+// ParseFullTextLengthToken returns the document length stored in a length token.
+func ParseFullTextLengthToken(token string) (int, bool) {
+	prefix := FullTextLengthPrefix()
+	if len(token) != len(prefix)+2 || token[:len(prefix)] != prefix {
+		return 0, false
+	}
+	return int(binary.BigEndian.Uint16([]byte(token[len(prefix):]))), true
+}
+
+// BuildIndexTokens returns the tokens under which val is indexed. These are the tokens from
+// BuildTokens, plus the document length for the fulltext tokenizer.
+func BuildIndexTokens(val interface{}, t Tokenizer) ([]string, error) {
+	tokens, err := BuildTokens(val, t)
+	if err != nil {
+		return nil, err
+	}
+	ft, ok := t.(FullTextTokenizer)
+	if !ok {
+		return tokens, nil
+	}
+	if str, ok := val.(string); ok && str != "" {
+		tokens = append(tokens, FullTextLengthToken(len(FullTextTerms(str, ft.lang))))
+	}
+	return tokens, nil
+}
+
+// BM25IDF returns the inverse document frequency of a term that is in df of docs documents.
+func BM25IDF(docs, df uint64) float64 {
+	if df > docs {
+		docs = df
+	}
+	return math.Log(1 + (float64(docs-df)+0.5)/(float64(df)+0.5))
+}
+
+// BM25 returns the score of a term that occurs tf times in a document with dl terms, given the
+// average document length avgdl and the idf of the term.
+func BM25(tf, dl int, avgdl, idf float64) float64 {
+	if tf == 0 {
+		return 0
+	}
+	norm := 1.0
+	if avgdl > 0 {
+		norm = 1 - bm25B + bm25B*float64(dl)/avgdl
+	}
+	f := float64(tf)
+	return idf * f * (bm25K1 + 1) / (f + bm25K1*norm)
+}
@@ -0,0 +1,53 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package tok
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestFullTextLengthToken(t *testing.T) {
+	for _, n := range []int{0, 1, 300, maxFullTextLength} {
+		got, ok := ParseFullTextLengthToken(FullTextLengthToken(n))
+		require.True(t, ok)
+		require.Equal(t, n, got)
+	}
+	got, ok := ParseFullTextLengthToken(FullTextLengthToken(maxFullTextLength + 10))
+	require.True(t, ok)
+	require.Equal(t, maxFullTextLength, got)
+
+	_, ok = ParseFullTextLengthToken(FullTextTermToken("fox"))
+	require.False(t, ok)
+}
+
+func TestBuildIndexTokens(t *testing.T) {
+	tokens, err := BuildIndexTokens("The quick foxes and the fox", FullTextTokenizer{lang: "en"})
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		FullTextTermToken("fox"), FullTextTermToken("quick"), FullTextLengthToken(3),
+	}, tokens)
+
+	// Other tokenizers are unchanged.
+	tokens, err = BuildIndexTokens("quick fox", TermTokenizer{})
+	require.NoError(t, err)
+	require.NotContains(t, tokens, FullTextLengthToken(2))
+	require.Len(t, tokens, 2)
+}
+
+func TestBM25(t *testing.T) {
+	require.Greater(t, BM25IDF(100, 1), BM25IDF(100, 50))
+	require.Greater(t, BM25IDF(100, 100), 0.0)
+
+	idf := BM25IDF(100, 10)
+	require.Zero(t, BM25(0, 10, 10, idf))
+	// More occurrences score higher, but saturate.
+	require.Greater(t, BM25(2, 10, 10, idf), BM25(1, 10, 10, idf))
+	require.Less(t, BM25(100, 10, 10, idf), idf*(bm25K1+1))
+	// Shorter documents score higher.
+	require.Greater(t, BM25(1, 5, 10, idf), BM25(1, 20, 10, idf))
+}
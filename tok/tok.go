@@ -434,15 +434,8 @@ func (t FullTextTokenizer) Tokens(v interface{}) ([]string, error) {
 	if !ok || str == "" {
 		return []string{}, nil
 	}
-	lang := LangBase(t.lang)
-	// pass 1 - lowercase and normalize input
-	tokens := fulltextAnalyzer.Analyze([]byte(str))
-	// pass 2 - filter stop words
-	tokens = filterStopwords(lang, tokens)
-	// pass 3 - filter stems
-	tokens = filterStemmers(lang, tokens)
 	// finally, return the terms.
-	return uniqueTerms(tokens), nil
+	return uniqueTerms(analyzeFullText(str, t.lang)), nil
 }
 func (t FullTextTokenizer) Identifier() byte { return IdentFullText }
 func (t FullTextTokenizer) IsSortable() bool { return false }
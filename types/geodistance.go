@@ -0,0 +1,157 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package types
+
+import (
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/golang/geo/s1"
+	"github.com/golang/geo/s2"
+	"github.com/pkg/errors"
+	geom "github.com/twpayne/go-geom"
+
+	"github.com/hypermodeinc/dgraph/v25/protos/pb"
+)
+
+const (
+	// NearestMinRadius is the radius in metres of the first index lookup of a nearest query.
+	NearestMinRadius = 1000
+	// NearestMaxRadius is the largest radius in metres that a nearest query looks at. It is a bit
+	// less than half the circumference of the earth, which covers almost all of it.
+	NearestMaxRadius = 0.99 * math.Pi * EarthRadiusMeters
+)
+
+// ParseGeoPoint parses a point given as [long, lat] or as GeoJSON.
+func ParseGeoPoint(str string) (*geom.Point, error) {
+	g, err := convertToGeom(str)
+	if err != nil {
+		return nil, err
+	}
+	p, ok := g.(*geom.Point)
+	if !ok {
+		return nil, errors.Errorf("Expected a point, got: %s", str)
+	}
+	return p, nil
+}
+
+// GeoDistance returns the shortest distance in metres between the geometry g and the point p. The
+// distance is 0 if p lies inside of a polygon.
+func GeoDistance(g geom.T, p *geom.Point) (float64, error) {
+	if p.Stride() != 2 {
+		return 0, errors.Errorf("Distance only available for 2D co-ordinates.")
+	}
+	a, err := distanceAngle(g, pointFromPoint(p))
+	if err != nil {
+		return 0, err
+	}
+	return float64(EarthDistance(a)), nil
+}
+
+func distanceAngle(g geom.T, pt s2.Point) (s1.Angle, error) {
+	switch v := g.(type) {
+	case *geom.Point:
+		return pointFromPoint(v).Distance(pt), nil
+	case *geom.LineString:
+		pl, err := polylineFromLineString(v)
+		if err != nil {
+			return 0, err
+		}
+		closest, _ := pl.Project(pt)
+		return closest.Distance(pt), nil
+	case *geom.Polygon:
+		l, err := loopFromPolygon(v)
+		if err != nil {
+			return 0, err
+		}
+		if l.ContainsPoint(pt) {
+			return 0, nil
+		}
+		d := s1.InfAngle()
+		for i := range l.NumEdges() {
+			e := l.Edge(i)
+			d = min(d, s2.DistanceFromSegment(pt, e.V0, e.V1))
+		}
+		return d, nil
+	case *geom.MultiPoint:
+		return minDistanceAngle(v.NumPoints(), func(i int) geom.T { return v.Point(i) }, pt)
+	case *geom.MultiLineString:
+		return minDistanceAngle(v.NumLineStrings(),
+			func(i int) geom.T { return v.LineString(i) }, pt)
+	case *geom.MultiPolygon:
+		return minDistanceAngle(v.NumPolygons(), func(i int) geom.T { return v.Polygon(i) }, pt)
+	default:
+		return 0, errors.Errorf("Cannot find distance to geometry of type %T", v)
+	}
+}
+
+func minDistanceAngle(n int, geometry func(i int) geom.T, pt s2.Point) (s1.Angle, error) {
+	if n == 0 {
+		return 0, errors.Errorf("Cannot find distance to an empty geometry")
+	}
+	d := s1.InfAngle()
+	for i := range n {
+		a, err := distanceAngle(geometry(i), pt)
+		if err != nil {
+			return 0, err
+		}
+		d = min(d, a)
+	}
+	return d, nil
+}
+
+// NearestQuery holds the arguments of a nearest(pred, point, k) function.
+type NearestQuery struct {
+	Point *geom.Point
+	K     int
+}
+
+// ParseNearest parses the arguments of a nearest function.
+func ParseNearest(srcFunc *pb.SrcFunction) (*NearestQuery, error) {
+	if len(srcFunc.Args) != 2 {
+		return nil, errors.Errorf("nearest function requires 2 arguments, but got %d",
+			len(srcFunc.Args))
+	}
+	p, err := ParseGeoPoint(srcFunc.Args[0])
+	if err != nil {
+		return nil, err
+	}
+	k, err := strconv.Atoi(strings.TrimSpace(srcFunc.Args[1]))
+	if err != nil {
+		return nil, errors.Wrapf(err, "Error while converting k to int")
+	}
+	if k <= 0 {
+		return nil, errors.Errorf("k should be greater than 0 for nearest, got: %d", k)
+	}
+	return &NearestQuery{Point: p, K: k}, nil
+}
+
+// Tokens returns the index tokens of all the geometries that may be within radius metres of
+// the point.
+func (q *NearestQuery) Tokens(radius float64) ([]string, error) {
+	toks, _, err := queryTokensGeo(QueryTypeNear, q.Point, radius)
+	return toks, err
+}
+
+// Distance returns the distance in metres between the point and a stored geo value. It returns
+// false if the value isn't a valid geometry.
+func (q *NearestQuery) Distance(value *pb.TaskValue) (float64, bool) {
+	if len(value.Val) == 0 || TypeID(value.ValType) != GeoID {
+		return 0, false
+	}
+	src := ValueForType(BinaryID)
+	src.Value = value.Val
+	gc, err := Convert(src, GeoID)
+	if err != nil {
+		return 0, false
+	}
+	d, err := GeoDistance(gc.Value.(geom.T), q.Point)
+	if err != nil {
+		return 0, false
+	}
+	return d, true
+}
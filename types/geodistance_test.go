@@ -0,0 +1,88 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"github.com/twpayne/go-geom"
+
+	"github.com/hypermodeinc/dgraph/v25/protos/pb"
+)
+
+func TestGeoDistance(t *testing.T) {
+	sf := geom.NewPoint(geom.XY).MustSetCoords(geom.Coord{-122.4194, 37.7749})
+	la := geom.NewPoint(geom.XY).MustSetCoords(geom.Coord{-118.2437, 34.0522})
+
+	d, err := GeoDistance(la, sf)
+	require.NoError(t, err)
+	require.InDelta(t, 559000, d, 2000)
+
+	d, err = GeoDistance(sf, sf)
+	require.NoError(t, err)
+	require.Zero(t, d)
+
+	// The closest point of a line can lie between its vertices. One degree of longitude at
+	// the equator is about 111km.
+	origin := geom.NewPoint(geom.XY).MustSetCoords(geom.Coord{0, 0})
+	line := geom.NewLineString(geom.XY).MustSetCoords([]geom.Coord{{-1, 1}, {1, 1}})
+	d, err = GeoDistance(line, origin)
+	require.NoError(t, err)
+	require.InDelta(t, 111195, d, 100)
+
+	poly := geom.NewPolygon(geom.XY).MustSetCoords([][]geom.Coord{
+		{{-1, -1}, {1, -1}, {1, 1}, {-1, 1}, {-1, -1}},
+	})
+	d, err = GeoDistance(poly, origin)
+	require.NoError(t, err)
+	require.Zero(t, d)
+
+	outside := geom.NewPoint(geom.XY).MustSetCoords(geom.Coord{2, 0})
+	d, err = GeoDistance(poly, outside)
+	require.NoError(t, err)
+	require.InDelta(t, 111195, d, 100)
+
+	multi := geom.NewMultiPoint(geom.XY).MustSetCoords([]geom.Coord{{5, 0}, {0, 1}})
+	d, err = GeoDistance(multi, origin)
+	require.NoError(t, err)
+	require.InDelta(t, 111195, d, 100)
+}
+
+func TestParseGeoPoint(t *testing.T) {
+	p, err := ParseGeoPoint("[-122.4194, 37.7749]")
+	require.NoError(t, err)
+	require.Equal(t, geom.Coord{-122.4194, 37.7749}, p.Coords())
+
+	_, err = ParseGeoPoint("[[[1, 2], [3, 4], [5, 6], [1, 2]]]")
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "Expected a point")
+}
+
+func TestParseLineString(t *testing.T) {
+	g, err := convertToGeom(`{"type": "LineString", "coordinates": [[1, 2], [3, 4]]}`)
+	require.NoError(t, err)
+	require.IsType(t, &geom.LineString{}, g)
+
+	_, err = convertToGeom(`{"type": "LineString", "coordinates": [[1, 2]]}`)
+	require.Error(t, err)
+}
+
+func TestParseNearest(t *testing.T) {
+	q, err := ParseNearest(&pb.SrcFunction{Name: "nearest", Args: []string{"[1, 2]", "5"}})
+	require.NoError(t, err)
+	require.Equal(t, 5, q.K)
+	require.Equal(t, geom.Coord{1, 2}, q.Point.Coords())
+
+	toks, err := q.Tokens(NearestMinRadius)
+	require.NoError(t, err)
+	require.NotEmpty(t, toks)
+
+	_, err = ParseNearest(&pb.SrcFunction{Name: "nearest", Args: []string{"[1, 2]", "0"}})
+	require.Error(t, err)
+	_, err = ParseNearest(&pb.SrcFunction{Name: "nearest", Args: []string{"[1, 2]"}})
+	require.Error(t, err)
+}
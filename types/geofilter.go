@@ -43,7 +43,7 @@ type GeoQueryData struct {
 // IsGeoFunc returns if a function is of geo type.
 func IsGeoFunc(str string) bool {
 	switch str {
-	case "near", "contains", "within", "intersects":
+	case "near", "contains", "within", "intersects", "nearest":
 		return true
 	}
 
@@ -277,6 +277,33 @@ func (q GeoQueryData) isWithin(g geom.T) bool {
 			}
 			return true
 		}
+	case *geom.LineString:
+		pl, err := polylineFromLineString(geometry)
+		if err != nil {
+			return false
+		}
+		return polylineWithinMultiloops(*pl, q.loops)
+	case *geom.MultiLineString:
+		// Each line in the multilinestring should be within some loop of q.loops.
+		for i := range geometry.NumLineStrings() {
+			pl, err := polylineFromLineString(geometry.LineString(i))
+			if err != nil {
+				return false
+			}
+			if !polylineWithinMultiloops(*pl, q.loops) {
+				return false
+			}
+		}
+		return true
+	}
+	return false
+}
+
+func polylineWithinMultiloops(pl s2.Polyline, loops []*s2.Loop) bool {
+	for _, l := range loops {
+		if polylineWithinLoop(l, pl) {
+			return true
+		}
 	}
 	return false
 }
@@ -398,6 +425,30 @@ func (q GeoQueryData) intersects(g geom.T) bool {
 			}
 		}
 		return false
+	case *geom.LineString:
+		pl, err := polylineFromLineString(v)
+		if err != nil {
+			return false
+		}
+		for _, loop := range q.loops {
+			if polylineIntersectsLoop(loop, *pl) {
+				return true
+			}
+		}
+		return false
+	case *geom.MultiLineString:
+		for i := range v.NumLineStrings() {
+			pl, err := polylineFromLineString(v.LineString(i))
+			if err != nil {
+				return false
+			}
+			for _, loop := range q.loops {
+				if polylineIntersectsLoop(loop, *pl) {
+					return true
+				}
+			}
+		}
+		return false
 	default:
 		// A type that we don't know how to handle.
 		return false
@@ -418,6 +418,51 @@ func TestMatchesFilterNearPoint(t *testing.T) {
 	require.True(t, qd.MatchesFilter(poly))
 }
 
+func TestMatchesFilterLineString(t *testing.T) {
+	poly := geom.NewPolygon(geom.XY).MustSetCoords([][]geom.Coord{
+		{{-122, 37}, {-123, 37}, {-123, 38}, {-122, 38}, {-122, 37}},
+	})
+	data := formDataPolygon(t, poly)
+
+	inside := geom.NewLineString(geom.XY).MustSetCoords([]geom.Coord{
+		{-122.2, 37.2}, {-122.8, 37.8}})
+	crossing := geom.NewLineString(geom.XY).MustSetCoords([]geom.Coord{
+		{-121.5, 37.5}, {-123.5, 37.5}})
+	outside := geom.NewLineString(geom.XY).MustSetCoords([]geom.Coord{
+		{-121.5, 37.5}, {-121.5, 38.5}})
+
+	_, qd, err := queryTokens(QueryTypeWithin, data, 0.0)
+	require.NoError(t, err)
+	require.True(t, qd.MatchesFilter(inside))
+	require.False(t, qd.MatchesFilter(crossing))
+	require.False(t, qd.MatchesFilter(outside))
+
+	_, qd, err = queryTokens(QueryTypeIntersects, data, 0.0)
+	require.NoError(t, err)
+	require.True(t, qd.MatchesFilter(inside))
+	require.True(t, qd.MatchesFilter(crossing))
+	require.False(t, qd.MatchesFilter(outside))
+
+	multi := geom.NewMultiLineString(geom.XY).MustSetCoords([][]geom.Coord{
+		{{-122.2, 37.2}, {-122.8, 37.8}},
+		{{-121.5, 37.5}, {-121.5, 38.5}},
+	})
+	require.True(t, qd.MatchesFilter(multi))
+
+	// Lines don't contain anything.
+	p := geom.NewPoint(geom.XY).MustSetCoords(geom.Coord{-122.2, 37.2})
+	_, qd, err = queryTokens(QueryTypeContains, formDataPoint(t, p), 0.0)
+	require.NoError(t, err)
+	require.False(t, qd.MatchesFilter(inside))
+
+	// A line passing close to the point is near it, even though its vertices are far away.
+	p = geom.NewPoint(geom.XY).MustSetCoords(geom.Coord{-122.5, 37.5})
+	_, qd, err = queryTokens(QueryTypeNear, formDataPoint(t, p), 1000.0)
+	require.NoError(t, err)
+	require.True(t, qd.MatchesFilter(crossing))
+	require.False(t, qd.MatchesFilter(outside))
+}
+
 func BenchmarkMatchesFilterContainsPoint(b *testing.B) {
 	us, _ := loadPolygon("testdata/us.json")
 	b.ResetTimer()
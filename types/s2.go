@@ -29,6 +29,42 @@ func edgesCrossPoints(l *s2.Loop, pts []s2.Point) bool {
 	return false
 }
 
+// edgesCrossPolyline is like edgesCrossPoints, but for an open chain of points.
+func edgesCrossPolyline(l *s2.Loop, pl s2.Polyline) bool {
+	for i := 0; i < len(pl)-1; i++ {
+		crosser := s2.NewChainEdgeCrosser(pl[i], pl[i+1], l.Vertex(0))
+		for j := 1; j <= l.NumEdges(); j++ {
+			if crosser.EdgeOrVertexChainCrossing(l.Vertex(j)) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// polylineWithinLoop checks whether all of the polyline lies inside the loop.
+func polylineWithinLoop(l *s2.Loop, pl s2.Polyline) bool {
+	for _, p := range pl {
+		if !l.ContainsPoint(p) {
+			return false
+		}
+	}
+	return !edgesCrossPolyline(l, pl)
+}
+
+// polylineIntersectsLoop checks whether any part of the polyline lies inside the loop.
+func polylineIntersectsLoop(l *s2.Loop, pl s2.Polyline) bool {
+	if !l.RectBound().Intersects(pl.RectBound()) {
+		return false
+	}
+	for _, p := range pl {
+		if l.ContainsPoint(p) {
+			return true
+		}
+	}
+	return edgesCrossPolyline(l, pl)
+}
+
 func intersects(l *s2.Loop, loop *s2.Loop) bool {
 	// Quick check if the bounding boxes intersect
 	if !l.RectBound().Intersects(loop.RectBound()) {
@@ -150,6 +186,10 @@ func convertToGeom(str string) (geom.T, error) {
 			if err := closed(v); err != nil {
 				return nil, err
 			}
+		case *geom.LineString:
+			if v.NumCoords() < 2 {
+				return nil, errors.Errorf("Line must have at least 2 coords")
+			}
 		}
 		return g, nil
 	}
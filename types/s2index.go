@@ -90,6 +90,25 @@ func indexCells(g geom.T) (parents, cover s2.CellUnion, err error) {
 		// Get parents for all cells in cover.
 		parents := getParentCells(cover, MinCellLevel)
 		return parents, cover, nil
+	case *geom.LineString:
+		pl, err := polylineFromLineString(v)
+		if err != nil {
+			return nil, nil, err
+		}
+		cover := coverRegion(pl, MinCellLevel, MaxCellLevel, MaxCells)
+		parents := getParentCells(cover, MinCellLevel)
+		return parents, cover, nil
+	case *geom.MultiLineString:
+		var cover s2.CellUnion
+		for i := range v.NumLineStrings() {
+			pl, err := polylineFromLineString(v.LineString(i))
+			if err != nil {
+				return nil, nil, err
+			}
+			cover = append(cover, coverRegion(pl, MinCellLevel, MaxCellLevel, MaxCells)...)
+		}
+		parents := getParentCells(cover, MinCellLevel)
+		return parents, cover, nil
 	default:
 		return nil, nil, errors.Errorf("Cannot index geometry of type %T", v)
 	}
@@ -143,6 +162,28 @@ func loopFromPolygon(p *geom.Polygon) (*s2.Loop, error) {
 	return l, nil
 }
 
+// polylineFromLineString converts a geom.LineString to a s2.Polyline.
+func polylineFromLineString(l *geom.LineString) (*s2.Polyline, error) {
+	n := l.NumCoords()
+	if n < 2 {
+		return nil, errors.Errorf("Can't convert line with less than 2 pts")
+	}
+	pts := make([]s2.Point, 0, n)
+	for i := range n {
+		p := pointFromCoord(l.Coord(i))
+		// s2 doesn't allow an edge to start and end at the same point.
+		if len(pts) > 0 && pts[len(pts)-1].ApproxEqual(p) {
+			continue
+		}
+		pts = append(pts, p)
+	}
+	if len(pts) < 2 {
+		return nil, errors.Errorf("Line must have at least 2 distinct pts")
+	}
+	pl := s2.Polyline(pts)
+	return &pl, nil
+}
+
 // Checks if a ring is clockwise or counter-clockwise. Note: This uses the algorithm for planar
 // polygons and doesn't work for spherical polygons that contain the poles or the antimeridan
 // discontinuity. We use this as a fast approximation instead.
@@ -208,13 +249,17 @@ func getParentCells(cu s2.CellUnion, minLevel int) s2.CellUnion {
 }
 
 func coverLoop(l *s2.Loop, minLevel int, maxLevel int, maxCells int) s2.CellUnion {
+	return coverRegion(l, minLevel, maxLevel, maxCells)
+}
+
+func coverRegion(r s2.Region, minLevel int, maxLevel int, maxCells int) s2.CellUnion {
 	rc := &s2.RegionCoverer{
 		MinLevel: minLevel,
 		MaxLevel: maxLevel,
 		LevelMod: 0,
 		MaxCells: maxCells,
 	}
-	return rc.Covering(l)
+	return rc.Covering(r)
 }
 
 // appendTokens creates tokens with a certain prefix and append.
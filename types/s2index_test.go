@@ -99,6 +99,38 @@ func TestIndexCellsPolygonError(t *testing.T) {
 	require.Contains(t, err.Error(), "Last coordinate not same as first")
 }
 
+func TestIndexCellsLineString(t *testing.T) {
+	line := geom.NewLineString(geom.XY).MustSetCoords([]geom.Coord{
+		{-122.41, 37.77}, {-122.27, 37.80}, {-122.27, 37.87}})
+	parents, cover, err := indexCells(line)
+	require.NoError(t, err)
+	require.LessOrEqual(t, len(cover), MaxCells)
+	for _, c := range cover {
+		require.True(t, c.Level() <= MaxCellLevel && c.Level() >= MinCellLevel)
+		require.Contains(t, parents, c)
+	}
+
+	// Every vertex of the line is in the cover.
+	for i := range line.NumCoords() {
+		require.True(t, cover.ContainsPoint(pointFromCoord(line.Coord(i))))
+	}
+
+	multi := geom.NewMultiLineString(geom.XY).MustSetCoords([][]geom.Coord{
+		{{-122.41, 37.77}, {-122.27, 37.80}},
+		{{-73.99, 40.73}, {-73.95, 40.78}},
+	})
+	_, cover, err = indexCells(multi)
+	require.NoError(t, err)
+	require.True(t, cover.ContainsPoint(pointFromCoord(geom.Coord{-73.95, 40.78})))
+}
+
+func TestIndexCellsLineStringError(t *testing.T) {
+	line := geom.NewLineString(geom.XY).MustSetCoords([]geom.Coord{{-122, 37}, {-122, 37}})
+	_, _, err := indexCells(line)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "at least 2 distinct pts")
+}
+
 func TestKeyGeneratorPoint(t *testing.T) {
 	p := geom.NewPoint(geom.XY).MustSetCoords(geom.Coord{-122.082506, 37.4249518})
 	data, err := wkb.Marshal(p, binary.LittleEndian)
@@ -20,6 +20,9 @@ type AclCache struct {
 	loaded        bool
 	predPerms     map[string]map[string]int32
 	userPredPerms map[string]map[string]int32
+	// rowFilters maps a namespaced predicate, or a namespaced type rule, to the filters of
+	// the groups that restrict the nodes accessible through it.
+	rowFilters map[string]map[string]string
 }
 
 func (cache *AclCache) reset() {
@@ -48,6 +51,7 @@ var AclCachePtr = &AclCache{
 	loaded:        false,
 	predPerms:     make(map[string]map[string]int32),
 	userPredPerms: make(map[string]map[string]int32),
+	rowFilters:    make(map[string]map[string]string),
 }
 
 func (cache *AclCache) GetUserPredPerms(userId string) map[string]int32 {
@@ -88,12 +92,34 @@ func (cache *AclCache) Update(ns uint64, groups []acl.Group) {
 	// userPredPerms is the map, described above in Second, that maps a single
 	// user to a submap, and the submap maps a predicate to a permission
 
+	// rowFilters is a third map that stores the filters of the rules that have one, e.g.
+	// owner ->
+	//     dev -> eq(owner, $user)
+	// type:Project ->
+	//     dev -> eq(team, "dev")
+	// the filters are injected into the queries and mutations of the groups.
+
 	predPerms := make(map[string]map[string]int32)
 	userPredPerms := make(map[string]map[string]int32)
+	rowFilters := make(map[string]map[string]string)
 	for _, group := range groups {
-		acls := group.Rules
+		acls := group.Rules[:0:0]
 		users := group.Users
 
+		for _, acl := range group.Rules {
+			if len(acl.Predicate) > 0 && len(acl.Filter) > 0 {
+				aclPred := x.NamespaceAttr(ns, acl.Predicate)
+				if _, found := rowFilters[aclPred]; !found {
+					rowFilters[aclPred] = make(map[string]string)
+				}
+				rowFilters[aclPred][group.GroupID] = acl.Filter
+			}
+			// Type rules only carry a filter, they don't grant any permission.
+			if !acl.IsTypeRule() {
+				acls = append(acls, acl)
+			}
+		}
+
 		for _, acl := range acls {
 			if len(acl.Predicate) > 0 {
 				aclPred := x.NamespaceAttr(ns, acl.Predicate)
@@ -143,6 +169,12 @@ func (cache *AclCache) Update(ns uint64, groups []acl.Group) {
 		}
 	}
 
+	for k := range AclCachePtr.rowFilters {
+		if x.ParseNamespace(k) == ns {
+			delete(AclCachePtr.rowFilters, k)
+		}
+	}
+
 	// Set new rules in the cache
 	for k, v := range predPerms {
 		AclCachePtr.predPerms[k] = v
@@ -151,6 +183,35 @@ func (cache *AclCache) Update(ns uint64, groups []acl.Group) {
 	for k, v := range userPredPerms {
 		AclCachePtr.userPredPerms[k] = v
 	}
+
+	for k, v := range rowFilters {
+		AclCachePtr.rowFilters[k] = v
+	}
+}
+
+// GetRowFilters returns the filters that restrict the nodes the groups can access in the
+// namespace. The returned map goes from the predicate, or the type rule, to the filters of all
+// the groups that have one for it.
+func (cache *AclCache) GetRowFilters(ns uint64, groups []string) map[string][]string {
+	cache.RLock()
+	defer cache.RUnlock()
+
+	var filters map[string][]string
+	for nsPred, groupFilters := range cache.rowFilters {
+		predNs, pred := x.ParseNamespaceAttr(nsPred)
+		if predNs != ns {
+			continue
+		}
+		for _, group := range groups {
+			if filter, found := groupFilters[group]; found {
+				if filters == nil {
+					filters = make(map[string][]string)
+				}
+				filters[pred] = append(filters[pred], filter)
+			}
+		}
+	}
+	return filters
 }
 
 func (cache *AclCache) AuthorizePredicate(groups []string, predicate string,
@@ -183,6 +244,31 @@ func HasAccessToAllPreds(ns uint64, groups []string, operation *acl.Operation) b
 	return hasAccessToPred(pred, groups, operation)
 }
 
+// HasAdminPermission returns true if any of the groups has been granted the admin permission in
+// the namespace, through a rule with a non-zero permission on it.
+func HasAdminPermission(ns uint64, groups []string, perm string) bool {
+	AclCachePtr.RLock()
+	defer AclCachePtr.RUnlock()
+
+	groupPerms, found := AclCachePtr.predPerms[x.NamespaceAttr(ns, perm)]
+	if !found {
+		return false
+	}
+	for _, group := range groups {
+		if groupPerms[group] != 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// HasFieldKeyAccess returns true if any of the groups has been granted the field key in the
+// namespace, through a rule with the read permission on acl.FieldKeyPrefix + keyName.
+func HasFieldKeyAccess(ns uint64, groups []string, keyName string) bool {
+	pred := x.NamespaceAttr(ns, acl.FieldKeyPrefix+keyName)
+	return hasAccessToPred(pred, groups, acl.Read)
+}
+
 func hasAccessToPred(pred string, groups []string, operation *acl.Operation) bool {
 	AclCachePtr.RLock()
 	defer AclCachePtr.RUnlock()
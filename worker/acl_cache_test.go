@@ -51,3 +51,101 @@ func TestAclCache(t *testing.T) {
 	require.Error(t, AclCachePtr.AuthorizePredicate(emptyGroups, predicate, acl.Read),
 		"the anonymous user should not have access when the acl cache is empty")
 }
+
+func TestAclCacheRowFilters(t *testing.T) {
+	AclCachePtr = &AclCache{
+		predPerms:     make(map[string]map[string]int32),
+		userPredPerms: make(map[string]map[string]int32),
+		rowFilters:    make(map[string]map[string]string),
+	}
+
+	groups := []acl.Group{
+		{
+			GroupID: "dev",
+			Rules: []acl.Acl{
+				{Predicate: "assigned", Perm: 4, Filter: "eq(owner, $user)"},
+				{Predicate: "type:Project", Filter: `eq(team, "dev")`},
+			},
+		},
+		{
+			GroupID: "ops",
+			Rules: []acl.Acl{
+				{Predicate: "assigned", Perm: 4, Filter: "has(public)"},
+			},
+		},
+	}
+	AclCachePtr.Update(x.RootNamespace, groups)
+
+	require.Equal(t, map[string][]string{
+		"assigned":     {"eq(owner, $user)"},
+		"type:Project": {`eq(team, "dev")`},
+	}, AclCachePtr.GetRowFilters(x.RootNamespace, []string{"dev"}))
+	require.Equal(t, []string{"eq(owner, $user)", "has(public)"},
+		AclCachePtr.GetRowFilters(x.RootNamespace, []string{"qa", "dev", "ops"})["assigned"])
+	require.Nil(t, AclCachePtr.GetRowFilters(x.RootNamespace, []string{"qa"}))
+	require.Nil(t, AclCachePtr.GetRowFilters(1, []string{"dev"}))
+
+	// Type rules don't grant any permission.
+	require.NoError(t, AclCachePtr.AuthorizePredicate([]string{"dev"},
+		x.AttrInRootNamespace("assigned"), acl.Read))
+	require.Error(t, AclCachePtr.AuthorizePredicate([]string{"dev"},
+		x.AttrInRootNamespace("type:Project"), acl.Read))
+}
+
+func TestAclCacheAdminPermission(t *testing.T) {
+	AclCachePtr = &AclCache{
+		predPerms:     make(map[string]map[string]int32),
+		userPredPerms: make(map[string]map[string]int32),
+		rowFilters:    make(map[string]map[string]string),
+	}
+
+	AclCachePtr.Update(x.RootNamespace, []acl.Group{
+		{
+			GroupID: "backup-operators",
+			Rules:   []acl.Acl{{Predicate: acl.AdminBackup, Perm: 7}},
+		},
+	})
+	AclCachePtr.Update(1, []acl.Group{
+		{
+			GroupID: "schema-editors",
+			Rules:   []acl.Acl{{Predicate: acl.AdminSchema, Perm: 7}},
+		},
+	})
+
+	require.True(t, HasAdminPermission(x.RootNamespace, []string{"backup-operators"},
+		acl.AdminBackup))
+	require.False(t, HasAdminPermission(x.RootNamespace, []string{"backup-operators"},
+		acl.AdminRestore))
+	require.False(t, HasAdminPermission(x.RootNamespace, []string{"dev"}, acl.AdminBackup))
+	require.False(t, HasAdminPermission(1, []string{"backup-operators"}, acl.AdminBackup))
+	require.True(t, HasAdminPermission(1, []string{"dev", "schema-editors"}, acl.AdminSchema))
+}
+
+func TestAclCacheFieldKeyAccess(t *testing.T) {
+	AclCachePtr = &AclCache{
+		predPerms:     make(map[string]map[string]int32),
+		userPredPerms: make(map[string]map[string]int32),
+		rowFilters:    make(map[string]map[string]string),
+	}
+
+	AclCachePtr.Update(x.RootNamespace, []acl.Group{
+		{
+			GroupID: "hr",
+			Rules:   []acl.Acl{{Predicate: acl.FieldKeyPrefix + "pii", Perm: 4}},
+		},
+		{
+			GroupID: "auditors",
+			Rules:   []acl.Acl{{Predicate: acl.FieldKeyPrefix + "pii", Perm: 2}},
+		},
+		{
+			GroupID: "dev",
+			Rules:   []acl.Acl{{Predicate: "dgraph.all", Perm: 7}},
+		},
+	})
+
+	require.True(t, HasFieldKeyAccess(x.RootNamespace, []string{"hr"}, "pii"))
+	require.False(t, HasFieldKeyAccess(x.RootNamespace, []string{"hr"}, "billing"))
+	require.False(t, HasFieldKeyAccess(x.RootNamespace, []string{"auditors"}, "pii"))
+	require.False(t, HasFieldKeyAccess(x.RootNamespace, []string{"dev"}, "pii"))
+	require.False(t, HasFieldKeyAccess(1, []string{"hr"}, "pii"))
+}
@@ -0,0 +1,590 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package worker
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/klauspost/compress/s2"
+	"github.com/pkg/errors"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/dgraph-io/badger/v4"
+	bpb "github.com/dgraph-io/badger/v4/pb"
+	"github.com/dgraph-io/ristretto/v2/z"
+	"github.com/hypermodeinc/dgraph/v25/enc"
+	"github.com/hypermodeinc/dgraph/v25/posting"
+	"github.com/hypermodeinc/dgraph/v25/protos/pb"
+	"github.com/hypermodeinc/dgraph/v25/schema"
+	"github.com/hypermodeinc/dgraph/v25/x"
+)
+
+// Continuous archiving
+//
+// With the archive flag set, the leader of each group writes the changes committed to its
+// tablets since its last segment to a new archive segment, every archive interval. A segment
+// holds every version of the changed posting lists, each rolled up as of its commit ts, in the
+// format of the backups. So a restore until a timestamp maps the latest backup taken before it,
+// and then the segments after the backup, skipping the versions committed after the timestamp.
+// The reduce phase keeps the latest version of each key.
+//
+// The first segment of a group continues from the latest backup at the destination, if any.
+// If the archive falls behind by more than a snapshot, the versions discarded since are only
+// restored as of the version they were rolled up into. Like a backup, a segment can't be written
+// while a predicate of the group is split across groups.
+
+// archiveDir is the directory of the archive at its destination. The segments of each group are
+// in the g<group> directory under it.
+const archiveDir = "dgraph.archive"
+
+// ArchiveSegment records the details of an archive segment. It's written to <read_ts>.json next
+// to the data of the segment, once the data is written.
+type ArchiveSegment struct {
+	GroupId uint32 `json:"group_id"`
+	// SinceTs is the read ts of the previous segment of the group. The segment has the versions
+	// committed after it.
+	SinceTs uint64 `json:"since_ts"`
+	// ReadTs is the timestamp the segment was read at.
+	ReadTs uint64 `json:"read_ts"`
+	// Time is the wall-clock time ReadTs was taken at.
+	Time time.Time `json:"time"`
+	// Predicates are the predicates served by the group at ReadTs.
+	Predicates []string `json:"predicates"`
+	// DropOperations are the drops committed in the segment. Only group 1, which serves the
+	// drop records, records them.
+	DropOperations []*ArchiveDrop `json:"drop_operations,omitempty"`
+	Encrypted      bool           `json:"encrypted"`
+	KeyFingerprint string         `json:"key_fingerprint,omitempty"`
+	Compression    string         `json:"compression"`
+}
+
+// ArchiveDrop is a drop operation committed at Ts.
+type ArchiveDrop struct {
+	DropOp    pb.DropOperation_DropOp `json:"drop_op"`
+	DropValue string                  `json:"drop_value"`
+	Ts        uint64                  `json:"ts"`
+}
+
+func archiveGroupDir(gid uint32) string {
+	return filepath.Join(archiveDir, fmt.Sprintf("g%d", gid))
+}
+
+func (s *ArchiveSegment) dataPath() string {
+	return filepath.Join(archiveGroupDir(s.GroupId), fmt.Sprintf("r%d.archive", s.ReadTs))
+}
+
+func (s *ArchiveSegment) metaPath() string {
+	return filepath.Join(archiveGroupDir(s.GroupId), fmt.Sprintf("r%d.json", s.ReadTs))
+}
+
+// hasData returns whether the segment has a data file. The first segment of an archive which
+// doesn't continue from a backup only marks where the archive starts.
+func (s *ArchiveSegment) hasData() bool {
+	return s.SinceTs < s.ReadTs
+}
+
+// encryptionKey returns the key the segment was encrypted with among the given keys.
+func (s *ArchiveSegment) encryptionKey(keys ...x.Sensitive) (x.Sensitive, error) {
+	if !s.Encrypted {
+		return nil, nil
+	}
+	return (&Manifest{Path: s.dataPath(), KeyFingerprint: s.KeyFingerprint}).encryptionKey(keys...)
+}
+
+// readArchiveSegments returns the segments of the group archived at the handler, by read ts.
+func readArchiveSegments(h UriHandler, gid uint32) ([]*ArchiveSegment, error) {
+	dir := archiveGroupDir(gid)
+	var segments []*ArchiveSegment
+	if !h.DirExists(dir) {
+		return nil, nil
+	}
+	for _, path := range h.ListPaths(dir) {
+		name := filepath.Base(path)
+		if filepath.Ext(name) != ".json" || filepath.Base(filepath.Dir(path)) != filepath.Base(dir) {
+			continue
+		}
+		b, err := h.Read(filepath.Join(dir, name))
+		if err != nil {
+			return nil, errors.Wrapf(err, "while reading archive segment %s", name)
+		}
+		var s ArchiveSegment
+		if err := json.Unmarshal(b, &s); err != nil {
+			return nil, errors.Wrapf(err, "while reading archive segment %s", name)
+		}
+		segments = append(segments, &s)
+	}
+	sort.Slice(segments, func(i, j int) bool {
+		return segments[i].ReadTs < segments[j].ReadTs
+	})
+	return segments, nil
+}
+
+// archiveGroups returns the groups archived at the handler.
+func archiveGroups(h UriHandler) map[uint32]struct{} {
+	groups := make(map[uint32]struct{})
+	if !h.DirExists(archiveDir) {
+		return groups
+	}
+	for _, path := range h.ListPaths(archiveDir) {
+		var gid uint32
+		if _, err := fmt.Sscanf(filepath.Base(filepath.Dir(path)), "g%d", &gid); err == nil {
+			groups[gid] = struct{}{}
+		}
+	}
+	return groups
+}
+
+// archiver writes the archive segments of a group, while this alpha is its leader.
+type archiver struct {
+	uri     *url.URL
+	handler UriHandler
+	// last is the latest segment of the group. It's read from the destination when this alpha
+	// becomes the leader.
+	last *ArchiveSegment
+}
+
+// rebuiltSince is the lowest start ts of the index rebuilds since the last archive round, or zero.
+// A rebuild writes at the start ts of its schema change, which is usually below the read ts of
+// the last segment, so the next segment is read from below it.
+var rebuiltSince atomic.Uint64
+
+// noteIndexRebuild records an index rebuild writing at startTs, for the next archive round.
+func noteIndexRebuild(startTs uint64) {
+	for {
+		cur := rebuiltSince.Load()
+		if cur != 0 && cur <= startTs {
+			return
+		}
+		if rebuiltSince.CompareAndSwap(cur, startTs) {
+			return
+		}
+	}
+}
+
+// processArchive archives the changes committed to the tablets of the group every archive
+// interval, while this alpha is the leader of the group.
+func (n *node) processArchive() {
+	defer n.closer.Done() // CLOSER:1
+	if Config.ArchiveConf == "" || Config.ArchiveConf == ArchiveDefaults {
+		return
+	}
+	archiveFlag := z.NewSuperFlag(Config.ArchiveConf).MergeAndCheckDefault(ArchiveDefaults)
+	uri, err := url.Parse(archiveFlag.GetString("dest"))
+	x.Check(err)
+	handler, err := NewUriHandler(uri, nil)
+	x.Check(err)
+	a := &archiver{uri: uri, handler: handler}
+
+	tick := time.NewTicker(archiveFlag.GetDuration("frequency"))
+	defer tick.Stop()
+	for {
+		select {
+		case <-n.closer.HasBeenClosed():
+			return
+		case <-tick.C:
+			if !n.AmLeader() {
+				a.last = nil
+				continue
+			}
+			if err := a.round(n.ctx, n.gid); err != nil {
+				glog.Errorf("While archiving group %d to %s: %v", n.gid, uri, err)
+			}
+		}
+	}
+}
+
+// round writes the next archive segment of the group.
+func (a *archiver) round(ctx context.Context, gid uint32) error {
+	if a.last == nil {
+		segments, err := readArchiveSegments(a.handler, gid)
+		if err != nil {
+			return err
+		}
+		if len(segments) > 0 {
+			a.last = segments[len(segments)-1]
+		}
+	}
+
+	// The indexes being rebuilt are incomplete, so they are archived once they are done.
+	if schema.State().IndexingInProgress() {
+		glog.V(2).Infof("Not archiving group %d while its indexes are rebuilt", gid)
+		return nil
+	}
+	rebuilt := rebuiltSince.Load()
+
+	start := time.Now()
+	readTs := State.GetTimestamp(true)
+	if err := posting.Oracle().WaitForTs(ctx, readTs); err != nil {
+		return err
+	}
+	sinceTs := readTs
+	switch {
+	case a.last != nil:
+		sinceTs = a.last.ReadTs
+	default:
+		// Continue from the latest backup, so that it can be restored with the archive.
+		m, err := GetLatestManifest(a.handler, a.uri)
+		if err != nil {
+			return err
+		}
+		if ts := m.ValidReadTs(); ts > 0 && ts < readTs {
+			sinceTs = ts
+		}
+	}
+	if rebuilt > 0 && rebuilt <= sinceTs {
+		sinceTs = rebuilt - 1
+	}
+
+	seg := &ArchiveSegment{
+		GroupId:     gid,
+		SinceTs:     sinceTs,
+		ReadTs:      readTs,
+		Time:        start.UTC(),
+		Compression: "snappy",
+		Encrypted:   x.WorkerConfig.EncryptionKey != nil,
+	}
+	if seg.Encrypted {
+		seg.KeyFingerprint = x.KeyFingerprint(x.WorkerConfig.EncryptionKey)
+	}
+	preds, err := archivePredicates(GetMembershipState().GetGroups()[gid].GetTablets(), gid)
+	if err != nil {
+		return err
+	}
+	seg.Predicates = preds
+
+	if err := a.handler.CreateDir(archiveGroupDir(gid)); err != nil {
+		return errors.Wrap(err, "while creating archive directory")
+	}
+	if seg.hasData() {
+		if err := writeArchiveSegment(ctx, a.handler, seg); err != nil {
+			return err
+		}
+	}
+	// A rebuild started meanwhile may have written part of an index below the read ts. The
+	// segment is left out, and the next round reads from below the rebuild.
+	if schema.State().IndexingInProgress() || rebuiltSince.Load() != rebuilt {
+		if seg.hasData() {
+			if err := a.handler.Delete(seg.dataPath()); err != nil {
+				glog.Warningf("While removing archive segment %s: %v", seg.dataPath(), err)
+			}
+		}
+		glog.V(2).Infof("Not archiving group %d at ts %d, its indexes were rebuilt meanwhile",
+			gid, readTs)
+		return nil
+	}
+	w, err := a.handler.CreateFile(seg.metaPath())
+	if err != nil {
+		return err
+	}
+	if err := json.NewEncoder(w).Encode(seg); err != nil {
+		return err
+	}
+	if err := w.Close(); err != nil {
+		return err
+	}
+	a.last = seg
+	rebuiltSince.CompareAndSwap(rebuilt, 0)
+	glog.V(2).Infof("Archived group %d from ts %d to %d in %s", gid, sinceTs, readTs,
+		time.Since(start).Round(time.Millisecond))
+	return nil
+}
+
+// archivePredicates returns the predicates of the tablets served by the group, to be archived.
+// It fails if a tablet is split across groups, as a restore would silently miss it otherwise.
+func archivePredicates(tablets map[string]*pb.Tablet, gid uint32) ([]string, error) {
+	var res []string
+	for pred, tablet := range tablets {
+		if len(tablet.GetShards()) > 0 {
+			return nil, errors.Errorf("Archiving predicate %s split across groups %v isn't "+
+				"supported", x.ParseAttr(pred), tablet.GetShards())
+		}
+		if tablet.GroupId != gid {
+			continue
+		}
+		// The predicates supporting a vector index are archived along with it.
+		if preds := schema.State().PredicatesToDelete(pred); len(preds) > 0 {
+			res = append(res, preds...)
+		} else {
+			res = append(res, pred)
+		}
+	}
+	return res, nil
+}
+
+// writeArchiveSegment writes every version of the keys of the predicates of the segment, and of
+// the schema and types, committed after its since ts. It records the drops it finds.
+func writeArchiveSegment(ctx context.Context, h UriHandler, seg *ArchiveSegment) error {
+	w, err := h.CreateFile(seg.dataPath())
+	if err != nil {
+		return errors.Wrap(err, "while creating archive file")
+	}
+	eWriter, err := enc.GetWriter(x.WorkerConfig.EncryptionKey, w)
+	if err != nil {
+		return err
+	}
+	cWriter := s2.NewWriter(eWriter)
+
+	preds := make(map[string]struct{})
+	for _, pred := range seg.Predicates {
+		preds[pred] = struct{}{}
+	}
+	var mu sync.Mutex
+	stream := pstore.NewStreamAt(seg.ReadTs)
+	stream.LogPrefix = "Dgraph.Archive"
+	stream.SinceTs = seg.SinceTs
+	stream.ChooseKey = func(item *badger.Item) bool {
+		pk, err := x.Parse(item.Key())
+		if err != nil || pk.HasStartUid {
+			return false
+		}
+		_, ok := preds[pk.Attr]
+		return ok || pk.IsType()
+	}
+	stream.KeyToList = func(key []byte, itr *badger.Iterator) (*bpb.KVList, error) {
+		list, drops, err := archiveVersions(key, itr, seg.SinceTs)
+		mu.Lock()
+		seg.DropOperations = append(seg.DropOperations, drops...)
+		mu.Unlock()
+		return list, err
+	}
+	stream.Send = func(buf *z.Buffer) error {
+		list, err := badger.BufferToKVList(buf)
+		if err != nil {
+			return err
+		}
+		return writeKVList(list, cWriter)
+	}
+	if err := stream.Orchestrate(ctx); err != nil {
+		return errors.Wrap(err, "while archiving")
+	}
+	if err := cWriter.Close(); err != nil {
+		return err
+	}
+	return w.Close()
+}
+
+// archiveVersions returns the versions of the key committed after sinceTs, in the format of the
+// backups. A posting list is rolled up as of each of its versions, so that it can be restored as
+// of any of them.
+func archiveVersions(key []byte, itr *badger.Iterator,
+	sinceTs uint64) (*bpb.KVList, []*ArchiveDrop, error) {
+	pk, err := x.Parse(key)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "while parsing key %x", key)
+	}
+	bk, err := proto.Marshal(pk.ToBackupKey())
+	if err != nil {
+		return nil, nil, err
+	}
+	isDropOp, err := x.IsDropOpKey(key)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	list := &bpb.KVList{}
+	var drops []*ArchiveDrop
+	var bpl pb.BackupPostingList
+	buf := z.NewBuffer(1<<10, "Archive.Versions")
+	defer func() {
+		if err := buf.Release(); err != nil {
+			glog.Warningf("error in releasing buffer: %v", err)
+		}
+	}()
+	for ; itr.Valid() && bytes.Equal(itr.Item().Key(), key); itr.Next() {
+		item := itr.Item()
+		version := item.Version()
+		if version <= sinceTs {
+			break
+		}
+		if pk.IsSchema() || pk.IsType() {
+			if item.IsDeletedOrExpired() {
+				continue
+			}
+			val, err := item.ValueCopy(nil)
+			if err != nil {
+				return nil, nil, err
+			}
+			list.Kv = append(list.Kv, &bpb.KV{Key: bk, Value: val,
+				UserMeta: []byte{item.UserMeta()}, Version: version})
+			continue
+		}
+
+		l, err := posting.GetNoStore(key, version)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "while reading posting list")
+		}
+		kv, err := l.ToBackupPostingList(&bpl, itr.Alloc, buf)
+		if err != nil {
+			return nil, nil, errors.Wrapf(err, "while rolling up list")
+		}
+		list.Kv = append(list.Kv, &bpb.KV{Key: bk, Value: bytes.Clone(kv.Value),
+			UserMeta: []byte{kv.UserMeta[0]}, Version: version})
+		if isDropOp {
+			op, err := checkAndGetDropOp(key, l, version)
+			if err != nil {
+				return nil, nil, err
+			}
+			if op != nil {
+				drops = append(drops, &ArchiveDrop{DropOp: op.DropOp, DropValue: op.DropValue,
+					Ts: version})
+			}
+		}
+	}
+	return list, drops, nil
+}
+
+// archiveRestore is the part of the archive of a group replayed by a restore.
+type archiveRestore struct {
+	// segments are the segments with data to replay, latest first.
+	segments []*ArchiveSegment
+	drops    archiveDrops
+}
+
+// preds returns the predicates served by the group as of the restore.
+func (ar *archiveRestore) preds() predicateSet {
+	preds := make(predicateSet)
+	if len(ar.segments) > 0 {
+		for _, pred := range ar.segments[0].Predicates {
+			preds[pred] = struct{}{}
+		}
+	}
+	return preds
+}
+
+// getArchiveToRestore returns the archive segments of the group to replay on top of the latest
+// backup to restore, to restore the data as of the until ts of the request. It returns nil if the
+// request restores the backups only.
+func getArchiveToRestore(h UriHandler, req *pb.RestoreRequest,
+	latest *Manifest) (*archiveRestore, error) {
+	if req.UntilTs == 0 || req.UntilTs <= latest.ValidReadTs() {
+		return nil, nil
+	}
+	segments, err := readArchiveSegments(h, req.GroupId)
+	if err != nil {
+		return nil, err
+	}
+	ar := &archiveRestore{}
+	ts := latest.ValidReadTs()
+	for _, seg := range segments {
+		if seg.ReadTs <= ts {
+			continue
+		}
+		if seg.SinceTs > ts {
+			return nil, errors.Errorf("The archive of group %d is missing the changes between "+
+				"ts %d and %d", req.GroupId, ts, seg.SinceTs)
+		}
+		if seg.hasData() {
+			ar.segments = append([]*ArchiveSegment{seg}, ar.segments...)
+		}
+		ts = seg.ReadTs
+		if ts >= req.UntilTs {
+			break
+		}
+	}
+	if ts < req.UntilTs {
+		return nil, errors.Errorf("The archive of group %d only reaches ts %d", req.GroupId, ts)
+	}
+
+	// The drops are recorded by group 1, which serves the drop records.
+	if req.GroupId != 1 {
+		if segments, err = readArchiveSegments(h, 1); err != nil {
+			return nil, err
+		}
+	}
+	for _, seg := range segments {
+		for _, drop := range seg.DropOperations {
+			if drop.Ts > latest.ValidReadTs() && drop.Ts <= req.UntilTs {
+				ar.drops = append(ar.drops, drop)
+			}
+		}
+	}
+	return ar, nil
+}
+
+// archiveDrops are the drops committed in the replayed part of an archive.
+type archiveDrops []*ArchiveDrop
+
+// dropOperations returns the drops as the drop operations of a backup.
+func (ds archiveDrops) dropOperations() []*pb.DropOperation {
+	var ops []*pb.DropOperation
+	for _, d := range ds {
+		ops = append(ops, &pb.DropOperation{DropOp: d.DropOp, DropValue: d.DropValue})
+	}
+	return ops
+}
+
+// shadows returns whether a version of the key was removed by a later drop.
+func (ds archiveDrops) shadows(pk x.ParsedKey, ns, version uint64) bool {
+	for _, d := range ds {
+		if version >= d.Ts {
+			continue
+		}
+		switch d.DropOp {
+		case pb.DropOperation_ALL:
+			return true
+		case pb.DropOperation_ATTR:
+			if pk.Attr == d.DropValue {
+				return true
+			}
+		case pb.DropOperation_DATA:
+			if d.DropValue == "" {
+				return true
+			}
+			fallthrough
+		case pb.DropOperation_NS:
+			dropNs, err := strconv.ParseUint(d.DropValue, 0, 64)
+			if err != nil || dropNs != ns {
+				continue
+			}
+			// Dropping the data of a namespace keeps its schema and types.
+			if d.DropOp == pb.DropOperation_NS || (!pk.IsSchema() && !pk.IsType()) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// ArchiveTsAt returns the latest timestamp archived at the location at or before the given time.
+// A restore until it restores the data as of that time, to the archive interval.
+func ArchiveTsAt(location string, creds *x.MinioCredentials, t time.Time) (uint64, error) {
+	uri, err := url.Parse(location)
+	if err != nil {
+		return 0, err
+	}
+	h, err := NewUriHandler(uri, creds)
+	if err != nil {
+		return 0, err
+	}
+	var ts uint64
+	for gid := range archiveGroups(h) {
+		segments, err := readArchiveSegments(h, gid)
+		if err != nil {
+			return 0, err
+		}
+		for _, seg := range segments {
+			if !seg.Time.After(t) {
+				ts = x.Max(ts, seg.ReadTs)
+			}
+		}
+	}
+	if ts == 0 {
+		return 0, errors.Errorf("Nothing was archived at %s by %s", location,
+			t.Format(time.RFC3339))
+	}
+	return ts, nil
+}
@@ -0,0 +1,169 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package worker
+
+import (
+	"context"
+	"encoding/json"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hypermodeinc/dgraph/v25/protos/pb"
+	"github.com/hypermodeinc/dgraph/v25/schema"
+	"github.com/hypermodeinc/dgraph/v25/x"
+)
+
+func writeTestSegments(t *testing.T, h UriHandler, segments ...*ArchiveSegment) {
+	for _, seg := range segments {
+		require.NoError(t, h.CreateDir(archiveGroupDir(seg.GroupId)))
+		w, err := h.CreateFile(seg.metaPath())
+		require.NoError(t, err)
+		require.NoError(t, json.NewEncoder(w).Encode(seg))
+		require.NoError(t, w.Close())
+	}
+}
+
+func TestArchiveToRestore(t *testing.T) {
+	uri, err := url.Parse("file://" + t.TempDir())
+	require.NoError(t, err)
+	h, err := NewUriHandler(uri, nil)
+	require.NoError(t, err)
+
+	drop := &ArchiveDrop{DropOp: pb.DropOperation_ATTR, DropValue: x.AttrInRootNamespace("name"),
+		Ts: 35}
+	writeTestSegments(t, h,
+		&ArchiveSegment{GroupId: 1, SinceTs: 5, ReadTs: 5},
+		&ArchiveSegment{GroupId: 1, SinceTs: 5, ReadTs: 20, Predicates: []string{"a"}},
+		&ArchiveSegment{GroupId: 1, SinceTs: 20, ReadTs: 30, Predicates: []string{"a", "b"}},
+		&ArchiveSegment{GroupId: 1, SinceTs: 30, ReadTs: 40, Predicates: []string{"b"},
+			DropOperations: []*ArchiveDrop{drop}},
+		&ArchiveSegment{GroupId: 2, SinceTs: 10, ReadTs: 30, Predicates: []string{"c"}},
+		&ArchiveSegment{GroupId: 2, SinceTs: 35, ReadTs: 50, Predicates: []string{"c"}},
+	)
+
+	// The backups reach the until ts.
+	ar, err := getArchiveToRestore(h, &pb.RestoreRequest{GroupId: 1, UntilTs: 10},
+		&Manifest{ReadTs: 10})
+	require.NoError(t, err)
+	require.Nil(t, ar)
+
+	// The segments are replayed from the backup until the first one to reach the until ts.
+	ar, err = getArchiveToRestore(h, &pb.RestoreRequest{GroupId: 1, UntilTs: 25},
+		&Manifest{ReadTs: 10})
+	require.NoError(t, err)
+	require.Len(t, ar.segments, 2)
+	require.Equal(t, uint64(30), ar.segments[0].ReadTs)
+	require.Equal(t, uint64(20), ar.segments[1].ReadTs)
+	require.Empty(t, ar.drops)
+	require.Equal(t, predicateSet{"a": {}, "b": {}}, ar.preds())
+
+	// Only the drops committed until the until ts are replayed, by every group.
+	ar, err = getArchiveToRestore(h, &pb.RestoreRequest{GroupId: 1, UntilTs: 40},
+		&Manifest{ReadTs: 10})
+	require.NoError(t, err)
+	require.Equal(t, archiveDrops{drop}, ar.drops)
+
+	// The archive doesn't reach the until ts.
+	_, err = getArchiveToRestore(h, &pb.RestoreRequest{GroupId: 1, UntilTs: 45},
+		&Manifest{ReadTs: 10})
+	require.ErrorContains(t, err, "only reaches ts 40")
+
+	// The archive of group 2 misses the changes between ts 30 and 35.
+	ar, err = getArchiveToRestore(h, &pb.RestoreRequest{GroupId: 2, UntilTs: 25},
+		&Manifest{ReadTs: 10})
+	require.NoError(t, err)
+	require.Len(t, ar.segments, 1)
+	_, err = getArchiveToRestore(h, &pb.RestoreRequest{GroupId: 2, UntilTs: 45},
+		&Manifest{ReadTs: 10})
+	require.ErrorContains(t, err, "missing the changes between ts 30 and 35")
+
+	// The archive doesn't continue from a backup.
+	_, err = getArchiveToRestore(h, &pb.RestoreRequest{GroupId: 1, UntilTs: 25},
+		&Manifest{ReadTs: 3})
+	require.ErrorContains(t, err, "missing the changes between ts 3 and 5")
+}
+
+func TestArchivePredicates(t *testing.T) {
+	if schema.State() == nil {
+		schema.Init(pstore)
+	}
+	name, age := x.AttrInRootNamespace("name"), x.AttrInRootNamespace("age")
+	preds, err := archivePredicates(map[string]*pb.Tablet{
+		name: {GroupId: 1, Predicate: name},
+		age:  {GroupId: 2, Predicate: age},
+	}, 1)
+	require.NoError(t, err)
+	require.Equal(t, []string{name}, preds)
+
+	_, err = archivePredicates(map[string]*pb.Tablet{
+		name: {GroupId: 1, Predicate: name, Shards: []uint32{1, 2}},
+	}, 1)
+	require.ErrorContains(t, err, "split across groups [1 2] isn't supported")
+}
+
+func TestArchiveIndexRebuild(t *testing.T) {
+	if schema.State() == nil {
+		schema.Init(pstore)
+	}
+	uri, err := url.Parse("file://" + t.TempDir())
+	require.NoError(t, err)
+	h, err := NewUriHandler(uri, nil)
+	require.NoError(t, err)
+	last := &ArchiveSegment{GroupId: 1, SinceTs: 5, ReadTs: 10}
+	writeTestSegments(t, h, last)
+
+	// No segment is archived while the indexes are rebuilt.
+	name := x.AttrInRootNamespace("name")
+	schema.State().SetMutSchema(name, &pb.SchemaUpdate{Predicate: name})
+	defer schema.State().DeleteMutSchema(name)
+	a := &archiver{uri: uri, handler: h}
+	require.NoError(t, a.round(context.Background(), 1))
+	require.Equal(t, last, a.last)
+	segments, err := readArchiveSegments(h, 1)
+	require.NoError(t, err)
+	require.Len(t, segments, 1)
+
+	// The lowest start ts of the rebuilds is kept until a round archives it.
+	defer rebuiltSince.Store(0)
+	noteIndexRebuild(8)
+	noteIndexRebuild(9)
+	require.Equal(t, uint64(8), rebuiltSince.Load())
+	noteIndexRebuild(7)
+	require.Equal(t, uint64(7), rebuiltSince.Load())
+}
+
+func TestArchiveDropsShadow(t *testing.T) {
+	parse := func(key []byte) x.ParsedKey {
+		pk, err := x.Parse(key)
+		require.NoError(t, err)
+		return pk
+	}
+	name := parse(x.DataKey(x.AttrInRootNamespace("name"), 1))
+	nameSchema := parse(x.SchemaKey(name.Attr))
+	other := parse(x.DataKey(x.NamespaceAttr(2, "age"), 1))
+	otherSchema := parse(x.SchemaKey(other.Attr))
+
+	attr := archiveDrops{{DropOp: pb.DropOperation_ATTR, DropValue: name.Attr, Ts: 10}}
+	require.True(t, attr.shadows(name, 0, 9))
+	require.True(t, attr.shadows(nameSchema, 0, 9))
+	require.False(t, attr.shadows(name, 0, 10))
+	require.False(t, attr.shadows(other, 2, 9))
+
+	data := archiveDrops{{DropOp: pb.DropOperation_DATA, DropValue: "2", Ts: 10}}
+	require.True(t, data.shadows(other, 2, 9))
+	require.False(t, data.shadows(otherSchema, 2, 9))
+	require.False(t, data.shadows(name, 0, 9))
+
+	ns := archiveDrops{{DropOp: pb.DropOperation_NS, DropValue: "2", Ts: 10}}
+	require.True(t, ns.shadows(otherSchema, 2, 9))
+	require.False(t, ns.shadows(name, 0, 9))
+
+	all := archiveDrops{{DropOp: pb.DropOperation_ALL, Ts: 10}}
+	require.True(t, all.shadows(otherSchema, 2, 9))
+	require.False(t, all.shadows(name, 0, 11))
+}
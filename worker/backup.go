@@ -7,6 +7,7 @@ package worker
 
 import (
 	"context"
+	"crypto/sha256"
 	"encoding/binary"
 	"encoding/hex"
 	"fmt"
@@ -68,11 +69,18 @@ type Manifest struct {
 	Path string `json:"path"`
 	// Encrypted indicates whether this backup was encrypted or not.
 	Encrypted bool `json:"encrypted"`
+	// KeyFingerprint identifies the key this backup was encrypted with, so that it can be
+	// restored after the key is rotated. It is empty for the backups taken before the rotation
+	// of keys was supported.
+	KeyFingerprint string `json:"key_fingerprint,omitempty"`
 	// DropOperations lists the various DROP operations that took place since the last backup.
 	// These are used during restore to redo those operations before applying the backup.
 	DropOperations []*pb.DropOperation `json:"drop_operations"`
 	// Compression keeps track of the compression that was used for the data.
 	Compression string `json:"compression"`
+	// Checksums are the hex-encoded SHA-256 checksums of the backup files of the groups. They
+	// are empty for the backups taken before the checksums were recorded.
+	Checksums map[uint32]string `json:"checksums,omitempty"`
 }
 
 // ValidReadTs function returns the valid read timestamp. The backup can have
@@ -102,6 +110,22 @@ func (m *Manifest) getPredsInGroup(gid uint32) predicateSet {
 	return predSet
 }
 
+// encryptionKey returns the key the backup was encrypted with among the given keys. The backups
+// that don't record their key are assumed to be encrypted with the first key.
+func (m *Manifest) encryptionKey(keys ...x.Sensitive) (x.Sensitive, error) {
+	if m.KeyFingerprint == "" {
+		if len(keys) == 0 {
+			return nil, nil
+		}
+		return keys[0], nil
+	}
+	if key := x.FindKey(m.KeyFingerprint, keys...); key != nil {
+		return key, nil
+	}
+	return nil, errors.Errorf("backup %s is encrypted with key %s, which is neither the "+
+		"encryption key nor one of the old keys", m.Path, m.KeyFingerprint)
+}
+
 // GetCredentialsFromRequest extracts the credentials from a backup request.
 func GetCredentialsFromRequest(req *pb.BackupRequest) *x.MinioCredentials {
 	return &x.MinioCredentials{
@@ -195,6 +219,7 @@ var backupLock sync.Mutex
 // BackupRes is used to represent the response and error of the Backup gRPC call together to be
 // transported via a channel.
 type BackupRes struct {
+	gid uint32
 	res *pb.BackupResponse
 	err error
 }
@@ -226,7 +251,7 @@ func ProcessBackupRequest(ctx context.Context, req *pb.BackupRequest) error {
 	}
 
 	req.ReadTs = ts.ReadOnly
-	req.UnixTs = time.Now().UTC().Format("20060102.150405.000")
+	req.UnixTs = time.Now().UTC().Format(backupTimeFmt)
 
 	// Read the manifests to get the right timestamp from which to start the backup.
 	uri, err := url.Parse(req.Destination)
@@ -242,6 +267,11 @@ func ProcessBackupRequest(ctx context.Context, req *pb.BackupRequest) error {
 			return errors.Wrap(err, "while creating backup directory")
 		}
 	}
+	unlock, err := lockLocation(handler)
+	if err != nil {
+		return err
+	}
+	defer unlock()
 	latestManifest, err := GetLatestManifest(handler, uri)
 	if err != nil {
 		return err
@@ -281,7 +311,11 @@ func ProcessBackupRequest(ctx context.Context, req *pb.BackupRequest) error {
 	for gid, group := range state.Groups {
 		groups = append(groups, gid)
 		predMap[gid] = make([]string, 0)
-		for pred := range group.Tablets {
+		for pred, tablet := range group.Tablets {
+			if len(tablet.GetShards()) > 0 {
+				return errors.Errorf("Backup of predicate %s split across groups %v isn't "+
+					"supported", x.ParseAttr(pred), tablet.GetShards())
+			}
 			predMap[gid] = append(predMap[gid], pred)
 		}
 
@@ -326,11 +360,12 @@ func ProcessBackupRequest(ctx context.Context, req *pb.BackupRequest) error {
 		br.Predicates = predMap[gid]
 		go func(req *pb.BackupRequest) {
 			res, err := BackupGroup(ctx, req)
-			resCh <- BackupRes{res: res, err: err}
+			resCh <- BackupRes{gid: req.GroupId, res: res, err: err}
 		}(br)
 	}
 
 	var dropOperations []*pb.DropOperation
+	checksums := make(map[uint32]string)
 	for range groups {
 		backupRes := <-resCh
 		if backupRes.err != nil {
@@ -338,6 +373,9 @@ func ProcessBackupRequest(ctx context.Context, req *pb.BackupRequest) error {
 			return backupRes.err
 		}
 		dropOperations = append(dropOperations, backupRes.res.GetDropOperations()...)
+		if sum := backupRes.res.GetChecksum(); sum != "" {
+			checksums[backupRes.gid] = sum
+		}
 	}
 
 	dir := fmt.Sprintf(backupPathFmt, req.UnixTs)
@@ -348,6 +386,7 @@ func ProcessBackupRequest(ctx context.Context, req *pb.BackupRequest) error {
 		DropOperations: dropOperations,
 		Path:           dir,
 		Compression:    "snappy",
+		Checksums:      checksums,
 	}
 	if req.SinceTs == 0 {
 		m.Type = "full"
@@ -359,6 +398,9 @@ func ProcessBackupRequest(ctx context.Context, req *pb.BackupRequest) error {
 		m.BackupNum = latestManifest.BackupNum + 1
 	}
 	m.Encrypted = x.WorkerConfig.EncryptionKey != nil
+	if m.Encrypted {
+		m.KeyFingerprint = x.KeyFingerprint(x.WorkerConfig.EncryptionKey)
+	}
 
 	bp := NewBackupProcessor(nil, req)
 	defer bp.Close()
@@ -476,9 +518,12 @@ func (pr *BackupProcessor) WriteBackup(ctx context.Context) (*pb.BackupResponse,
 	if err != nil {
 		return nil, err
 	}
+	w = newThrottledWriter(w, pr.Request.WriteBytesPerSec)
 	glog.V(3).Infof("Backup manifest version: %d", pr.Request.SinceTs)
 
-	eWriter, err := enc.GetWriter(x.WorkerConfig.EncryptionKey, w)
+	// The checksum of the file is recorded in the manifest, to verify the backup later.
+	checksum := sha256.New()
+	eWriter, err := enc.GetWriter(x.WorkerConfig.EncryptionKey, io.MultiWriter(w, checksum))
 	if err != nil {
 		return nil, err
 	}
@@ -554,7 +599,9 @@ func (pr *BackupProcessor) WriteBackup(ctx context.Context) (*pb.BackupResponse,
 	}
 
 	var maxVersion uint64
+	read := newThrottle(pr.Request.ReadBytesPerSec)
 	stream.Send = func(buf *z.Buffer) error {
+		read.take(buf.LenNoPadding())
 		list, err := badger.BufferToKVList(buf)
 		if err != nil {
 			return err
@@ -649,6 +696,7 @@ func (pr *BackupProcessor) WriteBackup(ctx context.Context) (*pb.BackupResponse,
 		glog.Errorf("While closing handler: %v", err)
 		return &response, err
 	}
+	response.Checksum = hex.EncodeToString(checksum.Sum(nil))
 	glog.Infof("Backup complete: group %d at %d", pr.Request.GroupId, pr.Request.ReadTs)
 	return &response, nil
 }
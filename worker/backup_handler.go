@@ -13,6 +13,7 @@ import (
 	"net/url"
 	"os"
 	"path/filepath"
+	"strings"
 	"time"
 
 	"github.com/golang/glog"
@@ -29,6 +30,9 @@ const (
 	// The expected parameter is a date in string format.
 	backupPathFmt = `dgraph.%s`
 
+	// backupTimeFmt defines the format of the time a backup is taken at, in its path.
+	backupTimeFmt = "20060102.150405.000"
+
 	// backupNameFmt defines the name of backups files or objects (remote).
 	// The first parameter is the read timestamp at the time of backup. This is used for
 	// incremental backups and partial restore.
@@ -95,6 +99,9 @@ type UriHandler interface {
 	// Stream would stream the path via an instance of io.ReadCloser. Close must be called at the
 	// end to release resources appropriately.
 	Stream(path string) (io.ReadCloser, error)
+	// Delete removes the file or the directory at the given relative path, with everything
+	// under it.
+	Delete(path string) error
 }
 
 // NewUriHandler parses the requested URI and finds the corresponding UriHandler.
@@ -197,6 +204,10 @@ func (h *fileHandler) Rename(src, dst string) error {
 	return os.Rename(src, dst)
 }
 
+func (h *fileHandler) Delete(path string) error {
+	return os.RemoveAll(h.JoinPath(path))
+}
+
 // pathExist checks if a path (file or dir) is found at target.
 // Returns true if found, false otherwise.
 func pathExist(path string) bool {
@@ -397,6 +408,25 @@ func (h *s3Handler) Rename(srcPath, dstPath string) error {
 	return errors.Wrap(err, "Rename failed to remove temporary file")
 }
 
+func (h *s3Handler) Delete(path string) error {
+	objectPath := h.getObjectPath(path)
+	for object := range h.mc.ListObjects(context.Background(), h.bucketName,
+		minio.ListObjectsOptions{Prefix: objectPath, Recursive: true}) {
+		if object.Err != nil {
+			return errors.Wrap(object.Err, "While listing objects to delete")
+		}
+		// The prefix also matches the objects whose name only starts with the path.
+		if object.Key != objectPath && !strings.HasPrefix(object.Key, objectPath+"/") {
+			continue
+		}
+		if err := h.mc.RemoveObject(context.Background(), h.bucketName, object.Key,
+			minio.RemoveObjectOptions{}); err != nil {
+			return errors.Wrapf(err, "While deleting object %s", object.Key)
+		}
+	}
+	return nil
+}
+
 func (h *s3Handler) getObjectPath(path string) string {
 	return filepath.Join(h.objectPrefix, path)
 }
@@ -0,0 +1,116 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package worker
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"os"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+
+	"github.com/dgraph-io/ristretto/v2/z"
+)
+
+// locationLockPath is the lock of a backup location. It's held by a backup, and by the pruning
+// of the backups, while they rewrite the manifest, so that they don't undo each other's changes
+// whichever alpha, or the offline tool, they run on. The backupLock only covers this alpha.
+const locationLockPath = "dgraph.lock"
+
+const (
+	// locationLockRefresh is how often the holder of the lock of a location writes it again.
+	locationLockRefresh = time.Minute
+	// locationLockExpiry is how long a lock which isn't written again is held. It was left
+	// behind by a holder which crashed, and can be taken over after it.
+	locationLockExpiry = 10 * time.Minute
+)
+
+type locationLock struct {
+	Owner string    `json:"owner"`
+	Time  time.Time `json:"time"`
+}
+
+func readLocationLock(h UriHandler) (*locationLock, error) {
+	b, err := h.Read(locationLockPath)
+	if err != nil {
+		return nil, errors.Wrap(err, "while reading the lock of the backup location")
+	}
+	var l locationLock
+	if err := json.Unmarshal(b, &l); err != nil {
+		return nil, errors.Wrap(err, "while reading the lock of the backup location")
+	}
+	return &l, nil
+}
+
+func writeLocationLock(h UriHandler, owner string) error {
+	w, err := h.CreateFile(locationLockPath)
+	if err != nil {
+		return err
+	}
+	if err := json.NewEncoder(w).Encode(&locationLock{Owner: owner, Time: time.Now()}); err != nil {
+		return err
+	}
+	return w.Close()
+}
+
+// lockLocation takes the lock of the backup location, or returns an error if it's held. The
+// lock is held until the returned function is called.
+func lockLocation(h UriHandler) (func(), error) {
+	if h.FileExists(locationLockPath) {
+		l, err := readLocationLock(h)
+		if err != nil {
+			return nil, err
+		}
+		if time.Since(l.Time) < locationLockExpiry {
+			return nil, errors.Errorf("The backup location is locked by %s. A backup or the "+
+				"pruning of the backups is in progress, try again later", l.Owner)
+		}
+		glog.Warningf("Taking over the lock of the backup location left by %s", l.Owner)
+	}
+
+	host, _ := os.Hostname()
+	//nolint:gosec // the owner only needs to be unique
+	owner := fmt.Sprintf("%s/%x", host, rand.Uint64())
+	if err := writeLocationLock(h, owner); err != nil {
+		return nil, errors.Wrap(err, "while writing the lock of the backup location")
+	}
+	// An object store can't create a file only if it doesn't exist. Of the holders writing the
+	// lock at once, only the last one reads it back.
+	l, err := readLocationLock(h)
+	if err != nil {
+		return nil, err
+	}
+	if l.Owner != owner {
+		return nil, errors.Errorf("The backup location is locked by %s. A backup or the "+
+			"pruning of the backups is in progress, try again later", l.Owner)
+	}
+
+	closer := z.NewCloser(1)
+	go func() {
+		defer closer.Done()
+		tick := time.NewTicker(locationLockRefresh)
+		defer tick.Stop()
+		for {
+			select {
+			case <-closer.HasBeenClosed():
+				return
+			case <-tick.C:
+				if err := writeLocationLock(h, owner); err != nil {
+					glog.Warningf("While writing the lock of the backup location: %v", err)
+				}
+			}
+		}
+	}()
+	return func() {
+		closer.SignalAndWait()
+		if err := h.Delete(locationLockPath); err != nil {
+			glog.Warningf("While removing the lock of the backup location: %v", err)
+		}
+	}, nil
+}
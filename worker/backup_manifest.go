@@ -94,7 +94,9 @@ func getManifestsToRestore(h UriHandler, uri *url.URL, req *pb.RestoreRequest) (
 				break
 			}
 		}
-		if !missingFiles {
+		// A restore until a timestamp starts from the backups taken before it.
+		tooLate := req.UntilTs > 0 && m.ValidReadTs() > req.UntilTs
+		if !missingFiles && !tooLate {
 			validManifests = append(validManifests, m)
 		}
 	}
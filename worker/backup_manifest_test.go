@@ -0,0 +1,33 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package worker
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hypermodeinc/dgraph/v25/x"
+)
+
+func TestManifestEncryptionKey(t *testing.T) {
+	cur := x.Sensitive("0123456789abcdef")
+	old := x.Sensitive("fedcba9876543210")
+
+	// Backups taken before the keys were recorded use the key of the request.
+	m := &Manifest{Encrypted: true}
+	key, err := m.encryptionKey(cur, old)
+	require.NoError(t, err)
+	require.Equal(t, cur, key)
+
+	m.KeyFingerprint = x.KeyFingerprint(old)
+	key, err = m.encryptionKey(nil, cur, old)
+	require.NoError(t, err)
+	require.Equal(t, old, key)
+
+	_, err = m.encryptionKey(cur)
+	require.Error(t, err)
+}
@@ -0,0 +1,331 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package worker
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/url"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+
+	"github.com/hypermodeinc/dgraph/v25/x"
+)
+
+// RetentionPolicy decides which backup series to keep at a location. A series is the full
+// backup and the incremental backups taken on top of it, so it's kept or deleted as a whole.
+// A series is kept if any of the rules keeps it.
+type RetentionPolicy struct {
+	// KeepSeries is the number of latest series to keep. The latest series is always kept.
+	KeepSeries int
+	// KeepDays keeps the series with the latest backup of each of the last KeepDays days.
+	KeepDays int
+	// KeepWeeks keeps the series with the latest backup of each of the last KeepWeeks weeks.
+	KeepWeeks int
+}
+
+// BackupSeries is a backup series, along with whether the retention policy keeps it.
+type BackupSeries struct {
+	BackupId string
+	// Manifests are the manifests of the backups of the series, by backup num.
+	Manifests []*Manifest
+	// Keep is whether the series is kept, and Reason is the rule which keeps it.
+	Keep   bool
+	Reason string
+}
+
+// Time returns the time the backup was taken at, from the name of its directory.
+func (m *Manifest) Time() (time.Time, error) {
+	prefix := fmt.Sprintf(backupPathFmt, "")
+	ts, ok := strings.CutPrefix(filepath.Base(m.Path), prefix)
+	if !ok {
+		return time.Time{}, errors.Errorf("backup path %q doesn't start with %q", m.Path, prefix)
+	}
+	return time.Parse(backupTimeFmt, ts)
+}
+
+// backupSeries groups the manifests by series, from the oldest series to the latest.
+func backupSeries(manifests []*Manifest) []*BackupSeries {
+	var series []*BackupSeries
+	byId := make(map[string]*BackupSeries)
+	for _, m := range manifests {
+		s, ok := byId[m.BackupId]
+		if !ok {
+			s = &BackupSeries{BackupId: m.BackupId}
+			byId[m.BackupId] = s
+			series = append(series, s)
+		}
+		s.Manifests = append(s.Manifests, m)
+	}
+	for _, s := range series {
+		sort.SliceStable(s.Manifests, func(i, j int) bool {
+			return s.Manifests[i].BackupNum < s.Manifests[j].BackupNum
+		})
+	}
+	return series
+}
+
+// apply marks the series kept by the policy as of now. The series are ordered from the oldest to
+// the latest.
+func (p RetentionPolicy) apply(series []*BackupSeries, now time.Time) {
+	keep := func(s *BackupSeries, reason string) {
+		if !s.Keep {
+			s.Keep, s.Reason = true, reason
+		}
+	}
+
+	for i := len(series) - 1; i >= 0 && len(series)-i <= max(p.KeepSeries, 1); i-- {
+		keep(series[i], "latest")
+	}
+
+	// The periods are counted back from the start of the current one, in UTC.
+	day := func(t time.Time) time.Time {
+		return t.UTC().Truncate(24 * time.Hour)
+	}
+	week := func(t time.Time) time.Time {
+		d := day(t)
+		return d.AddDate(0, 0, -(int(d.Weekday())+6)%7)
+	}
+	rules := []struct {
+		reason string
+		keep   int
+		period func(time.Time) time.Time
+		length time.Duration
+	}{
+		{"daily", p.KeepDays, day, 24 * time.Hour},
+		{"weekly", p.KeepWeeks, week, 7 * 24 * time.Hour},
+	}
+	for _, rule := range rules {
+		if rule.keep <= 0 {
+			continue
+		}
+		oldest := rule.period(now).Add(-time.Duration(rule.keep-1) * rule.length)
+		// latest is the series with the latest backup of each period.
+		latest := make(map[time.Time]*BackupSeries)
+		taken := make(map[time.Time]time.Time)
+		for _, s := range series {
+			for _, m := range s.Manifests {
+				t, err := m.Time()
+				if err != nil {
+					continue
+				}
+				period := rule.period(t)
+				if period.Before(oldest) || !t.After(taken[period]) {
+					continue
+				}
+				latest[period], taken[period] = s, t
+			}
+		}
+		for _, s := range latest {
+			keep(s, rule.reason)
+		}
+	}
+
+	// Never delete a series which can't be dated.
+	for _, s := range series {
+		for _, m := range s.Manifests {
+			if _, err := m.Time(); err != nil {
+				keep(s, "undated")
+			}
+		}
+	}
+}
+
+// PruneBackups applies the retention policy to the backups at the location, and deletes the
+// series it doesn't keep along with the archive segments which are only needed to restore them.
+// With dryRun set, it only returns what would be deleted. The policy needs a rule to delete
+// anything.
+func PruneBackups(location string, creds *x.MinioCredentials, policy RetentionPolicy,
+	dryRun bool) ([]*BackupSeries, error) {
+	if policy == (RetentionPolicy{}) && !dryRun {
+		return nil, errors.Errorf("The retention policy has no rule. Set the number of " +
+			"series, days or weeks to keep")
+	}
+	uri, err := url.Parse(location)
+	if err != nil {
+		return nil, err
+	}
+	h, err := NewUriHandler(uri, creds)
+	if err != nil {
+		return nil, err
+	}
+
+	// Don't race with a backup, which also rewrites the manifest.
+	backupLock.Lock()
+	defer backupLock.Unlock()
+	if !dryRun {
+		unlock, err := lockLocation(h)
+		if err != nil {
+			return nil, err
+		}
+		defer unlock()
+	}
+
+	// The manifest is rewritten, so it must not be upgraded.
+	master, err := GetManifestNoUpgrade(h, uri)
+	if err != nil {
+		return nil, err
+	}
+	series := backupSeries(master.Manifests)
+	policy.apply(series, time.Now())
+	if dryRun {
+		return series, nil
+	}
+
+	var kept []*Manifest
+	deleted := make(map[string]struct{})
+	var oldestTs uint64
+	for _, s := range series {
+		for _, m := range s.Manifests {
+			if !s.Keep {
+				deleted[m.Path] = struct{}{}
+				continue
+			}
+			if oldestTs == 0 || m.ValidReadTs() < oldestTs {
+				oldestTs = m.ValidReadTs()
+			}
+		}
+	}
+	if len(deleted) == 0 {
+		return series, nil
+	}
+	for _, m := range master.Manifests {
+		if _, ok := deleted[m.Path]; !ok {
+			kept = append(kept, m)
+		}
+	}
+
+	// Remove the entries of the deleted backups first, so that the manifest never refers to the
+	// files of a deleted backup.
+	if err := CreateManifest(h, uri, &MasterManifest{Manifests: kept}); err != nil {
+		return nil, errors.Wrap(err, "while removing the manifests of the expired backups")
+	}
+	for path := range deleted {
+		if err := h.Delete(path); err != nil {
+			return nil, errors.Wrapf(err, "while deleting backup %s", path)
+		}
+		glog.Infof("Deleted expired backup %s", path)
+	}
+	if err := pruneArchive(h, oldestTs); err != nil {
+		return nil, err
+	}
+	return series, nil
+}
+
+// pruneArchive deletes the archive segments which are only needed to restore until a timestamp
+// before the oldest backup. The latest segment of each group is kept, so that the archive goes on
+// from it.
+func pruneArchive(h UriHandler, oldestTs uint64) error {
+	for gid := range archiveGroups(h) {
+		segments, err := readArchiveSegments(h, gid)
+		if err != nil {
+			return err
+		}
+		for i, seg := range segments {
+			if i == len(segments)-1 || seg.ReadTs > oldestTs {
+				break
+			}
+			// Delete the details first, so that a segment is never listed without its data.
+			if err := h.Delete(seg.metaPath()); err != nil {
+				return errors.Wrapf(err, "while deleting archive segment %s", seg.metaPath())
+			}
+			if err := h.Delete(seg.dataPath()); err != nil {
+				return errors.Wrapf(err, "while deleting archive segment %s", seg.dataPath())
+			}
+		}
+	}
+	return nil
+}
+
+const (
+	// BackupFileOk is the status of a backup file which matches its checksum.
+	BackupFileOk = "ok"
+	// BackupFileUnverified is the status of a backup file of a backup taken before the checksums
+	// were recorded.
+	BackupFileUnverified = "unverified"
+	// BackupFileMissing is the status of a backup file which doesn't exist.
+	BackupFileMissing = "missing"
+	// BackupFileCorrupt is the status of a backup file which doesn't match its checksum.
+	BackupFileCorrupt = "corrupt"
+)
+
+// BackupVerification is the result of the verification of the files of a backup.
+type BackupVerification struct {
+	Manifest *Manifest
+	// Files are the statuses of the backup files, by path.
+	Files map[string]string
+	// Restorable is false if a file of the backup, or of an earlier backup of its series, is
+	// missing or corrupt.
+	Restorable bool
+}
+
+// VerifyBackups checks every file referred to by the manifests at the location against the
+// checksum recorded for it.
+func VerifyBackups(location string, creds *x.MinioCredentials) ([]*BackupVerification, error) {
+	uri, err := url.Parse(location)
+	if err != nil {
+		return nil, err
+	}
+	h, err := NewUriHandler(uri, creds)
+	if err != nil {
+		return nil, err
+	}
+	master, err := GetManifestNoUpgrade(h, uri)
+	if err != nil {
+		return nil, err
+	}
+
+	var res []*BackupVerification
+	for _, s := range backupSeries(master.Manifests) {
+		restorable := true
+		for _, m := range s.Manifests {
+			v := &BackupVerification{Manifest: m, Files: make(map[string]string)}
+			for gid := range m.Groups {
+				path := filepath.Join(m.Path, backupName(m.ValidReadTs(), gid))
+				status, err := verifyBackupFile(h, path, m.Checksums[gid])
+				if err != nil {
+					return nil, err
+				}
+				v.Files[path] = status
+				if status == BackupFileMissing || status == BackupFileCorrupt {
+					restorable = false
+				}
+			}
+			v.Restorable = restorable
+			res = append(res, v)
+		}
+	}
+	return res, nil
+}
+
+func verifyBackupFile(h UriHandler, path, checksum string) (string, error) {
+	if !h.FileExists(path) {
+		return BackupFileMissing, nil
+	}
+	if checksum == "" {
+		return BackupFileUnverified, nil
+	}
+	r, err := h.Stream(path)
+	if err != nil {
+		return "", errors.Wrapf(err, "while reading backup file %s", path)
+	}
+	defer r.Close()
+	sum := sha256.New()
+	if _, err := io.Copy(sum, r); err != nil {
+		return "", errors.Wrapf(err, "while reading backup file %s", path)
+	}
+	if hex.EncodeToString(sum.Sum(nil)) != checksum {
+		return BackupFileCorrupt, nil
+	}
+	return BackupFileOk, nil
+}
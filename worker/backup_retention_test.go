@@ -0,0 +1,141 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package worker
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func testManifest(id string, num uint64, t time.Time) *Manifest {
+	return &Manifest{
+		BackupId:  id,
+		BackupNum: num,
+		ReadTs:    uint64(t.Unix()),
+		Path:      fmt.Sprintf(backupPathFmt, t.Format(backupTimeFmt)),
+		Groups:    map[uint32][]string{1: {"0-name"}},
+	}
+}
+
+func keptSeries(series []*BackupSeries) map[string]string {
+	kept := make(map[string]string)
+	for _, s := range series {
+		if s.Keep {
+			kept[s.BackupId] = s.Reason
+		}
+	}
+	return kept
+}
+
+func TestRetentionPolicy(t *testing.T) {
+	// Wednesday.
+	now := time.Date(2024, 5, 15, 12, 0, 0, 0, time.UTC)
+	day := func(d int, hour int) time.Time {
+		return time.Date(2024, 5, 15+d, hour, 0, 0, 0, time.UTC)
+	}
+	manifests := []*Manifest{
+		{BackupId: "f", BackupNum: 1, Path: "old"},
+		testManifest("a", 1, day(-30, 1)),
+		testManifest("a", 2, day(-16, 1)),
+		testManifest("b", 1, day(-9, 1)),
+		testManifest("b", 2, day(-8, 1)),
+		testManifest("c", 1, day(-2, 1)),
+		testManifest("d", 1, day(-1, 1)),
+		testManifest("d", 2, day(-1, 2)),
+		testManifest("e", 1, day(0, 1)),
+	}
+
+	apply := func(p RetentionPolicy) map[string]string {
+		series := backupSeries(manifests)
+		p.apply(series, now)
+		return keptSeries(series)
+	}
+	// The latest series, and the series which can't be dated, are always kept.
+	require.Equal(t, map[string]string{"e": "latest", "f": "undated"},
+		apply(RetentionPolicy{}))
+	require.Equal(t, map[string]string{"e": "latest", "d": "latest", "f": "undated"},
+		apply(RetentionPolicy{KeepSeries: 2}))
+	// The latest backup of a day decides the series kept for it.
+	require.Equal(t, map[string]string{"e": "latest", "d": "daily", "c": "daily",
+		"f": "undated"}, apply(RetentionPolicy{KeepDays: 3}))
+	// The weeks start on Monday. Series b has the latest backup of the week of May 6, and a of
+	// the week of April 29.
+	require.Equal(t, map[string]string{"e": "latest", "b": "weekly", "f": "undated"},
+		apply(RetentionPolicy{KeepWeeks: 2}))
+	require.Equal(t, map[string]string{"e": "latest", "b": "weekly", "a": "weekly",
+		"f": "undated"}, apply(RetentionPolicy{KeepWeeks: 3}))
+}
+
+func TestPruneAndVerifyBackups(t *testing.T) {
+	dir := t.TempDir()
+	uri, err := url.Parse("file://" + dir)
+	require.NoError(t, err)
+	h, err := NewUriHandler(uri, nil)
+	require.NoError(t, err)
+
+	now := time.Now()
+	var manifests []*Manifest
+	for i, id := range []string{"a", "a", "b"} {
+		m := testManifest(id, uint64(i%2+1), now.Add(time.Duration(i-3)*time.Hour))
+		file := filepath.Join(m.Path, backupName(m.ValidReadTs(), 1))
+		require.NoError(t, h.CreateDir(m.Path))
+		data := []byte(fmt.Sprintf("backup %d", i))
+		require.NoError(t, os.WriteFile(h.JoinPath(file), data, 0644))
+		sum := sha256.Sum256(data)
+		m.Checksums = map[uint32]string{1: hex.EncodeToString(sum[:])}
+		manifests = append(manifests, m)
+	}
+	require.NoError(t, CreateManifest(h, uri, &MasterManifest{Manifests: manifests}))
+
+	// Corrupt the incremental backup of series a.
+	corrupt := filepath.Join(manifests[1].Path, backupName(manifests[1].ValidReadTs(), 1))
+	require.NoError(t, os.WriteFile(h.JoinPath(corrupt), []byte("corrupt"), 0644))
+	verifications, err := VerifyBackups(uri.String(), nil)
+	require.NoError(t, err)
+	require.Len(t, verifications, 3)
+	require.True(t, verifications[0].Restorable)
+	require.Equal(t, BackupFileCorrupt, verifications[1].Files[corrupt])
+	require.False(t, verifications[1].Restorable)
+	require.True(t, verifications[2].Restorable)
+
+	// A dry run doesn't delete anything.
+	series, err := PruneBackups(uri.String(), nil, RetentionPolicy{}, true)
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"b": "latest"}, keptSeries(series))
+	require.True(t, h.DirExists(manifests[0].Path))
+
+	// Nothing is deleted without a rule.
+	_, err = PruneBackups(uri.String(), nil, RetentionPolicy{}, false)
+	require.ErrorContains(t, err, "has no rule")
+	require.True(t, h.DirExists(manifests[0].Path))
+
+	// Nor while the location is locked.
+	unlock, err := lockLocation(h)
+	require.NoError(t, err)
+	_, err = PruneBackups(uri.String(), nil, RetentionPolicy{KeepSeries: 1}, false)
+	require.ErrorContains(t, err, "is locked by")
+	unlock()
+	require.False(t, h.FileExists(locationLockPath))
+
+	_, err = PruneBackups(uri.String(), nil, RetentionPolicy{KeepSeries: 1}, false)
+	require.NoError(t, err)
+	require.False(t, h.FileExists(locationLockPath))
+	require.False(t, h.DirExists(manifests[0].Path))
+	require.False(t, h.DirExists(manifests[1].Path))
+	require.True(t, h.DirExists(manifests[2].Path))
+	master, err := GetManifestNoUpgrade(h, uri)
+	require.NoError(t, err)
+	require.Len(t, master.Manifests, 1)
+	require.Equal(t, "b", master.Manifests[0].BackupId)
+}
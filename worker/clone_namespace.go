@@ -0,0 +1,282 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package worker
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/dgraph-io/badger/v4"
+	bpb "github.com/dgraph-io/badger/v4/pb"
+	"github.com/dgraph-io/dgo/v250/protos/api"
+	"github.com/dgraph-io/ristretto/v2/z"
+	"github.com/hypermodeinc/dgraph/v25/conn"
+	"github.com/hypermodeinc/dgraph/v25/posting"
+	"github.com/hypermodeinc/dgraph/v25/protos/pb"
+	"github.com/hypermodeinc/dgraph/v25/x"
+)
+
+// CloneNamespaceOverNetwork copies the predicates of namespace src, as of readTs, into namespace
+// dst. Every predicate is copied by the leader of the group serving it, and the copy is served
+// by the same group. The copies are written at txnTs, which must be greater than readTs. The
+// types of the namespace aren't copied, see ReadNamespaceTypes.
+func CloneNamespaceOverNetwork(ctx context.Context, src, dst, readTs, txnTs uint64) error {
+	// Update the membership state to get the latest mapping of groups to predicates.
+	if err := UpdateMembershipState(ctx); err != nil {
+		return errors.Wrapf(err, "Failed to update membership state while cloning namespace")
+	}
+
+	// Every predicate is checked before any of them is cloned.
+	type cloneReq struct {
+		gid uint32
+		req *pb.ClonePredicatePayload
+	}
+	var reqs []cloneReq
+	for gid, group := range GetMembershipState().GetGroups() {
+		for pred, tablet := range group.GetTablets() {
+			if x.ParseNamespace(pred) != src {
+				continue
+			}
+			if len(tablet.GetShards()) > 0 {
+				return errors.Errorf("Clone of predicate %s split across groups %v isn't "+
+					"supported", x.ParseAttr(pred), tablet.GetShards())
+			}
+			reqs = append(reqs, cloneReq{gid: gid, req: &pb.ClonePredicatePayload{
+				Predicate:    pred,
+				DstNamespace: dst,
+				ReadTs:       readTs,
+				TxnTs:        txnTs,
+			}})
+		}
+	}
+
+	g, gCtx := errgroup.WithContext(ctx)
+	g.SetLimit(8)
+	for _, r := range reqs {
+		g.Go(func() error {
+			return clonePredicateOrSend(gCtx, r.gid, r.req)
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return errors.Wrapf(err, "Failed to clone namespace %#x into %#x", src, dst)
+	}
+	return nil
+}
+
+func clonePredicateOrSend(ctx context.Context, gid uint32, req *pb.ClonePredicatePayload) error {
+	glog.V(2).Infof("Sending clone predicate request: %+v", req)
+	if groups().ServesGroup(gid) && groups().Node.AmLeader() {
+		_, err := (&grpcWorker{}).ClonePredicate(ctx, req)
+		return err
+	}
+
+	pl := groups().Leader(gid)
+	if pl == nil {
+		return conn.ErrNoConnection
+	}
+	c := pb.NewWorkerClient(pl.Get())
+	_, err := c.ClonePredicate(ctx, req)
+	return err
+}
+
+// ClonePredicate copies a predicate served by this group into another namespace.
+func (w *grpcWorker) ClonePredicate(ctx context.Context,
+	in *pb.ClonePredicatePayload) (*api.Payload, error) {
+	if !groups().Node.AmLeader() {
+		return &emptyPayload, errNotLeader
+	}
+	if len(in.Predicate) == 0 {
+		return &emptyPayload, errEmptyPredicate
+	}
+	if in.TxnTs <= in.ReadTs {
+		return &emptyPayload, errors.Errorf("Clone of predicate %s must be written after %d, "+
+			"got %d", in.Predicate, in.ReadTs, in.TxnTs)
+	}
+	if err := posting.Oracle().WaitForTs(ctx, in.ReadTs); err != nil {
+		return &emptyPayload, errors.Errorf("While waiting for read ts: %d. Error: %v",
+			in.ReadTs, err)
+	}
+	if err := groups().Node.history.checkClone(in.ReadTs, groups().groupId()); err != nil {
+		return &emptyPayload, err
+	}
+
+	gid, err := groups().BelongsTo(in.Predicate)
+	switch {
+	case err != nil:
+		return &emptyPayload, err
+	case gid == 0:
+		return &emptyPayload, errNonExistentTablet
+	case gid != groups().groupId():
+		return &emptyPayload, errUnservedTablet
+	}
+
+	glog.Infof("Clone predicate request: %+v", in)
+	return &emptyPayload, clonePredicate(ctx, in)
+}
+
+// cloneKey returns the key with its predicate replaced by attr.
+func cloneKey(key []byte, attr string) ([]byte, error) {
+	pk, err := x.Parse(key)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while parsing key %x", key)
+	}
+	pk.Attr = attr
+	return x.FromBackupKey(pk.ToBackupKey()), nil
+}
+
+func clonePredicate(ctx context.Context, in *pb.ClonePredicatePayload) error {
+	dstAttr := x.NamespaceAttr(in.DstNamespace, x.ParseAttr(in.Predicate))
+	tablet, err := groups().Tablet(dstAttr)
+	if err != nil {
+		return errors.Wrapf(err, "while assigning tablet %s", dstAttr)
+	}
+	if tablet.GetGroupId() != groups().groupId() {
+		return errors.Errorf("Predicate %s is already served by group %d", dstAttr,
+			tablet.GetGroupId())
+	}
+
+	txn := pstore.NewTransactionAt(in.ReadTs, false)
+	defer txn.Discard()
+	item, err := txn.Get(x.SchemaKey(in.Predicate))
+	switch {
+	case err == badger.ErrKeyNotFound:
+		// The predicate was dropped, there is nothing to clone.
+		return nil
+	case err != nil:
+		return err
+	}
+	var update pb.SchemaUpdate
+	if err := item.Value(func(val []byte) error {
+		return proto.Unmarshal(val, &update)
+	}); err != nil {
+		return errors.Wrapf(err, "while reading schema of predicate %s", in.Predicate)
+	}
+	update.Predicate = dstAttr
+	val, err := proto.Marshal(&update)
+	if err != nil {
+		return err
+	}
+
+	// The copy is proposed to this group like a predicate received in a move, schema first.
+	kvs := make(chan *pb.KVS, 3)
+	che := make(chan error, 1)
+	go func() {
+		che <- batchAndProposeKeyValues(ctx, kvs)
+	}()
+	send := func(buf *z.Buffer) error {
+		// The buffer is released once the stream is done with it, so send a copy.
+		select {
+		case kvs <- &pb.KVS{Data: append([]byte{}, buf.Bytes()...)}:
+			return nil
+		case err := <-che:
+			che <- err
+			if err == nil {
+				err = errors.Errorf("proposals of clone of predicate %s stopped", in.Predicate)
+			}
+			return err
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+
+	err = func() error {
+		buf := z.NewBuffer(1024, "ClonePredicate")
+		defer func() {
+			if err := buf.Release(); err != nil {
+				glog.Warningf("error in releasing buffer: %v", err)
+			}
+		}()
+		badger.KVToBuffer(&bpb.KV{
+			Key:      x.SchemaKey(dstAttr),
+			Value:    val,
+			Version:  in.TxnTs,
+			UserMeta: []byte{item.UserMeta()},
+		}, buf)
+		return send(buf)
+	}()
+	if err == nil {
+		stream := pstore.NewStreamAt(in.ReadTs)
+		stream.LogPrefix = fmt.Sprintf("Cloning predicate: [%s]", in.Predicate)
+		stream.Prefix = x.PredicatePrefix(in.Predicate)
+		stream.KeyToList = func(key []byte, itr *badger.Iterator) (*bpb.KVList, error) {
+			// Like a predicate move, write out full posting lists at a single timestamp.
+			l, err := posting.ReadPostingList(key, itr)
+			if err != nil {
+				return nil, err
+			}
+			kvs, err := l.Rollup(itr.Alloc, math.MaxUint64)
+			if err != nil {
+				return nil, err
+			}
+			for _, kv := range kvs {
+				if kv.Key, err = cloneKey(kv.Key, dstAttr); err != nil {
+					return nil, err
+				}
+				kv.Version = in.TxnTs
+			}
+			return &bpb.KVList{Kv: kvs}, nil
+		}
+		stream.Send = send
+		err = stream.Orchestrate(ctx)
+	}
+	close(kvs)
+	if perr := <-che; err == nil {
+		err = perr
+	}
+	if err != nil {
+		return errors.Wrapf(err, "while cloning predicate %s", in.Predicate)
+	}
+	glog.Infof("Cloned predicate %s into %s", in.Predicate, dstAttr)
+	return nil
+}
+
+// ReadNamespaceTypes returns the types of the namespace src as of readTs, renamed into the
+// namespace dst.
+func ReadNamespaceTypes(ctx context.Context, src, dst, readTs uint64) ([]*pb.TypeUpdate, error) {
+	if err := posting.Oracle().WaitForTs(ctx, readTs); err != nil {
+		return nil, errors.Errorf("While waiting for read ts: %d. Error: %v", readTs, err)
+	}
+	if err := groups().Node.history.checkClone(readTs, groups().groupId()); err != nil {
+		return nil, err
+	}
+
+	txn := pstore.NewTransactionAt(readTs, false)
+	defer txn.Discard()
+	iopts := badger.DefaultIteratorOptions
+	iopts.Prefix = append([]byte{x.ByteType}, x.NamespaceToBytes(src)...)
+	itr := txn.NewIterator(iopts)
+	defer itr.Close()
+
+	var types []*pb.TypeUpdate
+	for itr.Rewind(); itr.Valid(); itr.Next() {
+		item := itr.Item()
+		if item.IsDeletedOrExpired() {
+			continue
+		}
+		pk, err := x.Parse(item.Key())
+		if err != nil {
+			return nil, errors.Wrapf(err, "while parsing key %x", item.Key())
+		}
+		update := &pb.TypeUpdate{TypeName: pk.Attr}
+		if err := item.Value(func(val []byte) error {
+			return proto.Unmarshal(val, update)
+		}); err != nil {
+			return nil, errors.Wrapf(err, "while reading type %s", pk.Attr)
+		}
+		update.TypeName = x.NamespaceAttr(dst, x.ParseAttr(update.TypeName))
+		for _, field := range update.Fields {
+			field.Predicate = x.NamespaceAttr(dst, x.ParseAttr(field.Predicate))
+		}
+		types = append(types, update)
+	}
+	return types, nil
+}
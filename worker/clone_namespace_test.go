@@ -0,0 +1,37 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package worker
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hypermodeinc/dgraph/v25/x"
+)
+
+func TestCloneKey(t *testing.T) {
+	src := x.NamespaceAttr(2, "name")
+	dst := x.NamespaceAttr(5, "name")
+	for _, key := range []struct {
+		name     string
+		src, dst []byte
+	}{
+		{"data", x.DataKey(src, 7), x.DataKey(dst, 7)},
+		{"reverse", x.ReverseKey(src, 7), x.ReverseKey(dst, 7)},
+		{"index", x.IndexKey(src, "\x01alice"), x.IndexKey(dst, "\x01alice")},
+		{"count", x.CountKey(src, 3, true), x.CountKey(dst, 3, true)},
+	} {
+		t.Run(key.name, func(t *testing.T) {
+			got, err := cloneKey(key.src, dst)
+			require.NoError(t, err)
+			require.Equal(t, key.dst, got)
+		})
+	}
+
+	_, err := cloneKey([]byte{0xff}, dst)
+	require.Error(t, err)
+}
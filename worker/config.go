@@ -63,6 +63,9 @@ type Options struct {
 	// Define different ChangeDataCapture configurations
 	ChangeDataConf string
 
+	// ArchiveConf configures the continuous archiving of the committed changes.
+	ArchiveConf string
+
 	// TypeFilterUidLimit decides how many elements would be searched directly
 	// vs searched via type index. If the number of elements are too low, then querying the
 	// index might be slower. This would allow people to set their limit according to
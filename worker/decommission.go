@@ -0,0 +1,257 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package worker
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+
+	"github.com/hypermodeinc/dgraph/v25/conn"
+	"github.com/hypermodeinc/dgraph/v25/protos/pb"
+	"github.com/hypermodeinc/dgraph/v25/x"
+)
+
+const leadershipTransferTimeout = 30 * time.Second
+
+// decommissionMu makes the decommissions of this alpha run one at a time.
+var decommissionMu sync.Mutex
+
+// tabletMove is the move of a tablet to another group.
+type tabletMove struct {
+	Namespace uint64
+	Tablet    string
+	DstGroup  uint32
+}
+
+// planTabletMoves returns the moves emptying the group, before its last member is removed. Each
+// tablet goes to the other group holding the least data at that point, the largest tablets
+// first.
+func planTabletMoves(state *pb.MembershipState, gid uint32) ([]tabletMove, error) {
+	sizes := make(map[uint32]int64)
+	for id, group := range state.GetGroups() {
+		if id == gid || len(group.GetMembers()) == 0 || group.GetDecommissioning() {
+			continue
+		}
+		sizes[id] = 0
+		for _, tab := range group.GetTablets() {
+			sizes[id] += tab.GetOnDiskBytes()
+		}
+	}
+
+	// A split tablet can't be moved, and the sub-tablets of the group would be lost with it.
+	for _, group := range state.GetGroups() {
+		for _, tab := range group.GetTablets() {
+			if len(tab.GetShards()) > 0 && servedBy(tab, gid) {
+				return nil, errors.Errorf("Group %d serves a sub-tablet of the predicate %s "+
+					"split across groups %v, which can't be moved to another group", gid,
+					x.ParseAttr(tab.GetPredicate()), tab.GetShards())
+			}
+		}
+	}
+
+	var tablets []*pb.Tablet
+	for _, tab := range state.GetGroups()[gid].GetTablets() {
+		if x.IsReservedPredicate(tab.GetPredicate()) {
+			return nil, errors.Errorf("Group %d serves the reserved predicate %s, which can't be "+
+				"moved to another group", gid, x.ParseAttr(tab.GetPredicate()))
+		}
+		tablets = append(tablets, tab)
+	}
+	if len(tablets) == 0 {
+		return nil, nil
+	}
+	if len(sizes) == 0 {
+		return nil, errors.Errorf("There is no other group to move the tablets of group %d to",
+			gid)
+	}
+	sort.Slice(tablets, func(i, j int) bool {
+		if tablets[i].GetOnDiskBytes() != tablets[j].GetOnDiskBytes() {
+			return tablets[i].GetOnDiskBytes() > tablets[j].GetOnDiskBytes()
+		}
+		return tablets[i].GetPredicate() < tablets[j].GetPredicate()
+	})
+
+	moves := make([]tabletMove, 0, len(tablets))
+	for _, tab := range tablets {
+		var dst uint32
+		for id, size := range sizes {
+			if dst == 0 || size < sizes[dst] || (size == sizes[dst] && id < dst) {
+				dst = id
+			}
+		}
+		sizes[dst] += tab.GetOnDiskBytes()
+		ns, attr := x.ParseNamespaceAttr(tab.GetPredicate())
+		moves = append(moves, tabletMove{Namespace: ns, Tablet: attr, DstGroup: dst})
+	}
+	return moves, nil
+}
+
+// moveTablets moves the tablets of the group to the other groups, through Zero.
+func moveTablets(ctx context.Context, gid uint32) error {
+	moves, err := planTabletMoves(GetMembershipState(), gid)
+	if err != nil {
+		return err
+	}
+	for _, m := range moves {
+		glog.Infof("Decommission: moving tablet %s of namespace %d from group %d to %d",
+			m.Tablet, m.Namespace, gid, m.DstGroup)
+		if _, err := MoveTabletOverNetwork(ctx, &pb.MoveTabletRequest{
+			Namespace: m.Namespace,
+			Tablet:    m.Tablet,
+			DstGroup:  m.DstGroup,
+		}); err != nil {
+			return errors.Wrapf(err, "while moving tablet %s of namespace %d to group %d",
+				m.Tablet, m.Namespace, m.DstGroup)
+		}
+	}
+	return nil
+}
+
+// transferLeadership hands the leadership of the group over to the peer whose log is the most
+// up to date, and waits for it to take over. It does nothing if this node isn't the leader.
+func (n *node) transferLeadership(ctx context.Context) error {
+	if !n.AmLeader() {
+		return nil
+	}
+	var peer, match uint64
+	for id, pr := range n.Raft().Status().Progress {
+		if id == n.Id || pr.IsLearner {
+			continue
+		}
+		if peer == 0 || pr.Match > match {
+			peer, match = id, pr.Match
+		}
+	}
+	if peer == 0 {
+		return nil
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, leadershipTransferTimeout)
+	defer cancel()
+	glog.Infof("Decommission: transferring the leadership of group %d from %#x to %#x",
+		n.gid, n.Id, peer)
+	n.Raft().TransferLeadership(ctx, n.Id, peer)
+
+	ticker := time.NewTicker(100 * time.Millisecond)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			if lead := n.Raft().Status().Lead; lead != 0 && lead != n.Id {
+				glog.Infof("Decommission: %#x is the leader of group %d", lead, n.gid)
+				return nil
+			}
+		case <-ctx.Done():
+			return errors.Wrapf(ctx.Err(), "while transferring the leadership of group %d",
+				n.gid)
+		}
+	}
+}
+
+// waitForApplied waits for this node to apply the entries committed so far, so that the
+// proposals in flight are done before it's removed from the group.
+func (n *node) waitForApplied(ctx context.Context) error {
+	commit := n.Raft().Status().Commit
+	glog.Infof("Decommission: waiting for the applied index %d to reach the commit index %d",
+		n.Applied.DoneUntil(), commit)
+	return errors.Wrapf(n.Applied.WaitForMark(ctx, commit),
+		"while waiting for the applied index to reach %d", commit)
+}
+
+// decommission removes this alpha from its group gracefully. If it's the last member of the
+// group, the tablets of the group are moved to the other groups first. Then the alpha stops
+// accepting requests, hands the leadership of the group over, applies the committed entries and
+// asks Zero to remove it. The draining mode is restored if any of the steps fails.
+func decommission(ctx context.Context, req *pb.RemoveNodeRequest) (rerr error) {
+	decommissionMu.Lock()
+	defer decommissionMu.Unlock()
+
+	g := groups()
+	n := g.Node
+	if req.NodeId != n.Id || req.GroupId != g.groupId() {
+		return errors.Errorf("Alpha %s is node %#x of group %d, not node %#x of group %d",
+			x.WorkerConfig.MyAddr, n.Id, g.groupId(), req.NodeId, req.GroupId)
+	}
+
+	// A predicate can't be moved off a draining alpha, so the tablets are moved first. The group
+	// is marked in Zero meanwhile, so that it isn't given new tablets, or tablets moved back by
+	// the placement policies, before it's removed.
+	if _, hasPeer := g.MyPeer(); !hasPeer {
+		mark := &pb.DecommissionGroupRequest{GroupId: req.GroupId, Decommissioning: true}
+		if _, err := DecommissionGroupOverNetwork(ctx, mark); err != nil {
+			return errors.Wrapf(err, "while marking group %d as being decommissioned",
+				req.GroupId)
+		}
+		defer func() {
+			if rerr == nil {
+				return
+			}
+			unmark := &pb.DecommissionGroupRequest{GroupId: req.GroupId}
+			if _, err := DecommissionGroupOverNetwork(context.Background(), unmark); err != nil {
+				glog.Errorf("While unmarking group %d as being decommissioned: %v",
+					req.GroupId, err)
+			}
+		}()
+		if err := moveTablets(ctx, req.GroupId); err != nil {
+			return err
+		}
+	}
+
+	draining := x.DrainingMode()
+	x.UpdateDrainingMode(true)
+	defer func() {
+		if rerr != nil {
+			x.UpdateDrainingMode(draining)
+		}
+	}()
+
+	if err := n.transferLeadership(ctx); err != nil {
+		return err
+	}
+	if err := n.waitForApplied(ctx); err != nil {
+		return err
+	}
+	if _, err := RemoveNodeOverNetwork(ctx, req); err != nil {
+		return errors.Wrapf(err, "while removing node %#x from group %d", req.NodeId,
+			req.GroupId)
+	}
+	glog.Infof("Decommissioned node %#x of group %d", req.NodeId, req.GroupId)
+	return nil
+}
+
+// Decommission removes this alpha from its group gracefully.
+func (w *grpcWorker) Decommission(ctx context.Context,
+	req *pb.RemoveNodeRequest) (*pb.Status, error) {
+	if err := decommission(ctx, req); err != nil {
+		return nil, err
+	}
+	return &pb.Status{Msg: fmt.Sprintf("Decommissioned node %#x of group %d", req.NodeId,
+		req.GroupId)}, nil
+}
+
+// DecommissionOverNetwork removes the given alpha from its group gracefully. The request is
+// sent to the alpha itself, which drains and hands the leadership over.
+func DecommissionOverNetwork(ctx context.Context, req *pb.RemoveNodeRequest) error {
+	member := GetMembershipState().GetGroups()[req.GroupId].GetMembers()[req.NodeId]
+	if member == nil {
+		return errors.Errorf("There is no node %#x in group %d", req.NodeId, req.GroupId)
+	}
+	if member.GetAddr() == x.WorkerConfig.MyAddr {
+		return decommission(ctx, req)
+	}
+	pool, err := conn.GetPools().Get(member.GetAddr())
+	if err != nil {
+		return err
+	}
+	_, err = pb.NewWorkerClient(pool.Get()).Decommission(ctx, req)
+	return err
+}
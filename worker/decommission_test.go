@@ -0,0 +1,76 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package worker
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hypermodeinc/dgraph/v25/protos/pb"
+	"github.com/hypermodeinc/dgraph/v25/x"
+)
+
+func TestPlanTabletMoves(t *testing.T) {
+	tablet := func(gid uint32, ns uint64, attr string, size int64) *pb.Tablet {
+		return &pb.Tablet{GroupId: gid, Predicate: x.NamespaceAttr(ns, attr), OnDiskBytes: size}
+	}
+	tablets := func(tabs ...*pb.Tablet) map[string]*pb.Tablet {
+		m := make(map[string]*pb.Tablet)
+		for _, tab := range tabs {
+			m[tab.Predicate] = tab
+		}
+		return m
+	}
+	members := map[uint64]*pb.Member{1: {Id: 1}}
+	state := &pb.MembershipState{Groups: map[uint32]*pb.Group{
+		1: {Members: members, Tablets: tablets(tablet(1, 0, "dgraph.type", 10))},
+		2: {Members: members, Tablets: tablets(tablet(2, 0, "name", 100))},
+		3: {
+			Members: members,
+			Tablets: tablets(tablet(3, 0, "age", 50), tablet(3, 2, "friend", 80),
+				tablet(3, 0, "city", 5)),
+		},
+		// A group without members doesn't get any tablet, nor does a decommissioning one.
+		4: {},
+		5: {Members: members, Decommissioning: true},
+	}}
+
+	// The largest tablet goes to the smallest group first.
+	moves, err := planTabletMoves(state, 3)
+	require.NoError(t, err)
+	require.Equal(t, []tabletMove{
+		{Namespace: 2, Tablet: "friend", DstGroup: 1},
+		{Namespace: 0, Tablet: "age", DstGroup: 1},
+		{Namespace: 0, Tablet: "city", DstGroup: 2},
+	}, moves)
+
+	// The reserved predicates can't be moved off group 1.
+	_, err = planTabletMoves(state, 1)
+	require.ErrorContains(t, err, "reserved predicate dgraph.type")
+
+	// An empty group has nothing to move.
+	state.Groups[3].Tablets = nil
+	moves, err = planTabletMoves(state, 3)
+	require.NoError(t, err)
+	require.Empty(t, moves)
+
+	// The tablets of the only group can't go anywhere.
+	only := &pb.MembershipState{Groups: map[uint32]*pb.Group{
+		2: {Members: members, Tablets: tablets(tablet(2, 0, "name", 100))},
+	}}
+	_, err = planTabletMoves(only, 2)
+	require.ErrorContains(t, err, "no other group")
+
+	// A group serving a sub-tablet can't be emptied, even if it doesn't own any tablet.
+	split := tablet(2, 0, "name", 100)
+	split.Shards = []uint32{2, 3}
+	state.Groups[2].Tablets = tablets(split)
+	_, err = planTabletMoves(state, 3)
+	require.ErrorContains(t, err, "serves a sub-tablet of the predicate name")
+	_, err = planTabletMoves(state, 2)
+	require.ErrorContains(t, err, "serves a sub-tablet of the predicate name")
+}
@@ -68,6 +68,9 @@ type node struct {
 	opsLock     sync.Mutex
 	cdcTracker  *CDC
 	canCampaign bool
+
+	// history holds back Badger's discard ts for predicates with @history.
+	history historyTracker
 }
 
 type op int
@@ -274,7 +277,7 @@ func newNode(store *raftwal.DiskStorage, gid uint32, id uint64, myAddr string) *
 		// 10ms. If we restrict the size here, then Raft goes into a loop trying
 		// to maintain quorum health.
 		applyCh:    make(chan []raftpb.Entry, 1000),
-		closer:     z.NewCloser(4), // Matches CLOSER:1
+		closer:     z.NewCloser(7), // Matches CLOSER:1
 		ops:        make(map[op]operation),
 		cdcTracker: newCDC(),
 	}
@@ -341,6 +344,7 @@ func (n *node) applyMutations(ctx context.Context, proposal *pb.Proposal) (rerr
 		}
 		// Ensures nothing get written to disk due to commit proposals.
 		posting.Oracle().ResetTxnsForNs(ns)
+		replication.dropNamespace(proposal.Index, ns)
 		if err := posting.DeleteAllForNs(ns); err != nil {
 			return err
 		}
@@ -377,6 +381,7 @@ func (n *node) applyMutations(ctx context.Context, proposal *pb.Proposal) (rerr
 		}
 		// Ensures nothing get written to disk due to commit proposals.
 		posting.Oracle().ResetTxnsForNs(ns)
+		replication.dropNamespace(proposal.Index, ns)
 		if err := posting.DeleteData(ns); err != nil {
 			return err
 		}
@@ -390,6 +395,7 @@ func (n *node) applyMutations(ctx context.Context, proposal *pb.Proposal) (rerr
 	if proposal.Mutations.DropOp == pb.Mutations_ALL {
 		// Ensures nothing get written to disk due to commit proposals.
 		posting.Oracle().ResetTxns()
+		replication.drop(proposal.Index, schema.State().Predicates()...)
 		schema.State().DeleteAll()
 
 		if err := posting.DeleteAll(); err != nil {
@@ -445,6 +451,10 @@ func (n *node) applyMutations(ctx context.Context, proposal *pb.Proposal) (rerr
 		if err := runSchemaMutation(ctx, proposal.Mutations.Schema, startTs); err != nil {
 			return err
 		}
+		for _, supdate := range proposal.Mutations.Schema {
+			// Rebuilding the indexes removes data without new versions.
+			replication.drop(proposal.Index, supdate.Predicate)
+		}
 
 		// Clear the entire cache if there is a schema update because the index rebuild
 		// will invalidate the state.
@@ -478,8 +488,10 @@ func (n *node) applyMutations(ctx context.Context, proposal *pb.Proposal) (rerr
 				return err
 			}
 			span.AddEvent("Deleting predicate")
+			replication.drop(proposal.Index, edge.Attr)
 			return posting.DeletePredicate(ctx, edge.Attr, proposal.StartTs)
 		}
+		tabletLoads.addWrite(edge.Attr)
 		// Don't derive schema when doing deletion.
 		if edge.Op == pb.DirectedEdge_DEL {
 			continue
@@ -674,6 +686,7 @@ func (n *node) applyCommitted(proposal *pb.Proposal, key uint64) error {
 				proposal.CleanPredicate, proposal.ExpectedChecksum)
 			return nil
 		}
+		replication.drop(proposal.Index, proposal.CleanPredicate)
 		err := posting.DeletePredicate(ctx, proposal.CleanPredicate, proposal.StartTs)
 		if err == badger.ErrBannedKey {
 			// Zero might send the delete predicate instruction to alpha when updating the
@@ -724,8 +737,9 @@ func (n *node) applyCommitted(proposal *pb.Proposal, key uint64) error {
 			}
 			glog.Warningf("Error while calling CreateSnapshot: %v. Retrying...", err)
 		}
-		// We can now discard all invalid versions of keys below this ts.
-		pstore.SetDiscardTs(snap.ReadTs)
+		// We can now discard all invalid versions of keys below this ts, except for the
+		// ones held back by @history.
+		n.applySnapshotDiscard(snap)
 		return nil
 	case proposal.Restore != nil:
 		// Enable draining mode for the duration of the restore processing.
@@ -760,11 +774,19 @@ func (n *node) applyCommitted(proposal *pb.Proposal, key uint64) error {
 		x.AssertTrue(proposal.DeleteNs.Namespace != x.RootNamespace)
 		span.AddEvent("Deleting namespace: %d", trace.WithAttributes(
 			attribute.Int64("namespace", int64(proposal.DeleteNs.Namespace))))
+		replication.dropNamespace(proposal.Index, proposal.DeleteNs.Namespace)
 		return posting.DeleteNamespace(proposal.DeleteNs.Namespace)
 
 	case proposal.CdcState != nil:
 		n.cdcTracker.updateCDCState(proposal.CdcState)
 		return nil
+
+	case proposal.Replica != nil:
+		return applyReplica(ctx, proposal.Replica)
+
+	case proposal.ReplicationState != nil:
+		replication.applyState(proposal.ReplicationState)
+		return nil
 	}
 	x.Fatalf("Unknown proposal: %+v", proposal)
 	return nil
@@ -1040,6 +1062,9 @@ func (n *node) retrieveSnapshot(snap *pb.Snapshot) error {
 	if err := n.populateSnapshot(snap, pool); err != nil {
 		return errors.Wrapf(err, "cannot retrieve snapshot from peer")
 	}
+	// The streamed data only has the versions as of the snapshot, so there's no history
+	// before it on this node.
+	n.history.setFloor(snap.ReadTs)
 	// Populate shard stores the streamed data directly into db, so we need to refresh
 	// schema for current group id
 	if err := schema.LoadFromDb(closer.Ctx()); err != nil {
@@ -1598,6 +1623,13 @@ func (n *node) calculateTabletSizes() {
 		glog.V(2).Infof("No tablets found.")
 		return
 	}
+	reads, writes := tabletLoads.rates()
+	ctx, cancel := context.WithTimeout(n.ctx, 30*time.Second)
+	addReplicaReads(ctx, reads)
+	cancel()
+	for pred, tablet := range tablets {
+		tablet.ReadsPerSec, tablet.WritesPerSec = reads[pred], writes[pred]
+	}
 	// Update Zero with the tablet sizes. If Zero sees a tablet which does not belong to
 	// this group, it would send instruction to delete that tablet. There's an edge case
 	// here if the followers are still running Rollup, and happen to read a key before and
@@ -1834,6 +1866,8 @@ func (n *node) calculateSnapshot(startIdx, lastIdx, minPendingStart uint64) (*pb
 		Index:   snapshotIdx,
 		ReadTs:  maxCommitTs,
 	}
+	result.DiscardTs = n.history.discardTs(maxCommitTs, snapshotDiscardTs(snap),
+		schema.State().HistoryRetention(), time.Now())
 	span.AddEvent("Got snapshot", trace.WithAttributes(
 		attribute.Stringer("snapshot", result)))
 	return result, nil
@@ -1919,6 +1953,10 @@ func (n *node) InitAndStartNode() {
 			// zero-member Raft group.
 			n.SetConfState(&sp.Metadata.ConfState)
 
+			var snap pb.Snapshot
+			x.Check(proto.Unmarshal(sp.Data, &snap))
+			n.history.setFloor(snapshotDiscardTs(&snap))
+
 			// TODO: Making connections here seems unnecessary, evaluate.
 			members := groups().members(n.gid)
 			for _, id := range sp.Metadata.ConfState.Voters {
@@ -1959,6 +1997,9 @@ func (n *node) InitAndStartNode() {
 		}
 	}
 	go n.processTabletSizes()
+	go n.processReplication()
+	go n.processArchive()
+	go n.processSchedules()
 	go n.processApplyCh()
 	go n.BatchAndSendMessages()
 	go n.monitorRaftMetrics()
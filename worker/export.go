@@ -13,10 +13,12 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"io"
 	"math"
 	"net/url"
 	"os"
 	"path/filepath"
+	"strconv"
 	"strings"
 	"time"
 
@@ -324,6 +326,21 @@ func toSchema(attr string, update *pb.SchemaUpdate) *bpb.KV {
 	if update.GetUnique() {
 		x.Check2(buf.WriteString(" @unique"))
 	}
+	if ttl := update.GetTtl(); ttl > 0 {
+		x.Check2(buf.WriteString(" @ttl("))
+		x.Check2(buf.WriteString((time.Duration(ttl) * time.Second).String()))
+		x.Check2(buf.WriteRune(')'))
+	}
+	if retain := update.GetHistoryRetain(); retain > 0 {
+		x.Check2(buf.WriteString(" @history(retain: "))
+		x.Check2(buf.WriteString((time.Duration(retain) * time.Second).String()))
+		x.Check2(buf.WriteRune(')'))
+	}
+	if key := update.GetEncryptionKey(); key != "" {
+		x.Check2(buf.WriteString(" @encrypted(key: "))
+		x.Check2(buf.WriteString(strconv.Quote(key)))
+		x.Check2(buf.WriteRune(')'))
+	}
 	x.Check2(buf.WriteString(" . \n"))
 	//TODO(Naman): We don't need the version anymore.
 	return &bpb.KV{
@@ -402,13 +419,17 @@ type ExportWriter struct {
 	hasDataBefore bool
 }
 
-func (writer *ExportWriter) open(fpath string) error {
+func (writer *ExportWriter) open(fpath string, write *throttle) error {
 	var err error
 	writer.fd, err = os.Create(fpath)
 	if err != nil {
 		return err
 	}
-	writer.bw = bufio.NewWriterSize(writer.fd, 1e6)
+	var fw io.Writer = writer.fd
+	if write != nil {
+		fw = &throttledWriter{WriteCloser: writer.fd, t: write}
+	}
+	writer.bw = bufio.NewWriterSize(fw, 1e6)
 	w, err := enc.GetWriter(x.WorkerConfig.EncryptionKey, writer.bw)
 	if err != nil {
 		return err
@@ -444,6 +465,9 @@ type ExportStorage interface {
 type localExportStorage struct {
 	destination  string
 	relativePath string
+	// write limits the bytes per second written to the files, when they are the destination of
+	// the export.
+	write *throttle
 }
 
 // remoteExportStorage uses localExportStorage to write files, then uploads to minio
@@ -452,6 +476,8 @@ type remoteExportStorage struct {
 	bucket string
 	prefix string // stores the path within the bucket.
 	les    *localExportStorage
+	// write limits the bytes per second uploaded to the bucket.
+	write *throttle
 }
 
 func newLocalExportStorage(destination, backupName string) (*localExportStorage, error) {
@@ -464,7 +490,7 @@ func newLocalExportStorage(destination, backupName string) (*localExportStorage,
 		return nil, err
 	}
 
-	return &localExportStorage{destination, backupName}, nil
+	return &localExportStorage{destination: destination, relativePath: backupName}, nil
 }
 
 func (l *localExportStorage) OpenFile(fileName string) (*ExportWriter, error) {
@@ -477,7 +503,7 @@ func (l *localExportStorage) OpenFile(fileName string) (*ExportWriter, error) {
 
 	glog.Infof("Exporting to file at %s\n", filePath)
 
-	if err := fw.open(filePath); err != nil {
+	if err := fw.open(filePath, l.write); err != nil {
 		return nil, err
 	}
 
@@ -532,7 +558,8 @@ func newRemoteExportStorage(in *pb.ExportRequest, backupName string) (*remoteExp
 		return nil, err
 	}
 
-	return &remoteExportStorage{mc, bucket, prefix, localStorage}, nil
+	return &remoteExportStorage{mc, bucket, prefix, localStorage,
+		newThrottle(in.WriteBytesPerSec)}, nil
 }
 
 func (r *remoteExportStorage) OpenFile(fileName string) (*ExportWriter, error) {
@@ -562,10 +589,7 @@ func (r *remoteExportStorage) FinishWriting(w *Writers) (ExportedFiles, error) {
 		filePath := filepath.Join(r.les.destination, f)
 		// FIXME: tejas [06/2020] - We could probably stream these results, but it's easier to copy for now
 		glog.Infof("Uploading from %s to %s\n", filePath, d)
-		_, err := r.mc.FPutObject(context.Background(), r.bucket, d, filePath, minio.PutObjectOptions{
-			ContentType: "application/gzip",
-		})
-		if err != nil {
+		if err := r.upload(filePath, d); err != nil {
 			return nil, err
 		}
 	}
@@ -573,15 +597,41 @@ func (r *remoteExportStorage) FinishWriting(w *Writers) (ExportedFiles, error) {
 	return files, nil
 }
 
+// upload uploads the file to the object in the bucket, within the write limit.
+func (r *remoteExportStorage) upload(filePath, object string) error {
+	opts := minio.PutObjectOptions{ContentType: "application/gzip"}
+	if r.write == nil {
+		_, err := r.mc.FPutObject(context.Background(), r.bucket, object, filePath, opts)
+		return err
+	}
+	f, err := os.Open(filePath)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	fi, err := f.Stat()
+	if err != nil {
+		return err
+	}
+	_, err = r.mc.PutObject(context.Background(), r.bucket, object,
+		&throttledReader{Reader: f, t: r.write}, fi.Size(), opts)
+	return err
+}
+
 func NewExportStorage(in *pb.ExportRequest, backupName string) (ExportStorage, error) {
+	destination := x.WorkerConfig.ExportPath
 	switch {
 	case strings.HasPrefix(in.Destination, "/"):
-		return newLocalExportStorage(in.Destination, backupName)
+		destination = in.Destination
 	case strings.HasPrefix(in.Destination, "minio://") || strings.HasPrefix(in.Destination, "s3://"):
 		return newRemoteExportStorage(in, backupName)
-	default:
-		return newLocalExportStorage(x.WorkerConfig.ExportPath, backupName)
 	}
+	les, err := newLocalExportStorage(destination, backupName)
+	if err != nil {
+		return nil, err
+	}
+	les.write = newThrottle(in.WriteBytesPerSec)
+	return les, nil
 }
 
 // export creates a export of data by exporting it as an RDF gzip.
@@ -843,7 +893,9 @@ func exportInternal(ctx context.Context, in *pb.ExportRequest, db *badger.DB,
 		return ToExportKvList(pk, pl, in)
 	}
 
+	read := newThrottle(in.ReadBytesPerSec)
 	stream.Send = func(buf *z.Buffer) error {
+		read.take(buf.LenNoPadding())
 		kv := &bpb.KV{}
 		return buf.SliceIterate(func(s []byte) error {
 			kv.Reset()
@@ -1043,6 +1095,9 @@ func ExportOverNetwork(ctx context.Context, input *pb.ExportRequest) (ExportedFi
 				SecretKey:    input.SecretKey,
 				SessionToken: input.SessionToken,
 				Anonymous:    input.Anonymous,
+
+				ReadBytesPerSec:  input.ReadBytesPerSec,
+				WriteBytesPerSec: input.WriteBytesPerSec,
 			}
 			files, err := handleExportOverNetwork(ctx, req)
 			ch <- filesAndError{files, err}
@@ -547,6 +547,19 @@ func TestToSchema(t *testing.T) {
 			},
 			expected: "[0x0] <data.base>:string @lang . \n",
 		},
+		{
+			skv: &skv{
+				attr: x.AttrInRootNamespace("ssn"),
+				schema: pb.SchemaUpdate{
+					Predicate:     x.AttrInRootNamespace(""),
+					ValueType:     pb.Posting_STRING,
+					Directive:     pb.SchemaUpdate_INDEX,
+					Tokenizer:     []string{"exact"},
+					EncryptionKey: "pii",
+				},
+			},
+			expected: "[0x0] <ssn>:string @index(exact) @encrypted(key: \"pii\") . \n",
+		},
 	}
 	for _, testCase := range testCases {
 		kv := toSchema(testCase.skv.attr, &testCase.skv.schema)
@@ -0,0 +1,85 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package worker
+
+import (
+	"sort"
+
+	"github.com/hypermodeinc/dgraph/v25/protos/pb"
+	"github.com/hypermodeinc/dgraph/v25/x"
+)
+
+// proposalFeatures returns the features used by the proposal.
+func proposalFeatures(p *pb.Proposal) []x.Feature {
+	var features []x.Feature
+	if p.Replica != nil || p.ReplicationState != nil {
+		features = append(features, x.FeatureReplication)
+	}
+	for _, su := range p.GetMutations().GetSchema() {
+		if su.Ttl > 0 {
+			features = append(features, x.FeaturePredicateTTL)
+		}
+		if su.HistoryRetain > 0 {
+			features = append(features, x.FeaturePredicateHistory)
+		}
+		if su.EncryptionKey != "" {
+			features = append(features, x.FeatureFieldEncryption)
+		}
+	}
+	return features
+}
+
+// checkProposalFeatures returns an error if a member of the cluster doesn't support a feature
+// used by the proposal. The members running an older release would fail to apply it.
+func checkProposalFeatures(p *pb.Proposal) error {
+	features := proposalFeatures(p)
+	if len(features) == 0 {
+		return nil
+	}
+	g := groups()
+	g.RLock()
+	version := g.state.FeatureVersion()
+	g.RUnlock()
+	for _, f := range features {
+		if err := f.Check(version); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// UpgradeStatus is the release and the feature version of every member of the cluster.
+type UpgradeStatus struct {
+	// FeatureVersion is the version of the features the cluster can use, supported by its
+	// lowest member.
+	FeatureVersion uint32
+	// FinalizedFeatureVersion is the version the upgrade of the cluster was last finalized at.
+	FinalizedFeatureVersion uint32
+	// SafeToFinalize is set once every member supports the same feature version, above the
+	// finalized one.
+	SafeToFinalize bool
+	// Members are the Zeros and the alphas, by group and ID.
+	Members []*pb.Member
+}
+
+// GetUpgradeStatus returns the upgrade status of the cluster, from the membership state.
+func GetUpgradeStatus() *UpgradeStatus {
+	state := GetMembershipState()
+	_, safe := state.SafeToFinalize()
+	members := state.Members()
+	sort.Slice(members, func(i, j int) bool {
+		if members[i].GroupId != members[j].GroupId {
+			return members[i].GroupId < members[j].GroupId
+		}
+		return members[i].Id < members[j].Id
+	})
+	return &UpgradeStatus{
+		FeatureVersion:          state.FeatureVersion(),
+		FinalizedFeatureVersion: state.GetFinalizedFeatureVersion(),
+		SafeToFinalize:          safe,
+		Members:                 members,
+	}
+}
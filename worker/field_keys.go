@@ -0,0 +1,122 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package worker
+
+import (
+	"context"
+	"slices"
+
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+
+	"github.com/hypermodeinc/dgraph/v25/enc"
+	"github.com/hypermodeinc/dgraph/v25/protos/pb"
+	"github.com/hypermodeinc/dgraph/v25/schema"
+	"github.com/hypermodeinc/dgraph/v25/types"
+	"github.com/hypermodeinc/dgraph/v25/x"
+)
+
+type fieldKeysCtxKey struct{}
+
+// WithFieldKeys returns a context that restricts the values of @encrypted predicates decrypted
+// by the queries run with it to those encrypted with the given field keys. Without it, every
+// value is decrypted.
+func WithFieldKeys(ctx context.Context, keys []string) context.Context {
+	return context.WithValue(ctx, fieldKeysCtxKey{}, keys)
+}
+
+// FieldKeysFromContext returns the field keys set by WithFieldKeys. It returns false if the
+// context doesn't restrict them.
+func FieldKeysFromContext(ctx context.Context) ([]string, bool) {
+	keys, ok := ctx.Value(fieldKeysCtxKey{}).([]string)
+	return keys, ok
+}
+
+// canUseFieldKey returns true if the query can decrypt the values encrypted with the field key.
+func canUseFieldKey(q *pb.Query, keyName string) bool {
+	return !q.RestrictFieldKeys || slices.Contains(q.FieldKeys, keyName)
+}
+
+// checkFieldKeyFunc returns an error if the function of the query compares the values of an
+// @encrypted predicate but the query can't use its key. Otherwise, eq would tell whether a node
+// has a given value to users that can't read it.
+func checkFieldKeyFunc(q *pb.Query, fc *functionContext) error {
+	keyName := schema.State().EncryptionKey(q.Attr)
+	if keyName == "" || fc.fnType != compareAttrFn || canUseFieldKey(q, keyName) {
+		return nil
+	}
+	return errors.Errorf("Unauthorized to compare the values of @encrypted predicate %s",
+		x.ParseAttr(q.Attr))
+}
+
+// decryptFieldValues decrypts the values of an @encrypted predicate in the result. The values
+// encrypted with a key the query can't use are removed from it.
+func decryptFieldValues(q *pb.Query, out *pb.Result) {
+	if schema.State().EncryptionKey(q.Attr) == "" {
+		return
+	}
+	for i, list := range out.ValueMatrix {
+		var langs []string
+		if i < len(out.LangMatrix) && len(out.LangMatrix[i].Lang) == len(list.Values) {
+			langs = out.LangMatrix[i].Lang
+		}
+		var facets []*pb.Facets
+		if i < len(out.FacetMatrix) && len(out.FacetMatrix[i].FacetsList) == len(list.Values) {
+			facets = out.FacetMatrix[i].FacetsList
+		}
+
+		kept := 0
+		for j, val := range list.Values {
+			if !decryptFieldValue(q, val) {
+				continue
+			}
+			list.Values[kept] = val
+			if langs != nil {
+				langs[kept] = langs[j]
+			}
+			if facets != nil {
+				facets[kept] = facets[j]
+			}
+			kept++
+		}
+		list.Values = list.Values[:kept]
+		if langs != nil {
+			out.LangMatrix[i].Lang = langs[:kept]
+		}
+		if facets != nil {
+			out.FacetMatrix[i].FacetsList = facets[:kept]
+		}
+	}
+}
+
+// decryptFieldValue decrypts the value in place. Values that aren't encrypted, like the ones
+// stored before the predicate was made @encrypted, are kept as they are. It returns false if the
+// value must not be returned.
+func decryptFieldValue(q *pb.Query, val *pb.TaskValue) bool {
+	if types.TypeID(val.ValType) != types.StringID {
+		return true
+	}
+	keyName, ok := enc.FieldKeyName(string(val.Val))
+	if !ok {
+		return true
+	}
+	if !canUseFieldKey(q, keyName) {
+		return false
+	}
+	key, ok := x.WorkerConfig.FieldKeys[keyName]
+	if !ok {
+		glog.Warningf("Field key %q of predicate %s is not configured on this alpha",
+			keyName, x.ParseAttr(q.Attr))
+		return false
+	}
+	plain, err := enc.DecryptField(key, string(val.Val))
+	if err != nil {
+		glog.Warningf("While decrypting a value of predicate %s: %v", x.ParseAttr(q.Attr), err)
+		return false
+	}
+	val.Val = plain
+	return true
+}
@@ -0,0 +1,148 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package worker
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/dgraph-io/badger/v4"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/hypermodeinc/dgraph/v25/posting"
+	"github.com/hypermodeinc/dgraph/v25/protos/pb"
+	"github.com/hypermodeinc/dgraph/v25/schema"
+	"github.com/hypermodeinc/dgraph/v25/x"
+)
+
+func TestFieldKeys(t *testing.T) {
+	x.WorkerConfig.FieldKeys = map[string]x.Sensitive{
+		"pii":     x.Sensitive("0123456789abcdef0123456789abcdef"),
+		"billing": x.Sensitive("fedcba9876543210fedcba9876543210"),
+	}
+	defer func() { x.WorkerConfig.FieldKeys = nil }()
+	require.NoError(t, schema.ParseBytes([]byte(`
+		ssn: string @index(exact) @encrypted(key: "pii") @lang .
+		card: string @encrypted(key: "billing") .
+	`), 1))
+	ssn := x.AttrInRootNamespace("ssn")
+
+	su, ok := schema.State().Get(context.Background(), ssn)
+	require.True(t, ok)
+	edge := &pb.DirectedEdge{Attr: ssn, Value: []byte("123-45-6789"),
+		ValueType: pb.Posting_STRING}
+	require.NoError(t, ValidateAndConvert(edge, &su))
+	require.Equal(t, "123-45-6789", string(edge.Value))
+	require.NoError(t, encryptEdgeValue(edge, &su))
+	encrypted := string(edge.Value)
+	require.NotEqual(t, "123-45-6789", encrypted)
+
+	// The values of the clients are always encrypted, even the ones that look encrypted.
+	forged := &pb.DirectedEdge{Attr: ssn, Value: []byte(encrypted),
+		ValueType: pb.Posting_STRING}
+	require.NoError(t, encryptEdgeValue(forged, &su))
+	require.NotEqual(t, encrypted, string(forged.Value))
+
+	// Arguments of functions are encrypted the same way as the values.
+	val, err := convertValue(ssn, "123-45-6789")
+	require.NoError(t, err)
+	require.Equal(t, encrypted, val.Value)
+
+	_, err = parseSrcFn(context.Background(), &pb.Query{Attr: ssn,
+		SrcFunc: &pb.SrcFunction{Name: "regexp", Args: []string{"/^123/"}}})
+	require.ErrorContains(t, err, "not supported on @encrypted predicate")
+
+	result := func() *pb.Result {
+		return &pb.Result{
+			ValueMatrix: []*pb.ValueList{{Values: []*pb.TaskValue{
+				{ValType: pb.Posting_STRING, Val: []byte(encrypted)},
+				{ValType: pb.Posting_STRING, Val: []byte("plain")},
+			}}},
+			LangMatrix: []*pb.LangList{{Lang: []string{"en", ""}}},
+		}
+	}
+
+	// Without restrictions every value is decrypted.
+	out := result()
+	decryptFieldValues(&pb.Query{Attr: ssn}, out)
+	require.Equal(t, "123-45-6789", string(out.ValueMatrix[0].Values[0].Val))
+	require.Equal(t, "plain", string(out.ValueMatrix[0].Values[1].Val))
+
+	out = result()
+	decryptFieldValues(&pb.Query{Attr: ssn, RestrictFieldKeys: true,
+		FieldKeys: []string{"pii"}}, out)
+	require.Equal(t, "123-45-6789", string(out.ValueMatrix[0].Values[0].Val))
+
+	// Values encrypted with a key that isn't granted are removed along with their language.
+	q := &pb.Query{Attr: ssn, RestrictFieldKeys: true, FieldKeys: []string{"billing"}}
+	out = result()
+	decryptFieldValues(q, out)
+	require.Len(t, out.ValueMatrix[0].Values, 1)
+	require.Equal(t, "plain", string(out.ValueMatrix[0].Values[0].Val))
+	require.Equal(t, []string{""}, out.LangMatrix[0].Lang)
+
+	fc, err := parseSrcFn(context.Background(), &pb.Query{Attr: ssn,
+		SrcFunc: &pb.SrcFunction{Name: "eq", Args: []string{"123-45-6789"}}})
+	require.NoError(t, err)
+	require.ErrorContains(t, checkFieldKeyFunc(q, fc), "Unauthorized")
+	require.NoError(t, checkFieldKeyFunc(&pb.Query{Attr: ssn}, fc))
+
+	su.EncryptionKey = "unknown"
+	edge = &pb.DirectedEdge{Attr: ssn, Value: []byte("123-45-6789"),
+		ValueType: pb.Posting_STRING}
+	require.ErrorContains(t, encryptEdgeValue(edge, &su), "is not configured")
+
+	require.ErrorContains(t, checkSchema(&pb.SchemaUpdate{Predicate: ssn,
+		ValueType: pb.Posting_STRING, Directive: pb.SchemaUpdate_INDEX,
+		Tokenizer: []string{"term"}, EncryptionKey: "pii"}),
+		"Only exact and hash indexes")
+}
+
+func TestEncryptedSchemaChange(t *testing.T) {
+	dir := t.TempDir()
+	ps, err := badger.OpenManaged(badger.DefaultOptions(dir))
+	require.NoError(t, err)
+	defer ps.Close()
+	pstore = ps
+	posting.Init(ps, 0, false)
+
+	require.NoError(t, schema.ParseBytes([]byte(`
+		email: string @index(exact) .
+		phone: string @encrypted(key: "pii") .
+		fresh: string .
+	`), 1))
+	email := x.AttrInRootNamespace("email")
+	phone := x.AttrInRootNamespace("phone")
+	fresh := x.AttrInRootNamespace("fresh")
+	for _, attr := range []string{email, phone} {
+		data, err := proto.Marshal(&pb.PostingList{Postings: []*pb.Posting{{
+			Uid: math.MaxUint64, Value: []byte("value"), ValType: pb.Posting_STRING,
+			Op: posting.Set}}})
+		require.NoError(t, err)
+		writer := posting.NewTxnWriter(pstore)
+		require.NoError(t, writer.SetAt(x.DataKey(attr, 1), data, posting.BitDeltaPosting, 5))
+		require.NoError(t, writer.Flush())
+	}
+
+	// The values already stored would be left in the clear.
+	require.ErrorContains(t, checkSchema(&pb.SchemaUpdate{Predicate: email,
+		ValueType: pb.Posting_STRING, Directive: pb.SchemaUpdate_INDEX,
+		Tokenizer: []string{"exact"}, EncryptionKey: "pii"}),
+		"Schema change not allowed on the @encrypted directive")
+	// Or unreadable.
+	require.ErrorContains(t, checkSchema(&pb.SchemaUpdate{Predicate: phone,
+		ValueType: pb.Posting_STRING}), "Schema change not allowed on the @encrypted directive")
+	require.ErrorContains(t, checkSchema(&pb.SchemaUpdate{Predicate: phone,
+		ValueType: pb.Posting_STRING, EncryptionKey: "billing"}),
+		"Schema change not allowed on the @encrypted directive")
+
+	require.NoError(t, checkSchema(&pb.SchemaUpdate{Predicate: phone,
+		ValueType: pb.Posting_STRING, EncryptionKey: "pii"}))
+	require.NoError(t, checkSchema(&pb.SchemaUpdate{Predicate: fresh,
+		ValueType: pb.Posting_STRING, EncryptionKey: "pii"}))
+}
@@ -22,6 +22,7 @@ import (
 	"github.com/dgraph-io/dgo/v250/protos/api"
 	"github.com/dgraph-io/ristretto/v2/z"
 	"github.com/hypermodeinc/dgraph/v25/conn"
+	"github.com/hypermodeinc/dgraph/v25/posting"
 	"github.com/hypermodeinc/dgraph/v25/protos/pb"
 	"github.com/hypermodeinc/dgraph/v25/raftwal"
 	"github.com/hypermodeinc/dgraph/v25/schema"
@@ -94,10 +95,13 @@ func StartRaftNodes(walStore *raftwal.DiskStorage, bindall bool) {
 	// Successfully connect with dgraphzero, before doing anything else.
 	// Connect with Zero leader and figure out what group we should belong to.
 	m := &pb.Member{
-		Id:      raftIdx,
-		GroupId: x.WorkerConfig.ProposedGroupId,
-		Addr:    x.WorkerConfig.MyAddr,
-		Learner: x.WorkerConfig.Raft.GetBool("learner"),
+		Id:             raftIdx,
+		GroupId:        x.WorkerConfig.ProposedGroupId,
+		Addr:           x.WorkerConfig.MyAddr,
+		Learner:        x.WorkerConfig.Raft.GetBool("learner"),
+		Zone:           x.WorkerConfig.Raft.GetString("zone"),
+		Version:        x.Version(),
+		FeatureVersion: x.FeatureVersion,
 	}
 	if m.GroupId > 0 {
 		m.ForceGroupId = true
@@ -446,7 +450,7 @@ func (g *groupi) BelongsToReadOnly(key string, ts uint64) (uint32, error) {
 func (g *groupi) ServesTablet(key string) (bool, error) {
 	if tablet, err := g.Tablet(key); err != nil {
 		return false, err
-	} else if tablet != nil && tablet.GroupId == groups().groupId() {
+	} else if tablet != nil && servedBy(tablet, groups().groupId()) {
 		return true, nil
 	}
 	return false, nil
@@ -562,6 +566,13 @@ func (g *groupi) HasMeInState() bool {
 
 // Returns 0, 1, or 2 valid server addrs.
 func (g *groupi) AnyTwoServers(gid uint32) []string {
+	return g.readServers(gid, false)
+}
+
+// readServers returns 0, 1, or 2 valid server addrs to read from, the members in the zone of
+// this alpha first. The learners may lag behind the group, so they only serve stale reads, or
+// the reads of a group that has no other members.
+func (g *groupi) readServers(gid uint32, stale bool) []string {
 	g.RLock()
 	defer g.RUnlock()
 
@@ -572,17 +583,36 @@ func (g *groupi) AnyTwoServers(gid uint32) []string {
 	if !has {
 		return []string{}
 	}
-	var res []string
+	zone := x.WorkerConfig.Raft.GetString("zone")
+	var local, remote, learners []string
 	for _, m := range group.Members {
 		// map iteration gives us members in no particular order.
-		res = append(res, m.Addr)
-		if len(res) >= 2 {
-			break
+		switch {
+		case m.Learner && !stale:
+			learners = append(learners, m.Addr)
+		case zone != "" && m.Zone == zone:
+			local = append(local, m.Addr)
+		default:
+			remote = append(remote, m.Addr)
 		}
 	}
+	res := append(append(local, remote...), learners...)
+	if len(res) > 2 {
+		res = res[:2]
+	}
 	return res
 }
 
+// hasLearners returns whether the group of this alpha has learner members.
+func (g *groupi) hasLearners() bool {
+	for _, m := range g.members(g.groupId()) {
+		if m.Learner {
+			return true
+		}
+	}
+	return false
+}
+
 func (g *groupi) members(gid uint32) map[uint64]*pb.Member {
 	g.RLock()
 	defer g.RUnlock()
@@ -742,6 +772,11 @@ func (g *groupi) doSendMembership(tablets map[string]*pb.Tablet) error {
 		Addr:       x.WorkerConfig.MyAddr,
 		Leader:     leader,
 		LastUpdate: uint64(time.Now().Unix()),
+		// Zero replaces the member on changes, so send the fields set on connecting too.
+		Learner:        x.WorkerConfig.Raft.GetBool("learner"),
+		Zone:           x.WorkerConfig.Raft.GetString("zone"),
+		Version:        x.Version(),
+		FeatureVersion: x.FeatureVersion,
 	}
 	group := &pb.Group{
 		Members: make(map[uint64]*pb.Member),
@@ -986,6 +1021,7 @@ func (g *groupi) processOracleDeltaStream() {
 			}
 		}()
 
+		var lastProposed time.Time
 		for {
 			var delta *pb.OracleDelta
 			var batch int
@@ -1001,7 +1037,12 @@ func (g *groupi) processOracleDeltaStream() {
 					glog.Infof("Zero leadership changed. Renewing oracle delta stream.")
 					return
 				}
-				continue
+				if !g.hasLearners() || time.Since(lastProposed) < time.Second {
+					continue
+				}
+				// Propose an empty delta, so the learners can tell how stale they are even
+				// when there are no commits.
+				delta = &pb.OracleDelta{MaxAssigned: posting.Oracle().MaxAssigned()}
 
 			case <-ctx.Done():
 				return
@@ -1062,6 +1103,8 @@ func (g *groupi) processOracleDeltaStream() {
 					}
 				}
 			}
+			lastProposed = time.Now()
+			delta.ProposedAt = lastProposed.UnixNano()
 			for {
 				// Block forever trying to propose this. Also this proposal should not be counted
 				// towards num pending proposals and be proposed right away.
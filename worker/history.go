@@ -0,0 +1,164 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package worker
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/hypermodeinc/dgraph/v25/posting"
+	"github.com/hypermodeinc/dgraph/v25/protos/pb"
+	"github.com/hypermodeinc/dgraph/v25/schema"
+	"github.com/hypermodeinc/dgraph/v25/x"
+)
+
+// Dgraph timestamps are logical, so there is no direct way to tell which timestamp was current
+// 30 days ago. historyTracker remembers the wall clock time at which each snapshot was applied,
+// which is enough to hold back Badger's discard ts for predicates with @history.
+//
+// Badger's discard ts is global, so the longest @history retention applies to all predicates.
+type historyTracker struct {
+	sync.Mutex
+	samples []tsSample
+
+	// floor is the ts below which old versions may already be gone, either because Badger was
+	// allowed to discard them or because the data was streamed in as a snapshot.
+	floor uint64
+}
+
+type tsSample struct {
+	at time.Time
+	ts uint64
+}
+
+// record notes that ts was the snapshot read ts at the given time.
+func (h *historyTracker) record(ts uint64, at time.Time, retain time.Duration) {
+	h.Lock()
+	defer h.Unlock()
+
+	if n := len(h.samples); n > 0 && h.samples[n-1].ts >= ts {
+		return
+	}
+	h.samples = append(h.samples, tsSample{at: at, ts: ts})
+	h.prune(at.Add(-retain))
+}
+
+// prune drops the samples older than cutoff, except the latest of them which still marks the
+// ts that can be discarded.
+func (h *historyTracker) prune(cutoff time.Time) {
+	idx := h.lastBefore(cutoff)
+	if idx > 0 {
+		h.samples = append(h.samples[:0], h.samples[idx:]...)
+	}
+}
+
+// lastBefore returns the index of the latest sample taken at or before cutoff, or -1.
+func (h *historyTracker) lastBefore(cutoff time.Time) int {
+	idx := -1
+	for i, s := range h.samples {
+		if s.at.After(cutoff) {
+			break
+		}
+		idx = i
+	}
+	return idx
+}
+
+// discardTs returns the ts below which versions can be discarded for a snapshot at readTs. prev
+// is the discard ts of the last snapshot. The result never goes backwards, because versions
+// below prev might already be gone.
+func (h *historyTracker) discardTs(readTs, prev uint64, retain time.Duration,
+	now time.Time) uint64 {
+	if retain == 0 {
+		return x.Max(readTs, prev)
+	}
+
+	h.Lock()
+	defer h.Unlock()
+	ts := prev
+	if idx := h.lastBefore(now.Add(-retain)); idx >= 0 {
+		ts = x.Max(ts, h.samples[idx].ts)
+	}
+	ts = x.Min(ts, readTs)
+	if ts == 0 {
+		// Zero would mean that the snapshot predates @history and its read ts should be used.
+		ts = 1
+	}
+	return ts
+}
+
+func (h *historyTracker) setFloor(ts uint64) {
+	for {
+		cur := atomic.LoadUint64(&h.floor)
+		if ts <= cur || atomic.CompareAndSwapUint64(&h.floor, cur, ts) {
+			return
+		}
+	}
+}
+
+// snapshotDiscardTs returns the ts below which versions may be discarded once snap is applied.
+// Snapshots taken before @history was added don't have a discard ts and use their read ts.
+func snapshotDiscardTs(snap *pb.Snapshot) uint64 {
+	if snap.GetDiscardTs() != 0 {
+		return snap.GetDiscardTs()
+	}
+	return snap.GetReadTs()
+}
+
+// applySnapshotDiscard records snap and lets Badger discard the versions it no longer needs.
+func (n *node) applySnapshotDiscard(snap *pb.Snapshot) {
+	n.history.record(snap.ReadTs, time.Now(), schema.State().HistoryRetention())
+	discardTs := snapshotDiscardTs(snap)
+	n.history.setFloor(discardTs)
+	pstore.SetDiscardTs(discardTs)
+}
+
+// ValidateAsOfTs checks that the ts of an @asof query isn't ahead of the latest ts. The
+// history of every group serving the query is checked by processTask.
+func ValidateAsOfTs(ts uint64) error {
+	if maxTs := posting.Oracle().MaxAssigned(); ts > maxTs {
+		return errors.Errorf("@asof ts: %d is ahead of the latest ts: %d", ts, maxTs)
+	}
+	return nil
+}
+
+// checkAsOf checks that the data as of ts is still around in the group, so that an @asof query
+// doesn't silently read a partial graph.
+func (h *historyTracker) checkAsOf(ts uint64, gid uint32) error {
+	if floor := atomic.LoadUint64(&h.floor); ts < floor {
+		return errors.Errorf("@asof ts: %d is older than the retained history of group %d, "+
+			"which starts at ts: %d. Use @history on the predicates to keep older versions",
+			ts, gid, floor)
+	}
+	return nil
+}
+
+// checkClone checks that the data as of ts is still around in the group, so that a namespace
+// cloned at ts isn't silently missing the versions discarded since.
+func (h *historyTracker) checkClone(ts uint64, gid uint32) error {
+	if floor := atomic.LoadUint64(&h.floor); ts < floor {
+		return errors.Errorf("cannot clone at ts: %d, it is older than the retained history of "+
+			"group %d, which starts at ts: %d", ts, gid, floor)
+	}
+	return nil
+}
+
+type asOfCtxKey struct{}
+
+// WithAsOf returns a context marking the queries run with it as @asof queries.
+func WithAsOf(ctx context.Context) context.Context {
+	return context.WithValue(ctx, asOfCtxKey{}, true)
+}
+
+// AsOfFromContext returns whether the context was returned by WithAsOf.
+func AsOfFromContext(ctx context.Context) bool {
+	asOf, _ := ctx.Value(asOfCtxKey{}).(bool)
+	return asOf
+}
@@ -0,0 +1,73 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package worker
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hypermodeinc/dgraph/v25/protos/pb"
+)
+
+func TestHistoryDiscardTs(t *testing.T) {
+	var h historyTracker
+	start := time.Unix(1700000000, 0)
+	retain := 24 * time.Hour
+
+	// Without @history the read ts of the snapshot is used.
+	require.Equal(t, uint64(50), h.discardTs(50, 10, 0, start))
+
+	// Nothing is old enough yet, so hold on to the previous discard ts.
+	h.record(100, start, retain)
+	h.record(200, start.Add(12*time.Hour), retain)
+	require.Equal(t, uint64(10), h.discardTs(300, 10, retain, start.Add(20*time.Hour)))
+
+	// After a day, everything up to the first sample can go.
+	require.Equal(t, uint64(100), h.discardTs(300, 10, retain, start.Add(30*time.Hour)))
+	require.Equal(t, uint64(200), h.discardTs(300, 10, retain, start.Add(40*time.Hour)))
+
+	// The discard ts never goes backwards or past the read ts.
+	require.Equal(t, uint64(150), h.discardTs(300, 150, retain, start.Add(20*time.Hour)))
+	require.Equal(t, uint64(120), h.discardTs(120, 10, retain, start.Add(40*time.Hour)))
+
+	// A brand new group must not fall back to the read ts.
+	var fresh historyTracker
+	require.Equal(t, uint64(1), fresh.discardTs(300, 0, retain, start))
+}
+
+func TestHistoryRecordPrunes(t *testing.T) {
+	var h historyTracker
+	start := time.Unix(1700000000, 0)
+	for i := 0; i < 10; i++ {
+		h.record(uint64(i+1)*10, start.Add(time.Duration(i)*time.Hour), 3*time.Hour)
+	}
+	// Keeps the samples within the retention plus the latest one before it.
+	require.Len(t, h.samples, 4)
+	require.Equal(t, uint64(70), h.samples[0].ts)
+
+	// Older timestamps are ignored.
+	h.record(50, start.Add(10*time.Hour), 3*time.Hour)
+	require.Len(t, h.samples, 4)
+}
+
+func TestSnapshotDiscardTs(t *testing.T) {
+	require.Equal(t, uint64(20), snapshotDiscardTs(&pb.Snapshot{ReadTs: 20}))
+	require.Equal(t, uint64(5), snapshotDiscardTs(&pb.Snapshot{ReadTs: 20, DiscardTs: 5}))
+}
+
+func TestHistoryCheckAsOf(t *testing.T) {
+	var h historyTracker
+	require.NoError(t, h.checkAsOf(5, 2))
+
+	// The group discarded the versions below its floor.
+	h.setFloor(100)
+	require.NoError(t, h.checkAsOf(100, 2))
+	require.ErrorContains(t, h.checkAsOf(99, 2), "retained history of group 2")
+	require.NoError(t, h.checkClone(100, 2))
+	require.ErrorContains(t, h.checkClone(99, 2), "cannot clone at ts: 99")
+}
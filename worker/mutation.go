@@ -29,11 +29,13 @@ import (
 	"github.com/dgraph-io/dgo/v250/protos/api"
 	"github.com/dgraph-io/ristretto/v2/z"
 	"github.com/hypermodeinc/dgraph/v25/conn"
+	"github.com/hypermodeinc/dgraph/v25/enc"
 	"github.com/hypermodeinc/dgraph/v25/posting"
 	"github.com/hypermodeinc/dgraph/v25/protos/pb"
 	"github.com/hypermodeinc/dgraph/v25/schema"
 	"github.com/hypermodeinc/dgraph/v25/tok/hnsw"
 	"github.com/hypermodeinc/dgraph/v25/types"
+	"github.com/hypermodeinc/dgraph/v25/types/facets"
 	"github.com/hypermodeinc/dgraph/v25/x"
 )
 
@@ -89,6 +91,9 @@ func runMutation(ctx context.Context, edge *pb.DirectedEdge, txn *posting.Txn) e
 	isList := su.GetList()
 	var getFn func(key []byte) (*posting.List, error)
 	switch {
+	case edge.Part == pb.DirectedEdge_REVERSE:
+		// Only the reverse key of the object is updated.
+		getFn = txn.GetFromDelta
 	case len(edge.Lang) == 0 && !isList:
 		// Scalar Predicates, without lang
 		getFn = txn.GetScalarList
@@ -219,7 +224,7 @@ func runSchemaMutation(ctx context.Context, updates []*pb.SchemaUpdate, startTs
 	for _, su := range updates {
 		if tablet, err := groups().Tablet(su.Predicate); err != nil {
 			return err
-		} else if tablet.GetGroupId() != groups().groupId() {
+		} else if !servedBy(tablet, groups().groupId()) {
 			return errors.Errorf("Tablet isn't being served by this group. Tablet: %+v", tablet)
 		}
 
@@ -235,6 +240,9 @@ func runSchemaMutation(ctx context.Context, updates []*pb.SchemaUpdate, startTs
 			CurrentSchema: su,
 		}
 		shouldRebuild := ok && rebuild.NeedIndexRebuild()
+		if shouldRebuild {
+			noteIndexRebuild(startTs)
+		}
 
 		// Start opIndexing task only if schema update needs to build the indexes.
 		if shouldRebuild && !gr.Node.isRunningTask(opIndexing) {
@@ -416,6 +424,36 @@ func checkSchema(s *pb.SchemaUpdate) error {
 			x.ParseAttr(s.Predicate))
 	}
 
+	// Expired postings disappear without a delta being written, so count and vector
+	// indexes, which are maintained incrementally, would go stale.
+	if s.Ttl > 0 && s.Count {
+		return errors.Errorf("@ttl cannot be combined with @count on predicate %s",
+			x.ParseAttr(s.Predicate))
+	}
+	if s.Ttl > 0 && len(s.IndexSpecs) > 0 {
+		return errors.Errorf("@ttl is not supported on vector indexed predicate %s",
+			x.ParseAttr(s.Predicate))
+	}
+
+	// Encrypted values are deterministic, so only the tokenizers that keep the whole value can
+	// index them.
+	if s.EncryptionKey != "" {
+		for _, tokenizer := range s.Tokenizer {
+			if tokenizer != "exact" && tokenizer != "hash" {
+				return errors.Errorf("Only exact and hash indexes are allowed on @encrypted "+
+					"predicate %s, got: %s", x.ParseAttr(s.Predicate), tokenizer)
+			}
+		}
+	}
+
+	// The values stored before are neither encrypted nor decrypted again, they would be left in
+	// the clear, or unreadable, and out of the deterministic index.
+	if s.EncryptionKey != schema.State().EncryptionKey(s.Predicate) &&
+		hasEdges(s.Predicate, math.MaxUint64) {
+		return errors.Errorf("Schema change not allowed on the @encrypted directive of "+
+			"predicate %s while there is data for it", x.ParseAttr(s.Predicate))
+	}
+
 	if s.Unique {
 		ctx := context.WithValue(context.Background(), schema.IsWrite, false)
 		prevSchema, _ := schema.State().Get(ctx, s.Predicate)
@@ -503,6 +541,68 @@ func validateSchemaForUnique(prevSchema pb.SchemaUpdate, currentSchema *pb.Schem
 	return errors.Errorf("@unique directive not supported on [%v] type predicate", currentSchema.ValueType.String())
 }
 
+// ttlFacet is the reserved facet used to set the expiry of a single edge. Its value can be
+// a duration string ("1h"), a number of seconds, or an absolute datetime.
+const ttlFacet = "dgraph.ttl"
+
+// setEdgeExpiry fills in edge.ExpiresAt from the dgraph.ttl facet, falling back to the @ttl
+// of the predicate. It must run before the mutation is proposed so that every replica
+// stores the same expiry. su is nil if the predicate has no schema yet.
+func setEdgeExpiry(edge *pb.DirectedEdge, su *pb.SchemaUpdate, now time.Time) error {
+	if edge.Op != pb.DirectedEdge_SET || edge.ExpiresAt != 0 {
+		return nil
+	}
+	var expiresAt time.Time
+	for i, f := range edge.Facets {
+		if f.Key != ttlFacet {
+			continue
+		}
+		val, err := facets.ValFor(f)
+		if err != nil {
+			return err
+		}
+		switch v := val.Value.(type) {
+		case int64:
+			if v <= 0 {
+				return errors.Errorf("Facet %s must be positive, got: %d", ttlFacet, v)
+			}
+			expiresAt = now.Add(time.Duration(v) * time.Second)
+		case string:
+			d, err := x.ParseDuration(v)
+			if err != nil {
+				return errors.Wrapf(err, "while parsing facet %s", ttlFacet)
+			}
+			if d <= 0 {
+				return errors.Errorf("Facet %s must be positive, got: %s", ttlFacet, v)
+			}
+			expiresAt = now.Add(d)
+		case time.Time:
+			expiresAt = v
+		default:
+			return errors.Errorf("Facet %s must be a duration, seconds or datetime, got: %v",
+				ttlFacet, val.Tid.Name())
+		}
+		edge.Facets = append(edge.Facets[:i], edge.Facets[i+1:]...)
+		break
+	}
+	if expiresAt.IsZero() && su != nil && su.Ttl > 0 {
+		expiresAt = now.Add(time.Duration(su.Ttl) * time.Second)
+	}
+	if expiresAt.IsZero() {
+		return nil
+	}
+	if su != nil && su.Count {
+		return errors.Errorf("Edges with an expiry are not allowed on @count predicate %s",
+			x.ParseAttr(edge.Attr))
+	}
+	if su != nil && len(su.IndexSpecs) > 0 {
+		return errors.Errorf("Edges with an expiry are not allowed on vector indexed predicate %s",
+			x.ParseAttr(edge.Attr))
+	}
+	edge.ExpiresAt = uint64(expiresAt.Unix())
+	return nil
+}
+
 // ValidateAndConvert checks compatibility or converts to the schema type if the storage type is
 // specified. If no storage type is specified then it converts to the schema type.
 func ValidateAndConvert(edge *pb.DirectedEdge, su *pb.SchemaUpdate) error {
@@ -596,6 +696,29 @@ func ValidateAndConvert(edge *pb.DirectedEdge, su *pb.SchemaUpdate) error {
 	return nil
 }
 
+// encryptEdgeValue encrypts the value of an edge of an @encrypted predicate. It is called once,
+// on the values of the clients before they are proposed. Every value is encrypted, even one that
+// looks encrypted already, so that a client can't store a value of its choosing as the
+// ciphertext. The exports hold the encrypted values, they are loaded back with the bulk loader,
+// which stores the values as they are.
+func encryptEdgeValue(edge *pb.DirectedEdge, su *pb.SchemaUpdate) error {
+	keyName := su.EncryptionKey
+	if keyName == "" || types.TypeID(edge.ValueType) != types.StringID {
+		return nil
+	}
+	key, ok := x.WorkerConfig.FieldKeys[keyName]
+	if !ok {
+		return errors.Errorf("Field key %q of predicate %s is not configured on this alpha",
+			keyName, x.ParseAttr(edge.Attr))
+	}
+	val, err := enc.EncryptField(keyName, key, edge.Value)
+	if err != nil {
+		return err
+	}
+	edge.Value = []byte(val)
+	return nil
+}
+
 // AssignNsIdsOverNetwork sends a request to assign Namespace IDs to the current zero leader.
 func AssignNsIdsOverNetwork(ctx context.Context, num *pb.Num) (*pb.AssignedIds, error) {
 	pl := groups().Leader(0)
@@ -694,6 +817,15 @@ func populateMutationMap(src *pb.Mutations) (map[uint32]*pb.Mutations, error) {
 		if err != nil {
 			return nil, err
 		}
+		// The edges of a split predicate go to the sub-tablet serving their subject, and their
+		// reverse parts to the one serving their object.
+		if shards := groups().tabletShards(edge.Attr); len(shards) > 0 {
+			uid := edge.Entity
+			if edge.Part == pb.DirectedEdge_REVERSE {
+				uid = edge.ValueId
+			}
+			gid = shards[shardOf(uid, len(shards))]
+		}
 
 		mu := mm[gid]
 		if mu == nil {
@@ -710,12 +842,19 @@ func populateMutationMap(src *pb.Mutations) (map[uint32]*pb.Mutations, error) {
 			return nil, err
 		}
 
-		mu := mm[gid]
-		if mu == nil {
-			mu = &pb.Mutations{GroupId: gid}
-			mm[gid] = mu
+		// The schema of a split predicate is sent to all its sub-tablets.
+		gids := groups().tabletShards(schema.Predicate)
+		if len(gids) == 0 {
+			gids = []uint32{gid}
+		}
+		for _, gid := range gids {
+			mu := mm[gid]
+			if mu == nil {
+				mu = &pb.Mutations{GroupId: gid}
+				mm[gid] = mu
+			}
+			mu.Schema = append(mu.Schema, schema)
 		}
-		mu.Schema = append(mu.Schema, schema)
 	}
 
 	if src.DropOp > 0 {
@@ -760,6 +899,9 @@ func MutateOverNetwork(ctx context.Context, m *pb.Mutations) (*api.TxnContext, e
 	if err := verifyTypes(ctx, m); err != nil {
 		return tctx, err
 	}
+	if err := splitReverseEdges(ctx, m); err != nil {
+		return tctx, err
+	}
 	mutationMap, err := populateMutationMap(m)
 	if err != nil {
 		return tctx, err
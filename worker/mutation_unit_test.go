@@ -10,14 +10,17 @@ import (
 	"os"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
 	"github.com/dgraph-io/badger/v4"
+	"github.com/dgraph-io/dgo/v250/protos/api"
 	"github.com/hypermodeinc/dgraph/v25/posting"
 	"github.com/hypermodeinc/dgraph/v25/protos/pb"
 	"github.com/hypermodeinc/dgraph/v25/schema"
 	"github.com/hypermodeinc/dgraph/v25/types"
+	"github.com/hypermodeinc/dgraph/v25/types/facets"
 	"github.com/hypermodeinc/dgraph/v25/x"
 )
 
@@ -201,6 +204,41 @@ func TestValidateEdgeTypeError(t *testing.T) {
 	require.Error(t, err)
 }
 
+func TestSetEdgeExpiry(t *testing.T) {
+	now := time.Unix(1700000000, 0)
+	su := &pb.SchemaUpdate{ValueType: pb.Posting_STRING, Ttl: 3600}
+
+	edge := &pb.DirectedEdge{Attr: x.AttrInRootNamespace("token"), Op: pb.DirectedEdge_SET}
+	require.NoError(t, setEdgeExpiry(edge, su, now))
+	require.Equal(t, uint64(now.Unix()+3600), edge.ExpiresAt)
+
+	// The facet takes precedence over the schema and is not stored.
+	fc, err := facets.FacetFor(ttlFacet, `"10m"`)
+	require.NoError(t, err)
+	edge = &pb.DirectedEdge{Attr: x.AttrInRootNamespace("token"), Op: pb.DirectedEdge_SET,
+		Facets: []*api.Facet{fc}}
+	require.NoError(t, setEdgeExpiry(edge, su, now))
+	require.Equal(t, uint64(now.Unix()+600), edge.ExpiresAt)
+	require.Empty(t, edge.Facets)
+
+	fc, err = facets.FacetFor(ttlFacet, "30")
+	require.NoError(t, err)
+	edge = &pb.DirectedEdge{Attr: x.AttrInRootNamespace("token"), Op: pb.DirectedEdge_SET,
+		Facets: []*api.Facet{fc}}
+	require.NoError(t, setEdgeExpiry(edge, nil, now))
+	require.Equal(t, uint64(now.Unix()+30), edge.ExpiresAt)
+
+	edge = &pb.DirectedEdge{Attr: x.AttrInRootNamespace("token"), Op: pb.DirectedEdge_DEL}
+	require.NoError(t, setEdgeExpiry(edge, su, now))
+	require.Zero(t, edge.ExpiresAt)
+
+	edge = &pb.DirectedEdge{Attr: x.AttrInRootNamespace("friend"), Op: pb.DirectedEdge_SET,
+		Facets: []*api.Facet{fc}}
+	err = setEdgeExpiry(edge, &pb.SchemaUpdate{ValueType: pb.Posting_UID, Count: true}, now)
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "@count")
+}
+
 func TestTypeSanityCheck(t *testing.T) {
 	// Empty field name check.
 	typeDef := &pb.TypeUpdate{
@@ -37,6 +37,9 @@ const (
 // verifyRequest verifies that the manifest satisfies the requirements to process the given
 // restore request.
 func verifyRequest(h UriHandler, uri *url.URL, req *pb.RestoreRequest, currentGroups []uint32) error {
+	if req.UntilTs > 0 && req.IncrementalFrom > 1 {
+		return errors.Errorf("A restore until a timestamp can't be incremental")
+	}
 	manifests, err := getManifestsToRestore(h, uri, req)
 	if err != nil {
 		return errors.Wrapf(err, "while retrieving manifests")
@@ -59,6 +62,11 @@ func verifyRequest(h UriHandler, uri *url.URL, req *pb.RestoreRequest, currentGr
 		if _, ok := lastManifest.Groups[group]; !ok {
 			return errors.Errorf("groups in cluster and latest backup manifest differ")
 		}
+		// Check that the archive of each group reaches the until ts.
+		groupReq := &pb.RestoreRequest{GroupId: group, UntilTs: req.UntilTs}
+		if _, err := getArchiveToRestore(h, groupReq, lastManifest); err != nil {
+			return err
+		}
 	}
 	return nil
 }
@@ -269,6 +277,15 @@ func handleRestoreProposal(ctx context.Context, req *pb.RestoreRequest, pidx uin
 	if !ok {
 		return errors.Errorf("backup manifest does not contain information for group ID %d", req.GroupId)
 	}
+	// A restore until a timestamp restores the predicates served by the group at the end of the
+	// archive.
+	archive, err := getArchiveToRestore(handler, req, lastManifest)
+	if err != nil {
+		return errors.Wrapf(err, "cannot get archive")
+	}
+	if archive != nil && len(archive.segments) > 0 {
+		restorePreds = archive.segments[0].Predicates
+	}
 
 	// When we change predicate names from {fromNamespace}-predicate to 0-predicate,
 	// this is not straight forward. This is because, Zero has a knowledge of what
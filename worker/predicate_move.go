@@ -50,6 +50,10 @@ func populateKeyValues(ctx context.Context, kvs []*bpb.KV) error {
 	if err := writer.Flush(); err != nil {
 		return err
 	}
+	// The keys may be cached, e.g. when the postings of another sub-tablet are dropped.
+	for _, kv := range kvs {
+		posting.RemoveCacheFor(kv.Key)
+	}
 	pk, err := x.Parse(kvs[0].Key)
 	if err != nil {
 		return errors.Errorf("while parsing KV: %+v, got error: %v", kvs[0], err)
@@ -63,8 +67,12 @@ func batchAndProposeKeyValues(ctx context.Context, kvs chan *pb.KVS) error {
 	proposal := &pb.Proposal{}
 	size := 0
 	var pk x.ParsedKey
+	var sinceTs uint64
 
 	for kvPayload := range kvs {
+		if len(pk.Attr) == 0 {
+			sinceTs = kvPayload.GetSinceTs()
+		}
 		buf := z.NewBufferSlice(kvPayload.GetData())
 		err := buf.SliceIterate(func(s []byte) error {
 			kv := &bpb.KV{}
@@ -80,14 +88,20 @@ func batchAndProposeKeyValues(ctx context.Context, kvs chan *pb.KVS) error {
 				if !pk.IsSchema() {
 					return errors.Errorf("Expecting first key to be schema key: %+v", kv)
 				}
-
-				// Delete on all nodes. Remove the schema at timestamp kv.Version-1 and set it at
-				// kv.Version. kv.Version will be the TxnTs of the predicate move.
-				p := &pb.Proposal{CleanPredicate: pk.Attr, StartTs: kv.Version - 1}
-				glog.Infof("Predicate being received: %v", pk.Attr)
-				if err := n.proposeAndWait(ctx, p); err != nil {
-					glog.Errorf("Error while cleaning predicate %v %v\n", pk.Attr, err)
-					return err
+				if sinceTs > 0 {
+					// These are the changes since the last round of an online move. They are
+					// written on top of the posting lists received before.
+					glog.Infof("Changes of predicate being received: %v, since: %d",
+						pk.Attr, sinceTs)
+				} else {
+					// Delete on all nodes. Remove the schema at timestamp kv.Version-1 and set
+					// it at kv.Version. kv.Version will be the TxnTs of the predicate move.
+					p := &pb.Proposal{CleanPredicate: pk.Attr, StartTs: kv.Version - 1}
+					glog.Infof("Predicate being received: %v", pk.Attr)
+					if err := n.proposeAndWait(ctx, p); err != nil {
+						glog.Errorf("Error while cleaning predicate %v %v\n", pk.Attr, err)
+						return err
+					}
 				}
 			}
 
@@ -254,6 +268,21 @@ func (w *grpcWorker) MovePredicate(ctx context.Context,
 	return &emptyPayload, err
 }
 
+// checkNotMoving returns an error if the predicate is being moved to another group. Its schema
+// can't be changed meanwhile: the deletions of an index rebuild (DropPrefix) aren't versioned,
+// so the later rounds of the move would never send them to the destination.
+func checkNotMoving(pred string) error {
+	tablet, err := groups().Tablet(pred)
+	if err != nil {
+		return err
+	}
+	if tablet.GetMoving() {
+		return errors.Errorf("Schema change not allowed on predicate %s while it is being moved"+
+			" to another group", pred)
+	}
+	return nil
+}
+
 func movePredicateHelper(ctx context.Context, in *pb.MovePredicatePayload) error {
 	// Note: Manish thinks it *should* be OK for a predicate receiver to not have to stop other
 	// operations like snapshots and rollups. Note that this is the sender. This should stop other
@@ -283,11 +312,18 @@ func movePredicateHelper(ctx context.Context, in *pb.MovePredicatePayload) error
 	schemaKey := x.SchemaKey(in.Predicate)
 	item, err := txn.Get(schemaKey)
 	switch {
+	case err == badger.ErrKeyNotFound && in.SinceTs > 0:
+		// The changes are only written on top of the schema sent before. If the predicate was
+		// dropped since, the move has to start over.
+		return errors.Errorf("Predicate %s was dropped during the move", in.Predicate)
 	case err == badger.ErrKeyNotFound:
 		// The predicate along with the schema could have been deleted. In that case badger would
 		// return ErrKeyNotFound. We don't want to try and access item.Value() in that case.
 	case err != nil:
 		return err
+	case in.SinceTs > 0 && item.Version() > in.SinceTs:
+		// The destination can't rebuild the indexes of the new schema from the changes alone.
+		return errors.Errorf("Schema of predicate %s was changed during the move", in.Predicate)
 	default:
 		val, err := item.ValueCopy(nil)
 		if err != nil {
@@ -308,7 +344,8 @@ func movePredicateHelper(ctx context.Context, in *pb.MovePredicatePayload) error
 		badger.KVToBuffer(kv, buf)
 
 		kvs := &pb.KVS{
-			Data: buf.Bytes(),
+			Data:    buf.Bytes(),
+			SinceTs: in.SinceTs,
 		}
 		if err := out.Send(kvs); err != nil {
 			return errors.Errorf("while sending: %v", err)
@@ -320,11 +357,12 @@ func movePredicateHelper(ctx context.Context, in *pb.MovePredicatePayload) error
 	stream := pstore.NewStreamAt(in.TxnTs)
 	stream.LogPrefix = fmt.Sprintf("Sending predicate: [%s]", in.Predicate)
 	stream.Prefix = x.PredicatePrefix(in.Predicate)
+	stream.SinceTs = in.SinceTs
 	stream.KeyToList = func(key []byte, itr *badger.Iterator) (*bpb.KVList, error) {
 		// For now, just send out full posting lists, because we use delete markers to delete older
 		// data in the prefix range. So, by sending only one version per key, and writing it at a
 		// provided timestamp, we can ensure that these writes are above all the delete markers.
-		l, err := posting.ReadPostingList(key, itr)
+		l, err := readMovedPostingList(key, itr, in)
 		if err != nil {
 			return nil, err
 		}
@@ -357,9 +395,28 @@ func movePredicateHelper(ctx context.Context, in *pb.MovePredicatePayload) error
 		return err
 	}
 
-	msg := fmt.Sprintf("Receiver %s says it got %d keys.\n", pl.Addr, recvCount)
+	msg := fmt.Sprintf("Receiver %s says it got %d keys since ts %d.\n", pl.Addr, recvCount,
+		in.SinceTs)
 	span.AddEvent("Moving predicate", trace.WithAttributes(
 		attribute.String("predicate", in.Predicate)))
 	glog.Infof(msg)
 	return nil
 }
+
+// readMovedPostingList reads the posting list of the key to be moved. With SinceTs set, the
+// iterator only has the versions written after it, so the whole list is read again.
+func readMovedPostingList(key []byte, itr *badger.Iterator,
+	in *pb.MovePredicatePayload) (*posting.List, error) {
+	if in.SinceTs == 0 {
+		return posting.ReadPostingList(key, itr)
+	}
+	pk, err := x.Parse(key)
+	if err != nil {
+		return nil, errors.Wrapf(err, "while parsing key %x", key)
+	}
+	if pk.HasStartUid {
+		// The main key of the list has changed too, and its parts are read along with it.
+		return nil, posting.ErrInvalidKey
+	}
+	return posting.GetNoStore(key, in.TxnTs)
+}
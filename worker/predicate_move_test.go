@@ -0,0 +1,77 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package worker
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/dgraph-io/badger/v4"
+	"github.com/hypermodeinc/dgraph/v25/codec"
+	"github.com/hypermodeinc/dgraph/v25/posting"
+	"github.com/hypermodeinc/dgraph/v25/protos/pb"
+	"github.com/hypermodeinc/dgraph/v25/x"
+)
+
+func TestReadMovedPostingListSinceTs(t *testing.T) {
+	dir := t.TempDir()
+	ps, err := badger.OpenManaged(badger.DefaultOptions(dir))
+	require.NoError(t, err)
+	defer ps.Close()
+	pstore = ps
+	posting.Init(ps, 0, false)
+
+	key := x.DataKey(x.AttrInRootNamespace("online_move"), 1)
+
+	complete, err := proto.Marshal(&pb.PostingList{Pack: codec.Encode([]uint64{1, 2}, 256)})
+	require.NoError(t, err)
+	delta, err := proto.Marshal(&pb.PostingList{
+		Postings: []*pb.Posting{{Uid: 3, Op: posting.Set}},
+	})
+	require.NoError(t, err)
+	writer := posting.NewTxnWriter(pstore)
+	require.NoError(t, writer.SetAt(key, complete, posting.BitCompletePosting, 5))
+	require.NoError(t, writer.SetAt(key, delta, posting.BitDeltaPosting, 8))
+	require.NoError(t, writer.Flush())
+
+	in := &pb.MovePredicatePayload{SinceTs: 6, TxnTs: 10}
+	txn := pstore.NewTransactionAt(in.TxnTs, false)
+	defer txn.Discard()
+	iopts := badger.DefaultIteratorOptions
+	iopts.AllVersions = true
+	iopts.SinceTs = in.SinceTs
+	itr := txn.NewKeyIterator(key, iopts)
+	defer itr.Close()
+	itr.Seek(key)
+	require.True(t, itr.Valid())
+
+	// The iterator only has the delta, but the whole list is moved.
+	l, err := readMovedPostingList(key, itr, in)
+	require.NoError(t, err)
+	uids, err := l.Uids(posting.ListOptions{ReadTs: math.MaxUint64})
+	require.NoError(t, err)
+	require.Equal(t, []uint64{1, 2, 3}, uids.Uids)
+}
+
+func TestCheckNotMoving(t *testing.T) {
+	moving, served := x.AttrInRootNamespace("moving"), x.AttrInRootNamespace("served")
+	gr.Lock()
+	gr.tablets[moving] = &pb.Tablet{GroupId: 1, Predicate: moving, Moving: true}
+	gr.tablets[served] = &pb.Tablet{GroupId: 1, Predicate: served}
+	gr.Unlock()
+	defer func() {
+		gr.Lock()
+		delete(gr.tablets, moving)
+		delete(gr.tablets, served)
+		gr.Unlock()
+	}()
+
+	require.ErrorContains(t, checkNotMoving(moving), "while it is being moved")
+	require.NoError(t, checkNotMoving(served))
+}
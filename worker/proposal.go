@@ -153,18 +153,23 @@ func (n *node) proposeAndWait(ctx context.Context, proposal *pb.Proposal) (perr
 			return err
 		case tablet == nil || tablet.GroupId == 0:
 			return errNonExistentTablet
-		case tablet.GroupId != groups().groupId():
+		case !servedBy(tablet, groups().groupId()):
 			return errUnservedTablet
 		default:
 			return nil
 		}
 	}
 
+	if err := checkProposalFeatures(proposal); err != nil {
+		return err
+	}
+
 	// Do a type check here if schema is present
 	// In very rare cases invalid entries might pass through raft, which would
 	// be persisted, we do best effort schema check while writing
 	ctx = schema.GetWriteContext(ctx)
 	if proposal.Mutations != nil {
+		now := time.Now()
 		for _, edge := range proposal.Mutations.Edges {
 			if err := checkTablet(edge.Attr); err != nil {
 				return err
@@ -179,8 +184,18 @@ func (n *node) proposeAndWait(ctx context.Context, proposal *pb.Proposal) (perr
 						"types/predicates.",
 						x.ParseAttr(edge.Attr))
 				}
+				if err := setEdgeExpiry(edge, nil, now); err != nil {
+					return err
+				}
 				continue
-			} else if err := ValidateAndConvert(edge, &su); err != nil {
+			}
+			if err := ValidateAndConvert(edge, &su); err != nil {
+				return err
+			}
+			if err := encryptEdgeValue(edge, &su); err != nil {
+				return err
+			}
+			if err := setEdgeExpiry(edge, &su, now); err != nil {
 				return err
 			}
 		}
@@ -189,6 +204,12 @@ func (n *node) proposeAndWait(ctx context.Context, proposal *pb.Proposal) (perr
 			if err := checkTablet(schema.Predicate); err != nil {
 				return err
 			}
+			if err := checkNotMoving(schema.Predicate); err != nil {
+				return err
+			}
+			if err := checkShardSchema(ctx, schema); err != nil {
+				return err
+			}
 			if err := checkSchema(schema); err != nil {
 				return err
 			}
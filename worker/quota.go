@@ -0,0 +1,141 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package worker
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/hypermodeinc/dgraph/v25/conn"
+	"github.com/hypermodeinc/dgraph/v25/protos/pb"
+	"github.com/hypermodeinc/dgraph/v25/x"
+)
+
+// SetNamespaceQuotaOverNetwork sends a request to set the quota of a namespace to the current
+// zero leader.
+func SetNamespaceQuotaOverNetwork(ctx context.Context, q *pb.NamespaceQuota) error {
+	pl := groups().Leader(0)
+	if pl == nil {
+		return conn.ErrNoConnection
+	}
+	_, err := pb.NewZeroClient(pl.Get()).SetNamespaceQuota(ctx, q)
+	return err
+}
+
+// nsUsage tracks the requests of a namespace on this alpha. The rates are counted over windows
+// of one second.
+type nsUsage struct {
+	sync.Mutex
+	window    int64
+	queries   uint64
+	mutations uint64
+
+	running int64 // Number of queries running, accessed atomically.
+}
+
+var nsUsages sync.Map // namespace -> *nsUsage
+
+func getNsUsage(ns uint64) *nsUsage {
+	u, _ := nsUsages.LoadOrStore(ns, &nsUsage{})
+	return u.(*nsUsage)
+}
+
+// allow counts a request of the namespace in the window of the second now. It returns false if
+// the namespace already made limit requests in it.
+func (u *nsUsage) allow(now int64, isMutation bool, limit uint64) bool {
+	u.Lock()
+	defer u.Unlock()
+	if now != u.window {
+		u.window, u.queries, u.mutations = now, 0, 0
+	}
+	cnt := &u.queries
+	if isMutation {
+		cnt = &u.mutations
+	}
+	if limit > 0 && *cnt >= limit {
+		return false
+	}
+	*cnt++
+	return true
+}
+
+// namespaceQuota returns the quota of the namespace, or nil if it has none.
+func namespaceQuota(ns uint64) *pb.NamespaceQuota {
+	g := groups()
+	g.RLock()
+	defer g.RUnlock()
+	return g.state.GetQuotas()[ns]
+}
+
+// namespaceDiskBytes returns the on-disk size of the tablets of the namespace, as last reported
+// to zero.
+func namespaceDiskBytes(ns uint64) uint64 {
+	g := groups()
+	g.RLock()
+	defer g.RUnlock()
+	var size int64
+	for _, group := range g.state.GetGroups() {
+		for pred, tablet := range group.GetTablets() {
+			if x.ParseNamespace(pred) == ns {
+				size += tablet.GetOnDiskBytes()
+			}
+		}
+	}
+	return uint64(size)
+}
+
+func quotaError(format string, args ...interface{}) error {
+	return status.Errorf(codes.ResourceExhausted, format, args...)
+}
+
+// AcquireQuota checks that a request of the namespace is within its quota. The queries count
+// against its limits of queries per second and of concurrent queries, and the mutations
+// against its limit of mutations per second and of disk usage. The returned function must be
+// called once the request is done.
+//
+// The rates and the concurrent queries are counted by each alpha on its own, without any
+// coordination, so the limits apply per alpha: a namespace spreading its requests over n alphas
+// gets up to n times its quota. The disk usage is the one of the whole cluster.
+func AcquireQuota(ns uint64, isQuery, isMutation bool) (func(), error) {
+	noop := func() {}
+	q := namespaceQuota(ns)
+	if q == nil {
+		return noop, nil
+	}
+
+	u := getNsUsage(ns)
+	now := time.Now().Unix()
+	if isQuery && !u.allow(now, false, q.QueriesPerSec) {
+		return noop, quotaError("Namespace %#x is over its quota of %d queries per second",
+			ns, q.QueriesPerSec)
+	}
+	if isMutation {
+		if !u.allow(now, true, q.MutationsPerSec) {
+			return noop, quotaError("Namespace %#x is over its quota of %d mutations per "+
+				"second", ns, q.MutationsPerSec)
+		}
+		if q.MaxDiskBytes > 0 {
+			if size := namespaceDiskBytes(ns); size >= q.MaxDiskBytes {
+				return noop, quotaError("Namespace %#x uses %d bytes on disk, which is over its "+
+					"quota of %d bytes", ns, size, q.MaxDiskBytes)
+			}
+		}
+	}
+	if !isQuery || q.MaxConcurrentQueries == 0 {
+		return noop, nil
+	}
+	if running := atomic.AddInt64(&u.running, 1); uint64(running) > q.MaxConcurrentQueries {
+		atomic.AddInt64(&u.running, -1)
+		return noop, quotaError("Namespace %#x is over its quota of %d concurrent queries",
+			ns, q.MaxConcurrentQueries)
+	}
+	return func() { atomic.AddInt64(&u.running, -1) }, nil
+}
@@ -0,0 +1,82 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package worker
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/hypermodeinc/dgraph/v25/protos/pb"
+	"github.com/hypermodeinc/dgraph/v25/x"
+)
+
+func TestAcquireQuota(t *testing.T) {
+	g := groups()
+	g.Lock()
+	oldState := g.state
+	g.state = &pb.MembershipState{
+		Groups: map[uint32]*pb.Group{1: {Tablets: map[string]*pb.Tablet{
+			x.NamespaceAttr(3, "name"): {OnDiskBytes: 600},
+			x.NamespaceAttr(3, "age"):  {OnDiskBytes: 500},
+			x.NamespaceAttr(4, "name"): {OnDiskBytes: 5000},
+		}}},
+		Quotas: map[uint64]*pb.NamespaceQuota{
+			3: {Namespace: 3, MaxDiskBytes: 1000, MaxConcurrentQueries: 1},
+			4: {Namespace: 4, QueriesPerSec: 100},
+		},
+	}
+	g.Unlock()
+	defer func() {
+		g.Lock()
+		g.state = oldState
+		g.Unlock()
+	}()
+
+	isQuotaErr := func(err error) {
+		require.Error(t, err)
+		require.Equal(t, codes.ResourceExhausted, status.Code(err))
+	}
+
+	// Namespace 3 is over its disk quota and can only run one query at a time.
+	_, err := AcquireQuota(3, false, true)
+	isQuotaErr(err)
+	release, err := AcquireQuota(3, true, false)
+	require.NoError(t, err)
+	_, err = AcquireQuota(3, true, false)
+	isQuotaErr(err)
+	release()
+	release, err = AcquireQuota(3, true, false)
+	require.NoError(t, err)
+	release()
+
+	// Namespace 4 has no limit on mutations.
+	_, err = AcquireQuota(4, false, true)
+	require.NoError(t, err)
+
+	// Namespaces without a quota are not limited.
+	_, err = AcquireQuota(5, true, true)
+	require.NoError(t, err)
+}
+
+func TestNsUsageAllow(t *testing.T) {
+	u := &nsUsage{}
+	require.True(t, u.allow(10, false, 2))
+	require.True(t, u.allow(10, false, 2))
+	require.False(t, u.allow(10, false, 2))
+	// Mutations are counted separately.
+	require.True(t, u.allow(10, true, 1))
+	require.False(t, u.allow(10, true, 1))
+	// The counts are reset every second.
+	require.True(t, u.allow(11, false, 2))
+	require.True(t, u.allow(11, true, 1))
+	// A zero limit means no limit.
+	for range 10 {
+		require.True(t, u.allow(11, false, 0))
+	}
+}
@@ -0,0 +1,615 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package worker
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+	ostats "go.opencensus.io/stats"
+	"go.opencensus.io/tag"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/dgraph-io/badger/v4"
+	bpb "github.com/dgraph-io/badger/v4/pb"
+	"github.com/dgraph-io/dgo/v250/protos/api"
+	"github.com/dgraph-io/ristretto/v2/z"
+	"github.com/hypermodeinc/dgraph/v25/conn"
+	"github.com/hypermodeinc/dgraph/v25/posting"
+	"github.com/hypermodeinc/dgraph/v25/protos/pb"
+	"github.com/hypermodeinc/dgraph/v25/schema"
+	"github.com/hypermodeinc/dgraph/v25/x"
+)
+
+// replicationInterval is how often the leaders of the groups of a primary cluster ship their
+// changes to the secondary cluster. It bounds the writes lost when failing over to it.
+const replicationInterval = 30 * time.Second
+
+// Cross-cluster replication
+//
+// Every replication round, the leader of each group of a primary cluster reads its tablets at a
+// new read ts, and ships the posting lists changed since its last round to the leaders of the
+// groups of the secondary cluster serving them, at that read ts. The UIDs are the same in both
+// clusters, and the secondary bumps its leases past the ones of the primary, so that it can take
+// over the writes once it's promoted. A leader ships a predicate in full the first time, which
+// replaces it on the secondary, and again after it's dropped or its schema changes, as those
+// remove data without new versions. A predicate isn't shipped while its indexes are rebuilt, as
+// the rebuild writes below the read ts. The leader of group 1 also ships all the type definitions.
+// The GraphQL schema and the ACLs are kept in predicates, so they are replicated along with them.
+//
+// Each predicate is consistent as of the read ts of the round it was shipped in, but the
+// predicates of a round aren't applied atomically. Split predicates aren't replicated, and
+// neither is the state only kept by Zero, like the tablet placements.
+//
+// After each round, the leader proposes the read ts the predicates were shipped at to its group,
+// so that a new leader carries on from them. Like the CDC state, they are only kept in memory and
+// in the Raft log: once every member of the group restarts from a snapshot, all the predicates
+// are shipped in full again. A predicate shipped in full is removed from the secondary before its
+// batches are written, so until they are all applied, it's incomplete on the secondary.
+
+// replicator tracks the predicates shipped by this alpha as the leader of a primary group.
+type replicator struct {
+	sync.Mutex
+	// shipped is the read ts each predicate was last shipped at.
+	shipped map[string]uint64
+	// dropped are the predicates dropped since they were shipped. They are removed from the
+	// secondary if they aren't served by any group anymore.
+	dropped map[string]struct{}
+	// droppedAt is the Raft index each predicate was last dropped at.
+	droppedAt map[string]uint64
+	// started is when this alpha first tried to ship its changes, and lastRound when it last
+	// shipped all of them. The replication lag is measured from them.
+	started, lastRound time.Time
+}
+
+var replication = &replicator{
+	shipped:   make(map[string]uint64),
+	dropped:   make(map[string]struct{}),
+	droppedAt: make(map[string]uint64),
+}
+
+var errSecondaryCluster = errors.New("This cluster is a replication secondary. " +
+	"It only accepts the writes replicated from its primary")
+
+// replicationRole returns the role of the cluster in a cross-cluster replication, and the Zero
+// address of its secondary if it's a primary.
+func replicationRole() (pb.Replication_Role, string) {
+	g := groups()
+	g.RLock()
+	defer g.RUnlock()
+	r := g.state.GetReplication()
+	return r.GetRole(), r.GetTarget()
+}
+
+// CheckWritable returns an error if the cluster is a replication secondary, which only accepts
+// the writes replicated from its primary until it's promoted.
+func CheckWritable() error {
+	if role, _ := replicationRole(); role == pb.Replication_SECONDARY {
+		return errSecondaryCluster
+	}
+	return nil
+}
+
+// drop makes the predicates be shipped in full again. It's called by all the members of a
+// primary group at the Raft index of the proposal, as any of them could be the leader of the next
+// round.
+func (r *replicator) drop(index uint64, preds ...string) {
+	if role, _ := replicationRole(); role != pb.Replication_PRIMARY {
+		return
+	}
+	r.Lock()
+	defer r.Unlock()
+	for _, pred := range preds {
+		delete(r.shipped, pred)
+		r.dropped[pred] = struct{}{}
+		r.droppedAt[pred] = index
+	}
+}
+
+// dropNamespace makes the predicates of the namespace be shipped in full again.
+func (r *replicator) dropNamespace(index, ns uint64) {
+	var preds []string
+	for _, pred := range schema.State().Predicates() {
+		if x.ParseNamespace(pred) == ns {
+			preds = append(preds, pred)
+		}
+	}
+	r.drop(index, preds...)
+}
+
+// state returns the read ts the predicates were last shipped at. index is the Raft index
+// applied before reading them.
+func (r *replicator) state(index uint64) *pb.ReplicationState {
+	r.Lock()
+	defer r.Unlock()
+	st := &pb.ReplicationState{Shipped: make(map[string]uint64, len(r.shipped)), Index: index}
+	for pred, ts := range r.shipped {
+		if ts > 0 {
+			st.Shipped[pred] = ts
+		}
+	}
+	return st
+}
+
+// applyState sets the read ts the predicates were shipped at by the leader of the group. The
+// predicates dropped after the leader read them are left out, they have to be shipped in full.
+func (r *replicator) applyState(st *pb.ReplicationState) {
+	r.Lock()
+	defer r.Unlock()
+	for pred, ts := range st.Shipped {
+		if r.droppedAt[pred] > st.Index || r.shipped[pred] >= ts {
+			continue
+		}
+		r.shipped[pred] = ts
+	}
+}
+
+// lag returns the time since the changes last shipped to the secondary were read.
+func (r *replicator) lag() time.Duration {
+	r.Lock()
+	defer r.Unlock()
+	if r.lastRound.IsZero() {
+		return time.Since(r.started)
+	}
+	return time.Since(r.lastRound)
+}
+
+// processReplication ships the changes of the tablets of the group to the secondary cluster
+// every replication interval, while this alpha is the leader of a group of a primary cluster.
+func (n *node) processReplication() {
+	defer n.closer.Done() // CLOSER:1
+	tick := time.NewTicker(replicationInterval)
+	defer tick.Stop()
+
+	ctx, err := tag.New(n.ctx, tag.Upsert(x.KeyGroup, fmt.Sprintf("%d", n.gid)))
+	x.Check(err)
+	for {
+		select {
+		case <-n.closer.HasBeenClosed():
+			return
+		case <-tick.C:
+			role, target := replicationRole()
+			if role != pb.Replication_PRIMARY || target == "" || !n.AmLeader() {
+				continue
+			}
+			if err := replication.round(n.ctx, n.gid, target); err != nil {
+				glog.Errorf("While replicating group %d to %s: %v", n.gid, target, err)
+			}
+			st := replication.state(n.Applied.DoneUntil())
+			if len(st.Shipped) == 0 {
+				continue
+			}
+			if err := n.proposeAndWait(n.ctx, &pb.Proposal{ReplicationState: st}); err != nil {
+				glog.Errorf("While proposing the replication state of group %d: %v", n.gid, err)
+			}
+			ostats.Record(ctx, x.ReplicationLag.M(int64(replication.lag().Seconds())))
+		}
+	}
+}
+
+// round ships the changes of the tablets of the group to the secondary cluster.
+func (r *replicator) round(ctx context.Context, gid uint32, target string) error {
+	start := time.Now()
+	r.Lock()
+	if r.started.IsZero() {
+		r.started = start
+	}
+	r.Unlock()
+
+	readTs := State.GetTimestamp(true)
+	if err := posting.Oracle().WaitForTs(ctx, readTs); err != nil {
+		return err
+	}
+	state := GetMembershipState()
+	sec, err := connectSecondary(ctx, target)
+	if err != nil {
+		return err
+	}
+	if err := sec.bumpLeases(ctx, state, readTs); err != nil {
+		return errors.Wrapf(err, "while bumping the leases of the secondary")
+	}
+
+	served := make(map[string]struct{})
+	for _, tablets := range state.GetGroups() {
+		for pred := range tablets.GetTablets() {
+			served[pred] = struct{}{}
+		}
+	}
+	// An index rebuild writes at the start ts of its schema change, below the read ts of this
+	// round, so the predicates being indexed are shipped in full once it's done.
+	indexing := schema.GetIndexingPredicates()
+	for pred, tab := range state.GetGroups()[gid].GetTablets() {
+		if tab.GroupId != gid {
+			continue
+		}
+		if len(tab.Shards) > 0 {
+			glog.V(2).Infof("Not replicating split predicate %s", pred)
+			continue
+		}
+		if slices.Contains(indexing, pred) {
+			glog.V(2).Infof("Not replicating predicate %s while its indexes are rebuilt", pred)
+			continue
+		}
+		if err := r.shipPredicate(ctx, sec, gid, pred, readTs); err != nil {
+			return errors.Wrapf(err, "while replicating predicate %s", pred)
+		}
+	}
+
+	// Remove the dropped predicates not served by any group anymore from the secondary.
+	r.Lock()
+	var dropped []string
+	for pred := range r.dropped {
+		if _, ok := served[pred]; !ok {
+			dropped = append(dropped, pred)
+		}
+		delete(r.dropped, pred)
+	}
+	r.Unlock()
+	for _, pred := range dropped {
+		secGid := sec.servingGroup(pred)
+		if secGid == 0 {
+			continue
+		}
+		batch := &pb.ReplicaBatch{Predicate: pred, Clean: true, ReadTs: readTs}
+		if err := sec.send(ctx, secGid, batch); err != nil {
+			r.Lock()
+			r.dropped[pred] = struct{}{}
+			r.Unlock()
+			return errors.Wrapf(err, "while removing predicate %s", pred)
+		}
+	}
+
+	if gid == 1 {
+		if err := sec.shipTypes(ctx, readTs); err != nil {
+			return errors.Wrapf(err, "while replicating the types")
+		}
+	}
+
+	r.Lock()
+	r.lastRound = start
+	r.Unlock()
+	glog.V(2).Infof("Replicated group %d to %s at ts %d in %s", gid, target, readTs,
+		time.Since(start).Round(time.Millisecond))
+	return nil
+}
+
+// shipPredicate ships the changes of the predicate since it was last shipped, or all of it.
+func (r *replicator) shipPredicate(ctx context.Context, sec *secondary, gid uint32, pred string,
+	readTs uint64) error {
+	r.Lock()
+	sinceTs := r.shipped[pred]
+	// Dropping the predicate meanwhile removes it from shipped.
+	r.shipped[pred] = sinceTs
+	r.Unlock()
+
+	secGid := sec.servingGroup(pred)
+	if secGid == 0 {
+		secGid = sec.groupFor(gid)
+	}
+	c, err := sec.leader(secGid)
+	if err != nil {
+		return err
+	}
+	out, err := c.ReceiveReplica(ctx)
+	if err != nil {
+		return errors.Wrapf(err, "while calling ReceiveReplica")
+	}
+
+	txn := pstore.NewTransactionAt(readTs, false)
+	defer txn.Discard()
+
+	// Send the schema first. A predicate shipped in full replaces the one on the secondary.
+	first := &pb.ReplicaBatch{Predicate: pred, Clean: sinceTs == 0, ReadTs: readTs}
+	item, err := txn.Get(x.SchemaKey(pred))
+	switch {
+	case err == badger.ErrKeyNotFound:
+	case err != nil:
+		return err
+	case item.Version() >= sinceTs:
+		val, err := item.ValueCopy(nil)
+		if err != nil {
+			return err
+		}
+		first.Kv = append(first.Kv, &bpb.KV{
+			Key:      x.SchemaKey(pred),
+			Value:    val,
+			UserMeta: []byte{item.UserMeta()},
+			Version:  readTs,
+		})
+	}
+	if first.Clean || len(first.Kv) > 0 {
+		if err := out.Send(first); err != nil {
+			return err
+		}
+	}
+
+	// Like an online predicate move, each changed posting list is shipped in full, so that its
+	// versions on the secondary don't depend on the ones shipped before.
+	in := &pb.MovePredicatePayload{SinceTs: sinceTs, TxnTs: readTs}
+	stream := pstore.NewStreamAt(readTs)
+	stream.LogPrefix = fmt.Sprintf("Replicating predicate: [%s]", pred)
+	stream.Prefix = x.PredicatePrefix(pred)
+	stream.SinceTs = sinceTs
+	stream.KeyToList = func(key []byte, itr *badger.Iterator) (*bpb.KVList, error) {
+		l, err := readMovedPostingList(key, itr, in)
+		if err != nil {
+			return nil, err
+		}
+		kvs, err := l.Rollup(itr.Alloc, readTs)
+		for _, kv := range kvs {
+			kv.Version = readTs
+		}
+		return &bpb.KVList{Kv: kvs}, err
+	}
+	stream.Send = func(buf *z.Buffer) error {
+		list, err := badger.BufferToKVList(buf)
+		if err != nil {
+			return err
+		}
+		return out.Send(&pb.ReplicaBatch{Predicate: pred, Kv: list.Kv, ReadTs: readTs})
+	}
+	if err := stream.Orchestrate(out.Context()); err != nil {
+		return err
+	}
+	if _, err := out.CloseAndRecv(); err != nil {
+		return err
+	}
+
+	r.advance(pred, sinceTs, readTs)
+	return nil
+}
+
+// advance makes the next round ship the changes of the predicate since readTs, unless it was
+// dropped or started being indexed since it was read at sinceTs.
+func (r *replicator) advance(pred string, sinceTs, readTs uint64) {
+	r.Lock()
+	defer r.Unlock()
+	if slices.Contains(schema.GetIndexingPredicates(), pred) {
+		delete(r.shipped, pred)
+		return
+	}
+	if cur, ok := r.shipped[pred]; ok && cur == sinceTs {
+		r.shipped[pred] = readTs
+	}
+}
+
+// secondary is the secondary cluster of a replication round.
+type secondary struct {
+	state *pb.MembershipState
+	zero  pb.ZeroClient
+}
+
+// connectSecondary connects to the Zero leader of the secondary cluster at the target address,
+// and reads its membership state.
+func connectSecondary(ctx context.Context, target string) (*secondary, error) {
+	pl := conn.GetPools().Connect(target, x.WorkerConfig.TLSClientConfig)
+	if pl == nil {
+		return nil, errors.Errorf("Unable to connect to the secondary Zero at %s", target)
+	}
+	cs, err := pb.NewZeroClient(pl.Get()).Connect(ctx, &pb.Member{ClusterInfoOnly: true})
+	if err != nil {
+		return nil, errors.Wrapf(err, "while connecting to the secondary Zero at %s", target)
+	}
+	for _, zero := range cs.GetState().GetZeros() {
+		if zero.Leader {
+			pl = conn.GetPools().Connect(zero.Addr, x.WorkerConfig.TLSClientConfig)
+			return &secondary{state: cs.State, zero: pb.NewZeroClient(pl.Get())}, nil
+		}
+	}
+	return nil, errors.Errorf("No Zero leader in the secondary cluster at %s", target)
+}
+
+// bumpLeases bumps the UID and namespace leases of the secondary past the ones of this cluster,
+// and its timestamps past the read ts of the round.
+func (s *secondary) bumpLeases(ctx context.Context, state *pb.MembershipState,
+	readTs uint64) error {
+	bump := func(val uint64, typ pb.NumLeaseType) error {
+		_, err := s.zero.AssignIds(ctx, &pb.Num{Val: val, Type: typ, Bump: true})
+		if err != nil && strings.Contains(err.Error(), "Nothing to be leased") {
+			return nil
+		}
+		return err
+	}
+	if err := bump(state.GetMaxUID(), pb.Num_UID); err != nil {
+		return err
+	}
+	if err := bump(state.GetMaxNsID(), pb.Num_NS_ID); err != nil {
+		return err
+	}
+	_, err := s.zero.Timestamps(ctx, &pb.Num{Val: readTs, Bump: true})
+	return err
+}
+
+// servingGroup returns the group of the secondary serving the predicate, or zero.
+func (s *secondary) servingGroup(pred string) uint32 {
+	for gid, group := range s.state.GetGroups() {
+		if _, ok := group.GetTablets()[pred]; ok {
+			return gid
+		}
+	}
+	return 0
+}
+
+// groupFor returns the group of the secondary to ship a new predicate of the group to: the one
+// with the same ID if there is one, else group 1.
+func (s *secondary) groupFor(gid uint32) uint32 {
+	if _, ok := s.state.GetGroups()[gid]; ok {
+		return gid
+	}
+	return 1
+}
+
+func (s *secondary) leader(gid uint32) (pb.WorkerClient, error) {
+	for _, m := range s.state.GetGroups()[gid].GetMembers() {
+		if m.Leader {
+			pl := conn.GetPools().Connect(m.Addr, x.WorkerConfig.TLSClientConfig)
+			return pb.NewWorkerClient(pl.Get()), nil
+		}
+	}
+	return nil, errors.Errorf("No leader of group %d in the secondary cluster", gid)
+}
+
+// send ships a single batch to the leader of the group of the secondary.
+func (s *secondary) send(ctx context.Context, gid uint32, batch *pb.ReplicaBatch) error {
+	c, err := s.leader(gid)
+	if err != nil {
+		return err
+	}
+	out, err := c.ReceiveReplica(ctx)
+	if err != nil {
+		return err
+	}
+	if err := out.Send(batch); err != nil {
+		return err
+	}
+	_, err = out.CloseAndRecv()
+	return err
+}
+
+// shipTypes ships all the type definitions to every group of the secondary.
+func (s *secondary) shipTypes(ctx context.Context, readTs uint64) error {
+	batch := &pb.ReplicaBatch{ReadTs: readTs}
+	txn := pstore.NewTransactionAt(readTs, false)
+	defer txn.Discard()
+	iopts := badger.DefaultIteratorOptions
+	iopts.Prefix = x.TypePrefix()
+	itr := txn.NewIterator(iopts)
+	defer itr.Close()
+	for itr.Rewind(); itr.Valid(); itr.Next() {
+		item := itr.Item()
+		pk, err := x.Parse(item.Key())
+		if err != nil {
+			return err
+		}
+		val, err := item.ValueCopy(nil)
+		if err != nil {
+			return err
+		}
+		batch.Kv = append(batch.Kv, &bpb.KV{
+			Key:      item.KeyCopy(nil),
+			Value:    val,
+			UserMeta: []byte{item.UserMeta()},
+			Version:  readTs,
+		})
+		batch.Types = append(batch.Types, pk.Attr)
+	}
+	for gid := range s.state.GetGroups() {
+		if err := s.send(ctx, gid, batch); err != nil {
+			return errors.Wrapf(err, "while sending the types to group %d", gid)
+		}
+	}
+	return nil
+}
+
+// ReceiveReplica receives the batches of a predicate, or of the type definitions, shipped by the
+// primary cluster, and proposes them to the group.
+func (w *grpcWorker) ReceiveReplica(stream pb.Worker_ReceiveReplicaServer) error {
+	if role, _ := replicationRole(); role != pb.Replication_SECONDARY {
+		// Fences off a former primary, which may still be shipping its last round.
+		return errors.Errorf("This cluster is not a replication secondary")
+	}
+	n := groups().Node
+	if !n.AmLeader() {
+		return errNotLeader
+	}
+
+	ctx := stream.Context()
+	count := 0
+	for {
+		batch, err := stream.Recv()
+		if err == io.EOF {
+			return stream.SendAndClose(&api.Payload{Data: []byte(strconv.Itoa(count))})
+		}
+		if err != nil {
+			return err
+		}
+		if batch.Predicate != "" {
+			tablet, err := groups().Tablet(batch.Predicate)
+			if err != nil {
+				return err
+			}
+			if tablet.GetGroupId() != n.gid || len(tablet.GetShards()) > 0 {
+				return errors.Errorf("Predicate %s isn't served by group %d alone",
+					batch.Predicate, n.gid)
+			}
+			if len(batch.Kv) == 0 && !batch.Clean {
+				continue
+			}
+		}
+		if err := n.proposeAndWait(ctx, &pb.Proposal{Replica: batch}); err != nil {
+			return err
+		}
+		count += len(batch.Kv)
+	}
+}
+
+// applyReplica writes a batch replicated from the primary cluster.
+func applyReplica(ctx context.Context, batch *pb.ReplicaBatch) error {
+	if batch.Predicate == "" {
+		return applyReplicaTypes(batch)
+	}
+	if batch.Clean {
+		// The versions of the batch are written at the read ts, above the delete markers.
+		if err := posting.DeletePredicate(ctx, batch.Predicate, batch.ReadTs-1); err != nil {
+			return err
+		}
+	}
+	if len(batch.Kv) == 0 {
+		return nil
+	}
+	writer := posting.NewTxnWriter(pstore)
+	if err := writer.Write(&bpb.KVList{Kv: batch.Kv}); err != nil {
+		return err
+	}
+	if err := writer.Flush(); err != nil {
+		return err
+	}
+	var hasSchema bool
+	for _, kv := range batch.Kv {
+		posting.RemoveCacheFor(kv.Key)
+		pk, err := x.Parse(kv.Key)
+		if err != nil {
+			return errors.Wrapf(err, "while parsing key %x", kv.Key)
+		}
+		hasSchema = hasSchema || pk.IsSchema()
+	}
+	if hasSchema {
+		return schema.Load(batch.Predicate)
+	}
+	return nil
+}
+
+// applyReplicaTypes sets the type definitions of the batch, and removes the other ones.
+func applyReplicaTypes(batch *pb.ReplicaBatch) error {
+	types := make(map[string]struct{})
+	for i, kv := range batch.Kv {
+		var t pb.TypeUpdate
+		if err := proto.Unmarshal(kv.Value, &t); err != nil {
+			return err
+		}
+		if err := updateType(batch.Types[i], &t, batch.ReadTs); err != nil {
+			return err
+		}
+		types[batch.Types[i]] = struct{}{}
+	}
+	for _, name := range schema.State().Types() {
+		if _, ok := types[name]; ok {
+			continue
+		}
+		if err := schema.State().DeleteType(name, batch.ReadTs); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,167 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package worker
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/dgraph-io/badger/v4"
+	bpb "github.com/dgraph-io/badger/v4/pb"
+	"github.com/hypermodeinc/dgraph/v25/codec"
+	"github.com/hypermodeinc/dgraph/v25/posting"
+	"github.com/hypermodeinc/dgraph/v25/protos/pb"
+	"github.com/hypermodeinc/dgraph/v25/schema"
+	"github.com/hypermodeinc/dgraph/v25/x"
+)
+
+func setReplicationRole(t *testing.T, role pb.Replication_Role) {
+	g := groups()
+	g.Lock()
+	defer g.Unlock()
+	state := g.state
+	g.state = &pb.MembershipState{Replication: &pb.Replication{Role: role}}
+	t.Cleanup(func() {
+		g.Lock()
+		defer g.Unlock()
+		g.state = state
+	})
+}
+
+func TestReplicatorDrop(t *testing.T) {
+	r := &replicator{
+		shipped:   map[string]uint64{"0-name": 10, "0-age": 10},
+		dropped:   make(map[string]struct{}),
+		droppedAt: make(map[string]uint64),
+	}
+	r.drop(1, "0-name")
+	require.Contains(t, r.shipped, "0-name")
+	require.NoError(t, CheckWritable())
+
+	setReplicationRole(t, pb.Replication_PRIMARY)
+	r.drop(2, "0-name")
+	require.NotContains(t, r.shipped, "0-name")
+	require.Contains(t, r.shipped, "0-age")
+	require.Contains(t, r.dropped, "0-name")
+	require.NoError(t, CheckWritable())
+
+	setReplicationRole(t, pb.Replication_SECONDARY)
+	require.Error(t, CheckWritable())
+}
+
+func TestReplicatorState(t *testing.T) {
+	setReplicationRole(t, pb.Replication_PRIMARY)
+	leader := &replicator{
+		shipped:   map[string]uint64{"0-name": 10, "0-age": 10, "0-new": 0},
+		dropped:   make(map[string]struct{}),
+		droppedAt: make(map[string]uint64),
+	}
+	st := leader.state(5)
+	// A predicate being shipped for the first time isn't in the state.
+	require.Equal(t, map[string]uint64{"0-name": 10, "0-age": 10}, st.Shipped)
+
+	follower := &replicator{
+		shipped:   map[string]uint64{"0-age": 20},
+		dropped:   make(map[string]struct{}),
+		droppedAt: make(map[string]uint64),
+	}
+	// The predicates dropped after the leader read the state are shipped in full.
+	follower.drop(4, "0-name")
+	follower.drop(6, "0-age")
+	follower.applyState(st)
+	require.Equal(t, map[string]uint64{"0-name": 10}, follower.shipped)
+
+	// The state of a later round moves the shipped read ts forward.
+	follower.applyState(&pb.ReplicationState{Shipped: map[string]uint64{"0-name": 30,
+		"0-age": 30}, Index: 7})
+	require.Equal(t, map[string]uint64{"0-name": 30, "0-age": 30}, follower.shipped)
+	follower.applyState(&pb.ReplicationState{Shipped: map[string]uint64{"0-name": 20}, Index: 8})
+	require.Equal(t, uint64(30), follower.shipped["0-name"])
+}
+
+func TestReplicatorAdvance(t *testing.T) {
+	if schema.State() == nil {
+		schema.Init(pstore)
+	}
+	r := &replicator{shipped: map[string]uint64{"0-name": 10, "0-age": 10}}
+	r.advance("0-name", 10, 20)
+	require.Equal(t, uint64(20), r.shipped["0-name"])
+	// A predicate dropped while it was shipped is shipped in full again.
+	delete(r.shipped, "0-name")
+	r.advance("0-name", 20, 30)
+	require.NotContains(t, r.shipped, "0-name")
+
+	// So is a predicate that started being indexed while it was shipped.
+	schema.State().SetMutSchema("0-age", &pb.SchemaUpdate{Predicate: "0-age"})
+	defer schema.State().DeleteMutSchema("0-age")
+	r.advance("0-age", 10, 20)
+	require.NotContains(t, r.shipped, "0-age")
+}
+
+func TestApplyReplica(t *testing.T) {
+	ps, err := badger.OpenManaged(badger.DefaultOptions(t.TempDir()))
+	require.NoError(t, err)
+	defer ps.Close()
+	pstore = ps
+	posting.Init(ps, 0, false)
+	schema.Init(ps)
+
+	pred := x.AttrInRootNamespace("replicated")
+	key := x.DataKey(pred, 1)
+	su, err := proto.Marshal(&pb.SchemaUpdate{Predicate: pred, ValueType: pb.Posting_UID})
+	require.NoError(t, err)
+	pl, err := proto.Marshal(&pb.PostingList{Pack: codec.Encode([]uint64{2, 3}, 256)})
+	require.NoError(t, err)
+
+	uids := func(readTs uint64) []uint64 {
+		l, err := posting.GetNoStore(key, readTs)
+		require.NoError(t, err)
+		res, err := l.Uids(posting.ListOptions{ReadTs: readTs})
+		require.NoError(t, err)
+		return res.Uids
+	}
+
+	ctx := context.Background()
+	require.NoError(t, applyReplica(ctx, &pb.ReplicaBatch{
+		Predicate: pred,
+		Clean:     true,
+		ReadTs:    10,
+		Kv: []*bpb.KV{
+			{Key: x.SchemaKey(pred), Value: su, UserMeta: []byte{posting.BitSchemaPosting},
+				Version: 10},
+			{Key: key, Value: pl, UserMeta: []byte{posting.BitCompletePosting}, Version: 10},
+		},
+	}))
+	require.Equal(t, []uint64{2, 3}, uids(math.MaxUint64))
+	typ, ok := schema.State().Get(ctx, pred)
+	require.True(t, ok)
+	require.Equal(t, pb.Posting_UID, typ.ValueType)
+
+	// Shipping the predicate in full again removes what isn't in the batches anymore.
+	require.NoError(t, applyReplica(ctx, &pb.ReplicaBatch{Predicate: pred, Clean: true,
+		ReadTs: 20}))
+	require.Empty(t, uids(math.MaxUint64))
+	_, ok = schema.State().Get(ctx, pred)
+	require.False(t, ok)
+
+	// The types not in the batch of the type definitions are removed.
+	name := x.AttrInRootNamespace("Person")
+	require.NoError(t, updateType(x.AttrInRootNamespace("Old"), &pb.TypeUpdate{}, 5))
+	tu, err := proto.Marshal(&pb.TypeUpdate{TypeName: name, Fields: []*pb.SchemaUpdate{
+		{Predicate: pred}}})
+	require.NoError(t, err)
+	require.NoError(t, applyReplica(ctx, &pb.ReplicaBatch{
+		ReadTs: 30,
+		Types:  []string{name},
+		Kv: []*bpb.KV{{Key: x.TypeKey(name), Value: tu,
+			UserMeta: []byte{posting.BitSchemaPosting}, Version: 30}},
+	}))
+	require.Equal(t, []string{name}, schema.State().Types())
+}
@@ -108,6 +108,10 @@ type loadBackupInput struct {
 	version     int
 	keepSchema  bool
 	compression string
+	// untilTs and drops restore the versions committed until untilTs only, without the versions
+	// removed by the drops committed until then.
+	untilTs uint64
+	drops   archiveDrops
 
 	fromNamespace           uint64
 	isNamespaceAwareRestore bool
@@ -334,6 +338,12 @@ func (m *mapper) processReqCh(ctx context.Context) error {
 		if err != nil {
 			return errors.Wrapf(err, "could not parse key %s", hex.Dump(restoreKey))
 		}
+		if in.untilTs > 0 && kv.Version > in.untilTs {
+			return nil
+		}
+		if in.drops.shadows(parsedKey, ns, kv.Version) {
+			return nil
+		}
 
 		// Update the local max uid and max namespace values.
 		maxUid = x.Max(maxUid, parsedKey.Uid)
@@ -765,6 +775,25 @@ func RunMapper(req *pb.RestoreRequest, mapDir string) (*mapResult, error) {
 	if err != nil {
 		return nil, errors.Wrapf(err, "unable to get encryption keys")
 	}
+	// The backups taken before a rotation of the key are encrypted with one of the old keys.
+	restoreKeys := []x.Sensitive{keys.EncKey, x.WorkerConfig.EncryptionKey}
+	restoreKeys = append(restoreKeys, keys.EncKeyRing...)
+	restoreKeys = append(restoreKeys, x.WorkerConfig.EncryptionKeyRing...)
+
+	latest := &Manifest{}
+	if len(manifests) > 0 {
+		latest = manifests[0]
+	}
+	archive, err := getArchiveToRestore(h, req, latest)
+	if err != nil {
+		return nil, errors.Wrapf(err, "cannot retrieve archive")
+	}
+	var drops archiveDrops
+	if archive != nil {
+		drops = archive.drops
+		glog.Infof("Got %d archive segments to restore until ts %d", len(archive.segments),
+			req.UntilTs)
+	}
 
 	mapper := &mapper{
 		buf:       z.NewBuffer(mapFileSz, "Restore.Buffer"),
@@ -796,6 +825,81 @@ func RunMapper(req *pb.RestoreRequest, mapDir string) (*mapResult, error) {
 	dropAttr := make(map[string]struct{})
 	dropNs := make(map[uint64]struct{})
 	var maxBannedNs uint64
+	applyDrops := func(ops []*pb.DropOperation) error {
+		for _, op := range ops {
+			switch op.DropOp {
+			case pb.DropOperation_ALL:
+				dropAll = true
+			case pb.DropOperation_DATA:
+				if op.DropValue == "" {
+					// In 2103, we do not support namespace level drop data.
+					dropAll = true
+					continue
+				}
+				ns, err := strconv.ParseUint(op.DropValue, 0, 64)
+				if err != nil {
+					return errors.Wrap(err, "map phase failed to parse namespace")
+				}
+				dropNs[ns] = struct{}{}
+			case pb.DropOperation_ATTR:
+				dropAttr[op.DropValue] = struct{}{}
+			case pb.DropOperation_NS:
+				// pstore will be nil for export_backup tool. In that case we don't need to ban ns.
+				if pstore == nil {
+					continue
+				}
+				// If there is a drop namespace, we just ban the namespace in the pstore.
+				ns, err := strconv.ParseUint(op.DropValue, 0, 64)
+				if err != nil {
+					return errors.Wrapf(err, "Map phase failed to parse namespace")
+				}
+				if err := pstore.BanNamespace(ns); err != nil {
+					return errors.Wrapf(err, "Map phase failed to ban namespace: %d", ns)
+				}
+				maxBannedNs = x.Max(maxBannedNs, ns)
+			}
+		}
+		return nil
+	}
+
+	// Map the archive first, so that the drops it replays apply to the backups.
+	var archivePreds predicateSet
+	if archive != nil {
+		archivePreds = archive.preds()
+		for _, seg := range archive.segments {
+			key, err := seg.encryptionKey(restoreKeys...)
+			if err != nil {
+				return nil, err
+			}
+			br := readerFrom(h, seg.dataPath()).WithEncryption(key).WithCompression(seg.Compression)
+			if br.err != nil {
+				return nil, errors.Wrap(br.err, "newBackupReader")
+			}
+			defer br.Close()
+
+			in := &loadBackupInput{
+				preds:                   archivePreds,
+				version:                 x.ManifestVersion,
+				restoreTs:               req.RestoreTs,
+				keepSchema:              true,
+				compression:             seg.Compression,
+				untilTs:                 req.UntilTs,
+				drops:                   drops,
+				fromNamespace:           req.FromNamespace,
+				isNamespaceAwareRestore: req.IsNamespaceAwareRestore,
+			}
+			if err := mapper.Map(br, in); err != nil {
+				return nil, errors.Wrap(err, "mapper.Map")
+			}
+			if err := br.Close(); err != nil {
+				return nil, errors.Wrap(err, "br.Close")
+			}
+			glog.Infof("[MAP] Processed archive segment at ts: %d", seg.ReadTs)
+		}
+		if err := applyDrops(drops.dropOperations()); err != nil {
+			return nil, err
+		}
+	}
 
 	// manifests are ordered as: latest..full
 	for i, manifest := range manifests {
@@ -821,16 +925,22 @@ func RunMapper(req *pb.RestoreRequest, mapDir string) (*mapResult, error) {
 			// Only restore the predicates that were assigned to this group at the time
 			// of the last backup.
 			file := filepath.Join(manifest.Path, backupName(manifest.ValidReadTs(), gid))
-			br := readerFrom(h, file).WithEncryption(keys.EncKey).WithCompression(manifest.Compression)
+			key, err := manifest.encryptionKey(restoreKeys...)
+			if err != nil {
+				return nil, err
+			}
+			br := readerFrom(h, file).WithEncryption(key).WithCompression(manifest.Compression)
 			if br.err != nil {
 				return nil, errors.Wrap(br.err, "newBackupReader")
 			}
 			defer br.Close()
 
-			// Only map the predicates which haven't been dropped yet.
+			// Only map the predicates which haven't been dropped yet, and which the group still
+			// served at the end of the archive.
 			predSet := manifest.getPredsInGroup(gid)
 			for p := range predSet {
-				if _, ok := dropAttr[p]; ok {
+				_, archived := archivePreds[p]
+				if _, ok := dropAttr[p]; ok || (archive != nil && !archived) {
 					delete(predSet, p)
 				}
 			}
@@ -846,6 +956,7 @@ func RunMapper(req *pb.RestoreRequest, mapDir string) (*mapResult, error) {
 				// Only map the schema keys corresponding to the latest backup.
 				keepSchema:              i == 0,
 				compression:             manifest.Compression,
+				drops:                   drops,
 				fromNamespace:           req.FromNamespace,
 				isNamespaceAwareRestore: req.IsNamespaceAwareRestore,
 			}
@@ -859,38 +970,8 @@ func RunMapper(req *pb.RestoreRequest, mapDir string) (*mapResult, error) {
 				return nil, errors.Wrap(err, "br.Close")
 			}
 		}
-		for _, op := range manifest.DropOperations {
-			switch op.DropOp {
-			case pb.DropOperation_ALL:
-				dropAll = true
-			case pb.DropOperation_DATA:
-				if op.DropValue == "" {
-					// In 2103, we do not support namespace level drop data.
-					dropAll = true
-					continue
-				}
-				ns, err := strconv.ParseUint(op.DropValue, 0, 64)
-				if err != nil {
-					return nil, errors.Wrap(err, "map phase failed to parse namespace")
-				}
-				dropNs[ns] = struct{}{}
-			case pb.DropOperation_ATTR:
-				dropAttr[op.DropValue] = struct{}{}
-			case pb.DropOperation_NS:
-				// pstore will be nil for export_backup tool. In that case we don't need to ban ns.
-				if pstore == nil {
-					continue
-				}
-				// If there is a drop namespace, we just ban the namespace in the pstore.
-				ns, err := strconv.ParseUint(op.DropValue, 0, 64)
-				if err != nil {
-					return nil, errors.Wrapf(err, "Map phase failed to parse namespace")
-				}
-				if err := pstore.BanNamespace(ns); err != nil {
-					return nil, errors.Wrapf(err, "Map phase failed to ban namespace: %d", ns)
-				}
-				maxBannedNs = x.Max(maxBannedNs, ns)
-			}
+		if err := applyDrops(manifest.DropOperations); err != nil {
+			return nil, err
 		}
 		glog.Infof("[MAP] Processed manifest num: %v", manifest.BackupNum)
 	} // done with all the manifests.
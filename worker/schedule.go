@@ -0,0 +1,150 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package worker
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/golang/glog"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/hypermodeinc/dgraph/v25/protos/pb"
+	"github.com/hypermodeinc/dgraph/v25/x"
+)
+
+// scheduler queues the scheduled backups and exports, as tasks of the leader of group 1.
+type scheduler struct {
+	// runs are the latest runs of the jobs by this alpha, and queued the latest ones which
+	// queued a task. The history in the membership state lags behind them, until Zero streams the
+	// recorded runs back.
+	runs, queued map[string]*pb.JobRun
+}
+
+func newScheduler() *scheduler {
+	return &scheduler{runs: make(map[string]*pb.JobRun), queued: make(map[string]*pb.JobRun)}
+}
+
+// processSchedules checks the schedules of the jobs every few seconds, while this alpha is the
+// leader of group 1.
+func (n *node) processSchedules() {
+	defer n.closer.Done() // CLOSER:1
+	if n.gid != 1 {
+		return
+	}
+	s := newScheduler()
+
+	tick := time.NewTicker(10 * time.Second)
+	defer tick.Stop()
+	for {
+		select {
+		case <-n.closer.HasBeenClosed():
+			return
+		case now := <-tick.C:
+			if !n.AmLeader() {
+				continue
+			}
+			s.round(n.ctx, GetMembershipState().GetSchedules(), now)
+		}
+	}
+}
+
+// round runs the jobs scheduled at the minute of now, which haven't been run for it yet.
+func (s *scheduler) round(ctx context.Context, schedules map[string]*pb.JobSchedule,
+	now time.Time) {
+	minute := now.UTC().Truncate(time.Minute)
+	for name, js := range schedules {
+		cron, err := x.ParseCron(js.Cron)
+		if err != nil {
+			glog.Errorf("Invalid schedule %q: %v", name, err)
+			continue
+		}
+		if !cron.Matches(minute) {
+			continue
+		}
+		last, queued := s.lastRuns(js)
+		if last.GetTime() >= minute.Unix() {
+			continue
+		}
+
+		run := s.run(ctx, js, queued, minute)
+		s.runs[name] = run
+		if run.TaskId != 0 {
+			s.queued[name] = run
+		}
+		if run.Error != "" {
+			glog.Warningf("Scheduled job %q wasn't run: %s", name, run.Error)
+		} else {
+			glog.Infof("Scheduled job %q queued with ID %#x", name, run.TaskId)
+		}
+		if err := recordJobRunOverNetwork(ctx, run); err != nil {
+			glog.Errorf("While recording the run of scheduled job %q: %v", name, err)
+		}
+	}
+}
+
+// lastRuns returns the latest run of the job, and the latest one which queued a task, by this
+// alpha or by the previous leaders.
+func (s *scheduler) lastRuns(js *pb.JobSchedule) (last, queued *pb.JobRun) {
+	last, queued = s.runs[js.Name], s.queued[js.Name]
+	for i := len(js.History) - 1; i >= 0; i-- {
+		run := js.History[i]
+		if i == len(js.History)-1 && run.Time > last.GetTime() {
+			last = run
+		}
+		if run.TaskId != 0 {
+			if run.Time > queued.GetTime() {
+				queued = run
+			}
+			break
+		}
+	}
+	return last, queued
+}
+
+// run queues a run of the job, unless the task queued by its last run is still queued or
+// running.
+func (s *scheduler) run(ctx context.Context, js *pb.JobSchedule, queued *pb.JobRun,
+	minute time.Time) *pb.JobRun {
+	run := &pb.JobRun{Name: js.Name, Time: minute.Unix()}
+	if id := queued.GetTaskId(); id != 0 {
+		// If the status of the last run can't be found, its alpha is gone, and so is the task.
+		resp, err := TaskStatusOverNetwork(ctx, &pb.TaskStatusRequest{TaskId: id})
+		if err == nil {
+			status := TaskMeta(resp.GetTaskMeta()).Status()
+			if status == TaskStatusQueued || status == TaskStatusRunning {
+				run.Error = fmt.Sprintf("skipped, the previous run %#x is still %s", id,
+					strings.ToLower(status.String()))
+				return run
+			}
+		}
+	}
+	if Tasks == nil {
+		run.Error = "task queue hasn't been initialized yet"
+		return run
+	}
+
+	// The request is filled in while the task runs, so every run gets its own copy.
+	var req interface{}
+	switch {
+	case js.Backup != nil:
+		req = proto.Clone(js.Backup).(*pb.BackupRequest)
+	case js.Export != nil:
+		req = proto.Clone(js.Export).(*pb.ExportRequest)
+	default:
+		run.Error = "neither a backup nor an export is scheduled"
+		return run
+	}
+	id, err := Tasks.enqueue(req)
+	if err != nil {
+		run.Error = err.Error()
+		return run
+	}
+	run.TaskId = id
+	return run
+}
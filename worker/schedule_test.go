@@ -0,0 +1,130 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package worker
+
+import (
+	"context"
+	"math/rand"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/dgraph-io/ristretto/v2/z"
+	"github.com/hypermodeinc/dgraph/v25/protos/pb"
+	"github.com/hypermodeinc/dgraph/v25/raftwal"
+)
+
+// setTestTasks sets up a task queue whose tasks are never run.
+func setTestTasks(t *testing.T) {
+	queue, wal := Tasks, State.WALstore
+	Tasks = &tasks{
+		queue: make(chan taskRequest, 16),
+		log:   z.NewTree("Worker.TestTasks"),
+		logMu: new(sync.Mutex),
+		rng:   rand.New(rand.NewSource(time.Now().UnixNano())),
+	}
+	State.WALstore = raftwal.Init(t.TempDir())
+	t.Cleanup(func() {
+		require.NoError(t, Tasks.log.Close())
+		require.NoError(t, State.WALstore.Close())
+		Tasks, State.WALstore = queue, wal
+	})
+}
+
+func setTaskStatus(id uint64, status TaskStatus) {
+	Tasks.logMu.Lock()
+	defer Tasks.logMu.Unlock()
+	Tasks.log.Set(id, newTaskMeta(TaskKindBackup, status).uint64())
+}
+
+func TestSchedulerRound(t *testing.T) {
+	setTestTasks(t)
+	ctx := context.Background()
+	minute := time.Date(2024, 5, 15, 12, 0, 0, 0, time.UTC)
+	schedules := map[string]*pb.JobSchedule{
+		"backup": {Name: "backup", Cron: "* * * * *", Backup: &pb.BackupRequest{}},
+		"hourly": {Name: "hourly", Cron: "@hourly", Export: &pb.ExportRequest{}},
+	}
+
+	s := newScheduler()
+	s.round(ctx, schedules, minute.Add(5*time.Second))
+	require.Len(t, Tasks.queue, 2)
+	first := s.runs["backup"]
+	require.NotZero(t, first.TaskId)
+	require.Equal(t, minute.Unix(), first.Time)
+
+	// A minute is only run once.
+	setTaskStatus(first.TaskId, TaskStatusSuccess)
+	setTaskStatus(s.runs["hourly"].TaskId, TaskStatusSuccess)
+	s.round(ctx, schedules, minute.Add(45*time.Second))
+	require.Len(t, Tasks.queue, 2)
+	require.Equal(t, first, s.runs["backup"])
+
+	// The hourly job doesn't match the next minute, and the backup is run again.
+	s.round(ctx, schedules, minute.Add(time.Minute))
+	require.Len(t, Tasks.queue, 3)
+	second := s.runs["backup"]
+	require.NotEqual(t, first.TaskId, second.TaskId)
+
+	// The run is skipped while the previous one is still queued or running.
+	s.round(ctx, schedules, minute.Add(2*time.Minute))
+	require.Len(t, Tasks.queue, 3)
+	require.Zero(t, s.runs["backup"].TaskId)
+	require.Contains(t, s.runs["backup"].Error, "is still queued")
+	setTaskStatus(second.TaskId, TaskStatusRunning)
+	s.round(ctx, schedules, minute.Add(3*time.Minute))
+	require.Len(t, Tasks.queue, 3)
+	require.Contains(t, s.runs["backup"].Error, "is still running")
+	setTaskStatus(second.TaskId, TaskStatusFailed)
+	s.round(ctx, schedules, minute.Add(4*time.Minute))
+	require.Len(t, Tasks.queue, 4)
+	require.NotZero(t, s.runs["backup"].TaskId)
+}
+
+func TestSchedulerLeaderChange(t *testing.T) {
+	setTestTasks(t)
+	ctx := context.Background()
+	minute := time.Date(2024, 5, 15, 12, 0, 0, 0, time.UTC)
+
+	// The previous leader ran the minute, and its run is in the history.
+	prev := newScheduler()
+	js := &pb.JobSchedule{Name: "backup", Cron: "* * * * *", Backup: &pb.BackupRequest{}}
+	prev.round(ctx, map[string]*pb.JobSchedule{"backup": js}, minute)
+	run := prev.runs["backup"]
+	require.Len(t, Tasks.queue, 1)
+	setTaskStatus(run.TaskId, TaskStatusSuccess)
+
+	js = proto.Clone(js).(*pb.JobSchedule)
+	js.History = []*pb.JobRun{run}
+	schedules := map[string]*pb.JobSchedule{"backup": js}
+	s := newScheduler()
+	s.round(ctx, schedules, minute.Add(30*time.Second))
+	require.Len(t, Tasks.queue, 1)
+	require.Nil(t, s.runs["backup"])
+
+	// The new leader goes on with the next minute.
+	s.round(ctx, schedules, minute.Add(time.Minute))
+	require.Len(t, Tasks.queue, 2)
+	require.Equal(t, minute.Add(time.Minute).Unix(), s.runs["backup"].Time)
+
+	// The status of the previous run is checked even if it was queued by another leader.
+	setTaskStatus(s.runs["backup"].TaskId, TaskStatusQueued)
+	js.History = append(js.History, s.runs["backup"])
+	s = newScheduler()
+	s.round(ctx, schedules, minute.Add(2*time.Minute))
+	require.Len(t, Tasks.queue, 2)
+	require.Contains(t, s.runs["backup"].Error, "is still queued")
+
+	// And so is the status of a run queued before the skipped ones.
+	js.History = append(js.History, s.runs["backup"])
+	s = newScheduler()
+	s.round(ctx, schedules, minute.Add(3*time.Minute))
+	require.Len(t, Tasks.queue, 2)
+	require.Contains(t, s.runs["backup"].Error, "is still queued")
+}
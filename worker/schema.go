@@ -7,6 +7,7 @@ package worker
 
 import (
 	"context"
+	"time"
 
 	"github.com/pkg/errors"
 	"go.opentelemetry.io/otel"
@@ -46,7 +47,8 @@ func getSchema(ctx context.Context, s *pb.SchemaRequest) (*pb.SchemaResult, erro
 		fields = s.Fields
 	} else {
 		fields = []string{"type", "index", "tokenizer", "reverse", "count", "list", "upsert", "unique",
-			"lang", "noconflict", "vector_specs"}
+			"lang", "noconflict", "vector_specs", "ttl",
+			"history", "encrypted"}
 	}
 
 	myGid := groups().groupId()
@@ -110,6 +112,16 @@ func populateSchema(attr string, fields []string) *pb.SchemaNode {
 			schemaNode.NoConflict = pred.GetNoConflict()
 		case "vector_specs":
 			schemaNode.IndexSpecs = pred.GetIndexSpecs()
+		case "ttl":
+			if ttl := pred.GetTtl(); ttl > 0 {
+				schemaNode.Ttl = (time.Duration(ttl) * time.Second).String()
+			}
+		case "history":
+			if retain := pred.GetHistoryRetain(); retain > 0 {
+				schemaNode.History = (time.Duration(retain) * time.Second).String()
+			}
+		case "encrypted":
+			schemaNode.Encrypted = pred.GetEncryptionKey()
 		default:
 			//pass
 		}
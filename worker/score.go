@@ -0,0 +1,166 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package worker
+
+import (
+	"context"
+
+	"github.com/dgraph-io/badger/v4"
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/hypermodeinc/dgraph/v25/posting"
+	"github.com/hypermodeinc/dgraph/v25/protos/pb"
+	"github.com/hypermodeinc/dgraph/v25/tok"
+	"github.com/hypermodeinc/dgraph/v25/types"
+	"github.com/hypermodeinc/dgraph/v25/x"
+)
+
+// fullTextStats holds the corpus statistics that BM25 needs for a predicate.
+type fullTextStats struct {
+	docs     uint64
+	totalLen uint64
+}
+
+func (s fullTextStats) avgLen() float64 {
+	if s.docs == 0 {
+		return 0
+	}
+	return float64(s.totalLen) / float64(s.docs)
+}
+
+// readFullTextStats adds up the document length tokens in the fulltext index of attr.
+func (qs *queryState) readFullTextStats(attr string, readTs uint64) (fullTextStats, error) {
+	var stats fullTextStats
+
+	txn := pstore.NewTransactionAt(readTs, false)
+	defer txn.Discard()
+
+	itOpt := badger.DefaultIteratorOptions
+	itOpt.PrefetchValues = false
+	itOpt.Prefix = x.IndexKey(attr, tok.FullTextLengthPrefix())
+	it := txn.NewIterator(itOpt)
+	defer it.Close()
+
+	for it.Rewind(); it.Valid(); it.Next() {
+		pk, err := x.Parse(it.Item().Key())
+		if err != nil {
+			return stats, err
+		}
+		if pk.HasStartUid {
+			continue
+		}
+		n, ok := tok.ParseFullTextLengthToken(pk.Term)
+		if !ok {
+			continue
+		}
+		pl, err := qs.cache.GetUids(it.Item().KeyCopy(nil))
+		if err != nil {
+			return stats, err
+		}
+		docs := pl.Length(readTs, 0)
+		if docs == -1 {
+			return stats, errors.Wrapf(posting.ErrTsTooOld, "While reading posting list length")
+		}
+		stats.docs += uint64(docs)
+		stats.totalLen += uint64(docs) * uint64(n)
+	}
+	return stats, nil
+}
+
+// handleScoreFunction ranks the values of the uids in q.UidList against the text given to
+// score() using BM25. Term frequencies and document lengths come from the values themselves,
+// the document frequencies and the average document length from the fulltext index.
+func (qs *queryState) handleScoreFunction(ctx context.Context, arg funcArgs) error {
+	span := trace.SpanFromContext(ctx)
+	stop := x.SpanTimer(span, "handleScoreFunction")
+	defer stop()
+
+	q := arg.q
+	lang := langForFunc(q.Langs)
+	stats, err := qs.readFullTextStats(q.Attr, q.ReadTs)
+	if err != nil {
+		return err
+	}
+	avgLen := stats.avgLen()
+
+	idf := make(map[string]float64)
+	for _, term := range tok.FullTextTerms(q.SrcFunc.Args[0], lang) {
+		if _, ok := idf[term]; ok {
+			continue
+		}
+		pl, err := qs.cache.GetUids(x.IndexKey(q.Attr, tok.FullTextTermToken(term)))
+		if err != nil {
+			return err
+		}
+		df := pl.Length(q.ReadTs, 0)
+		if df == -1 {
+			return errors.Wrapf(posting.ErrTsTooOld, "While reading posting list length")
+		}
+		// Every document in the index has a length token, unless the index was built before
+		// they were added. The scores would be meaningless without them.
+		if uint64(df) > stats.docs {
+			return errors.Errorf("The fulltext index of %s was built without document lengths, "+
+				"which score() needs. Rebuild it by removing the fulltext index from the schema "+
+				"and adding it back", x.ParseAttr(q.Attr))
+		}
+		idf[term] = tok.BM25IDF(stats.docs, uint64(df))
+	}
+	span.AddEvent("BM25 stats", trace.WithAttributes(
+		attribute.Int64("docs", int64(stats.docs)),
+		attribute.Float64("avg_len", avgLen),
+		attribute.Int("terms", len(idf))))
+
+	for i, uid := range q.UidList.Uids {
+		if i%100 == 0 {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			default:
+			}
+		}
+		vl := &pb.ValueList{}
+		arg.out.ValueMatrix = append(arg.out.ValueMatrix, vl)
+		// Add an empty UID list to make later processing consistent.
+		arg.out.UidMatrix = append(arg.out.UidMatrix, &pb.List{})
+
+		vals, err := qs.getValsForUID(q.Attr, lang, uid, q.ReadTs)
+		switch {
+		case err == posting.ErrNoValue:
+			continue
+		case err != nil:
+			return err
+		}
+
+		var score float64
+		for _, val := range vals {
+			sv, err := types.Convert(val, types.StringID)
+			if err != nil {
+				continue
+			}
+			terms := tok.FullTextTerms(sv.Value.(string), lang)
+			tf := make(map[string]int)
+			for _, term := range terms {
+				if _, ok := idf[term]; ok {
+					tf[term]++
+				}
+			}
+			var s float64
+			for term, n := range tf {
+				s += tok.BM25(n, len(terms), avgLen, idf[term])
+			}
+			score = max(score, s)
+		}
+		data := types.ValueForType(types.BinaryID)
+		if err := types.Marshal(types.Val{Tid: types.FloatID, Value: score}, &data); err != nil {
+			return err
+		}
+		vl.Values = append(vl.Values,
+			&pb.TaskValue{ValType: types.FloatID.Enum(), Val: data.Value.([]byte)})
+	}
+	return nil
+}
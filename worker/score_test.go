@@ -0,0 +1,112 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package worker
+
+import (
+	"context"
+	"os"
+	"sort"
+	"testing"
+
+	"github.com/dgraph-io/badger/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/hypermodeinc/dgraph/v25/posting"
+	"github.com/hypermodeinc/dgraph/v25/protos/pb"
+	"github.com/hypermodeinc/dgraph/v25/schema"
+	"github.com/hypermodeinc/dgraph/v25/tok"
+	"github.com/hypermodeinc/dgraph/v25/types"
+	"github.com/hypermodeinc/dgraph/v25/x"
+)
+
+func TestScoreFunction(t *testing.T) {
+	dir, err := os.MkdirTemp("", "storetest_")
+	x.Check(err)
+	defer os.RemoveAll(dir)
+
+	opt := badger.DefaultOptions(dir)
+	ps, err := badger.OpenManaged(opt)
+	x.Check(err)
+	pstore = ps
+	posting.Init(ps, 0, false)
+	Init(ps)
+	require.NoError(t, schema.ParseBytes([]byte("bm25Text: string @index(fulltext) ."), 1))
+
+	ctx := context.Background()
+	attr := x.AttrInRootNamespace("bm25Text")
+	docs := []string{
+		"the quick brown fox jumps over the lazy dog",
+		"fox fox fox",
+		"a lazy afternoon in the sun with a good book and some tea",
+		"brown bread and butter on a plate for breakfast",
+	}
+	for i, doc := range docs {
+		txn := posting.Oracle().RegisterStartTs(uint64(10 + 2*i))
+		require.NoError(t, runMutation(ctx, &pb.DirectedEdge{
+			Value:     []byte(doc),
+			ValueType: pb.Posting_STRING,
+			Attr:      attr,
+			Entity:    uint64(i + 1),
+			Op:        pb.DirectedEdge_SET,
+		}, txn))
+		txn.Update()
+		writer := posting.NewTxnWriter(pstore)
+		require.NoError(t, txn.CommitToDisk(writer, uint64(11+2*i)))
+		require.NoError(t, writer.Flush())
+		txn.UpdateCachedKeys(uint64(11 + 2*i))
+	}
+
+	qs := queryState{cache: posting.NewLocalCache(30)}
+	stats, err := qs.readFullTextStats(attr, 30)
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), stats.docs)
+
+	out, err := qs.helpProcessTask(ctx, &pb.Query{
+		Attr:    attr,
+		ReadTs:  30,
+		SrcFunc: &pb.SrcFunction{Name: "score", Args: []string{"brown fox"}},
+		UidList: &pb.List{Uids: []uint64{1, 2, 3, 4, 5}},
+	}, 1)
+	require.NoError(t, err)
+	require.Len(t, out.ValueMatrix, 5)
+
+	scores := make([]float64, 0, 4)
+	for _, vl := range out.ValueMatrix[:4] {
+		require.Len(t, vl.Values, 1)
+		v, err := types.Convert(types.Val{Tid: types.BinaryID, Value: vl.Values[0].Val},
+			types.FloatID)
+		require.NoError(t, err)
+		scores = append(scores, v.Value.(float64))
+	}
+	// No value, no score.
+	require.Empty(t, out.ValueMatrix[4].Values)
+
+	// The document without any of the terms doesn't score.
+	require.Zero(t, scores[2])
+	// Matching both terms beats matching one.
+	require.Greater(t, scores[0], scores[1])
+	require.Greater(t, scores[0], scores[3])
+	// Repeating a term in a short document beats a single mention in a longer one.
+	require.Greater(t, scores[1], scores[3])
+
+	// Ranked by score, the documents matching both terms come first.
+	ranked := []uint64{1, 2, 3, 4}
+	sort.SliceStable(ranked, func(i, j int) bool {
+		return scores[ranked[i]-1] > scores[ranked[j]-1]
+	})
+	require.Equal(t, []uint64{1, 2, 4, 3}, ranked)
+
+	// An index built before the document lengths were added can't be scored.
+	require.NoError(t, pstore.DropPrefix(x.IndexKey(attr, tok.FullTextLengthPrefix())))
+	qs = queryState{cache: posting.NewLocalCache(30)}
+	_, err = qs.helpProcessTask(ctx, &pb.Query{
+		Attr:    attr,
+		ReadTs:  30,
+		SrcFunc: &pb.SrcFunction{Name: "score", Args: []string{"brown fox"}},
+		UidList: &pb.List{Uids: []uint64{1, 2, 3, 4}},
+	}, 1)
+	require.ErrorContains(t, err, "built without document lengths")
+}
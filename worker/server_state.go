@@ -15,6 +15,7 @@ import (
 
 	"github.com/dgraph-io/badger/v4"
 	"github.com/dgraph-io/ristretto/v2/z"
+	"github.com/hypermodeinc/dgraph/v25/enc"
 	"github.com/hypermodeinc/dgraph/v25/protos/pb"
 	"github.com/hypermodeinc/dgraph/v25/raftwal"
 	"github.com/hypermodeinc/dgraph/v25/x"
@@ -28,14 +29,17 @@ const (
 	//       For easy readability, keep the options without default values (if any) at the end of
 	//       the *Defaults string. Also, since these strings are printed in --help text, avoid line
 	//       breaks.
-	AuditDefaults  = `compress=false; days=10; size=100; dir=; output=; encrypt-file=;`
+	AuditDefaults = `compress=false; days=10; size=100; dir=; output=; encrypt-file=; ` +
+		`syslog=; syslog-ca-cert=; syslog-client-cert=; syslog-client-key=; otlp=; ` +
+		`otlp-headers=; buffer=10000; head-dir=;`
 	BadgerDefaults = `compression=snappy; numgoroutines=8;`
 	RaftDefaults   = `learner=false; snapshot-after-entries=10000; ` +
-		`snapshot-after-duration=30m; pending-proposals=256; idx=; group=;`
+		`snapshot-after-duration=30m; pending-proposals=256; idx=; group=; zone=;`
 	SecurityDefaults = `token=; whitelist=;`
 	CDCDefaults      = `file=; kafka=; sasl_user=; sasl_password=; ca_cert=; client_cert=; ` +
 		`client_key=; sasl-mechanism=PLAIN; tls=false;`
-	LimitDefaults = `mutations=allow; query-edge=1000000; normalize-node=10000; ` +
+	ArchiveDefaults = `frequency=1m; dest=;`
+	LimitDefaults   = `mutations=allow; query-edge=1000000; normalize-node=10000; ` +
 		`mutations-nquad=1000000; disallow-drop=false; query-timeout=0ms; txn-abort-after=5m; ` +
 		` max-retries=10;max-pending-queries=10000;shared-instance=false;type-filter-uid-limit=10`
 	ZeroLimitsDefaults = `uid-lease=0; refill-interval=30s; disable-admin-http=false;`
@@ -102,6 +106,8 @@ func (s *ServerState) InitStorage() {
 	{
 		// Write Ahead Log directory
 		x.Checkf(os.MkdirAll(Config.WALDir, 0700), "Error while creating WAL dir.")
+		x.Checkf(enc.RotateKeyRegistry(Config.WALDir, x.WorkerConfig.EncryptionKey,
+			x.WorkerConfig.EncryptionKeyRing), "Error while rotating WAL key registry.")
 		s.WALstore, err = raftwal.InitEncrypted(Config.WALDir, x.WorkerConfig.EncryptionKey)
 		x.Check(err)
 	}
@@ -110,6 +116,8 @@ func (s *ServerState) InitStorage() {
 		// All the writes to posting store should be synchronous. We use batched writers
 		// for posting lists, so the cost of sync writes is amortized.
 		x.Check(os.MkdirAll(Config.PostingDir, 0700))
+		x.Checkf(enc.RotateKeyRegistry(Config.PostingDir, x.WorkerConfig.EncryptionKey,
+			x.WorkerConfig.EncryptionKeyRing), "Error while rotating postings key registry.")
 		opt := x.WorkerConfig.Badger.
 			WithDir(Config.PostingDir).WithValueDir(Config.PostingDir).
 			WithNumVersionsToKeep(math.MaxInt32).
@@ -52,6 +52,9 @@ func SortOverNetwork(ctx context.Context, q *pb.SortMessage) (*pb.SortResult, er
 	} else if gid == 0 {
 		return &emptySortResult,
 			errors.Errorf("Cannot sort by unknown attribute %s", x.ParseAttr(q.Order[0].Attr))
+	} else if len(groups().tabletShards(q.Order[0].Attr)) > 0 {
+		return &emptySortResult, errors.Errorf("Cannot sort by attribute %s split across groups",
+			x.ParseAttr(q.Order[0].Attr))
 	}
 
 	if span := trace.SpanFromContext(ctx); span != nil {
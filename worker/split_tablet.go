@@ -0,0 +1,726 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package worker
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"fmt"
+	"maps"
+	"math"
+	"slices"
+	"strconv"
+	"sync/atomic"
+	"time"
+
+	"github.com/dgryski/go-farm"
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+	"golang.org/x/sync/errgroup"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/dgraph-io/badger/v4"
+	bpb "github.com/dgraph-io/badger/v4/pb"
+	"github.com/dgraph-io/dgo/v250/protos/api"
+	"github.com/dgraph-io/ristretto/v2/z"
+	"github.com/hypermodeinc/dgraph/v25/posting"
+	"github.com/hypermodeinc/dgraph/v25/protos/pb"
+	"github.com/hypermodeinc/dgraph/v25/schema"
+	"github.com/hypermodeinc/dgraph/v25/x"
+)
+
+// A predicate can be split across groups by the hash of the subject UIDs. Every forward posting
+// is served by the sub-tablet of its subject: the data keys of the subject, and its postings in
+// the index and count keys. The reverse postings are served by the sub-tablet of their object:
+// its reverse key, and its postings in the reverse count keys. So, the mutations of an edge
+// touch the groups serving its subject and its object. The lookups of the edges of some UIDs,
+// forward or reverse, are sent to the sub-tablets serving them, and the other queries, like
+// functions, are sent to all of them and the results merged.
+
+// shardOf returns the sub-tablet serving the uid of a predicate split into n sub-tablets.
+func shardOf(uid uint64, n int) int {
+	var buf [8]byte
+	binary.BigEndian.PutUint64(buf[:], uid)
+	return int(farm.Fingerprint64(buf[:]) % uint64(n))
+}
+
+// servedBy returns whether the tablet, or one of its sub-tablets, is served by the group.
+func servedBy(tablet *pb.Tablet, gid uint32) bool {
+	if tablet.GetGroupId() == gid {
+		return true
+	}
+	for _, shard := range tablet.GetShards() {
+		if shard == gid {
+			return true
+		}
+	}
+	return false
+}
+
+// tabletShards returns the groups serving the sub-tablets of the predicate, or nil if the
+// predicate isn't split.
+func (g *groupi) tabletShards(key string) []uint32 {
+	g.RLock()
+	defer g.RUnlock()
+	return g.tablets[key].GetShards()
+}
+
+// servesShard returns whether this group serves a sub-tablet of the predicate.
+func (g *groupi) servesShard(key string) bool {
+	g.RLock()
+	tablet := g.tablets[key]
+	g.RUnlock()
+	return len(tablet.GetShards()) > 0 && servedBy(tablet, g.groupId())
+}
+
+// checkSplittable returns an error if the predicate can't be split. The vector indexes are
+// graphs over all the vectors of the predicate.
+func checkSplittable(ctx context.Context, attr string) error {
+	su, ok := schema.State().Get(ctx, attr)
+	switch {
+	case !ok:
+		return errors.Errorf("Predicate %s has no schema", x.ParseAttr(attr))
+	case len(su.IndexSpecs) > 0:
+		return errors.Errorf("Predicate %s with a vector index can't be split", x.ParseAttr(attr))
+	}
+	return nil
+}
+
+// checkShardSchema returns an error if the schema update can't be applied to a split predicate.
+// The reverse edges would be rebuilt from the data keys of each sub-tablet, instead of being
+// sent to the sub-tablets of their objects, and a vector index can't be split.
+func checkShardSchema(ctx context.Context, update *pb.SchemaUpdate) error {
+	if len(groups().tabletShards(update.Predicate)) == 0 {
+		return nil
+	}
+	old, _ := schema.State().Get(ctx, update.Predicate)
+	reversed := old.Directive == pb.SchemaUpdate_REVERSE
+	switch {
+	case update.Directive == pb.SchemaUpdate_REVERSE && !reversed:
+		return errors.Errorf("@reverse can't be added to predicate %s split across groups",
+			x.ParseAttr(update.Predicate))
+	case update.Directive == pb.SchemaUpdate_REVERSE && update.Count && !old.Count:
+		return errors.Errorf("@count can't be added to predicate %s with @reverse split across "+
+			"groups", x.ParseAttr(update.Predicate))
+	case len(update.IndexSpecs) > 0:
+		return errors.Errorf("A vector index can't be added to predicate %s split across groups",
+			x.ParseAttr(update.Predicate))
+	}
+	return nil
+}
+
+// splitReverseEdges splits the edges of the split predicates with @reverse into their forward
+// and reverse parts, which are sent to the sub-tablets of their subjects and of their objects.
+// The reverse postings removed along with a forward edge, by deleting all the objects of the
+// subject or replacing the object of a single uid predicate, are deleted by explicit edges. The
+// objects of the subjects are read at the start ts of the transaction, and the mutations of the
+// subjects then conflict in their sub-tablets.
+func splitReverseEdges(ctx context.Context, m *pb.Mutations) error {
+	var preds []string
+	for _, edge := range m.Edges {
+		if edge.Entity != 0 && len(groups().tabletShards(edge.Attr)) > 0 {
+			preds = append(preds, edge.Attr)
+		}
+	}
+	if len(preds) == 0 {
+		return nil
+	}
+	nodes, err := GetSchemaOverNetwork(ctx, &pb.SchemaRequest{
+		Predicates: preds,
+		Fields:     []string{"reverse", "list"},
+	})
+	if err != nil {
+		return errors.Wrapf(err, "while reading the schema of split predicates")
+	}
+	reversed := make(map[string]*pb.SchemaNode)
+	for _, node := range nodes {
+		if node.Reverse {
+			reversed[node.Predicate] = node
+		}
+	}
+	if len(reversed) == 0 {
+		return nil
+	}
+
+	// The objects of the subjects that replace or delete all of them.
+	read := make(map[string][]uint64)
+	for _, edge := range m.Edges {
+		node := reversed[edge.Attr]
+		if node == nil || edge.Part != pb.DirectedEdge_ALL || edge.Entity == 0 {
+			continue
+		}
+		if isStarAll(edge.Value) || (!node.List && edge.Op != pb.DirectedEdge_DEL) {
+			read[edge.Attr] = append(read[edge.Attr], edge.Entity)
+		}
+	}
+	objects := make(map[edgeSubject]map[uint64]struct{})
+	for attr, uids := range read {
+		slices.Sort(uids)
+		uids = slices.Compact(uids)
+		res, err := ProcessTaskOverNetwork(ctx, &pb.Query{
+			Attr:    attr,
+			UidList: &pb.List{Uids: uids},
+			ReadTs:  m.StartTs,
+		})
+		if err != nil {
+			return errors.Wrapf(err, "while reading the objects of predicate %s",
+				x.ParseAttr(attr))
+		}
+		for i, uid := range uids {
+			set := make(map[uint64]struct{})
+			if i < len(res.UidMatrix) {
+				for _, obj := range res.UidMatrix[i].Uids {
+					set[obj] = struct{}{}
+				}
+			}
+			objects[edgeSubject{attr, uid}] = set
+		}
+	}
+	m.Edges = edgeParts(m.Edges, reversed, objects)
+	return nil
+}
+
+// edgeSubject is the subject of the edges of a predicate.
+type edgeSubject struct {
+	attr string
+	uid  uint64
+}
+
+// edgeParts returns the forward and reverse parts of the edges of the reversed predicates, along
+// with the deletions of the reverse postings of the objects they remove. The edges of a subject
+// are applied in order, so the parts follow the order of the edges.
+func edgeParts(in []*pb.DirectedEdge, reversed map[string]*pb.SchemaNode,
+	objects map[edgeSubject]map[uint64]struct{}) []*pb.DirectedEdge {
+	edges := make([]*pb.DirectedEdge, 0, 2*len(in))
+	for _, edge := range in {
+		node := reversed[edge.Attr]
+		if node == nil || edge.Part != pb.DirectedEdge_ALL || edge.Entity == 0 {
+			edges = append(edges, edge)
+			continue
+		}
+		part := func(p pb.DirectedEdge_Part, op pb.DirectedEdge_Op, obj uint64) {
+			e := proto.Clone(edge).(*pb.DirectedEdge)
+			e.Part, e.Op, e.ValueId = p, op, obj
+			if p == pb.DirectedEdge_REVERSE {
+				e.Value, e.ValueType = nil, pb.Posting_UID
+			}
+			edges = append(edges, e)
+		}
+		set := objects[edgeSubject{edge.Attr, edge.Entity}]
+		switch {
+		case isStarAll(edge.Value):
+			for _, obj := range slices.Sorted(maps.Keys(set)) {
+				part(pb.DirectedEdge_REVERSE, pb.DirectedEdge_DEL, obj)
+			}
+			clear(set)
+			part(pb.DirectedEdge_FORWARD, edge.Op, edge.ValueId)
+			continue
+		case edge.ValueId == 0:
+			edges = append(edges, edge)
+			continue
+		case edge.Op == pb.DirectedEdge_DEL:
+			delete(set, edge.ValueId)
+		case !node.List:
+			for _, obj := range slices.Sorted(maps.Keys(set)) {
+				if obj != edge.ValueId {
+					part(pb.DirectedEdge_REVERSE, pb.DirectedEdge_DEL, obj)
+				}
+			}
+			clear(set)
+			set[edge.ValueId] = struct{}{}
+		case set != nil:
+			// The objects of the subject are only tracked if all of them are deleted later on.
+			set[edge.ValueId] = struct{}{}
+		}
+		part(pb.DirectedEdge_FORWARD, edge.Op, edge.ValueId)
+		part(pb.DirectedEdge_REVERSE, edge.Op, edge.ValueId)
+	}
+	return edges
+}
+
+// SplitPredicate splits a predicate served by this group into sub-tablets. The data of the
+// other sub-tablets is first streamed to their groups. Once Zero has proposed the split, it is
+// called again to drop that data from this group.
+func (w *grpcWorker) SplitPredicate(ctx context.Context,
+	in *pb.SplitPredicatePayload) (*api.Payload, error) {
+	n := groups().Node
+	if !n.AmLeader() {
+		return &emptyPayload, errNotLeader
+	}
+	if err := x.HealthCheck(); err != nil {
+		return &emptyPayload, errors.Wrap(err, "Split predicate request rejected")
+	}
+	if len(in.Predicate) == 0 {
+		return &emptyPayload, errEmptyPredicate
+	}
+	if len(in.Shards) < 2 || in.Shards[0] != groups().groupId() {
+		return &emptyPayload, errors.Errorf("Group %d can't split predicate %s across groups %v",
+			groups().groupId(), in.Predicate, in.Shards)
+	}
+	if err := posting.Oracle().WaitForTs(ctx, in.TxnTs); err != nil {
+		return &emptyPayload, errors.Errorf("While waiting for txn ts: %d. Error: %v", in.TxnTs, err)
+	}
+	gid, err := groups().BelongsTo(in.Predicate)
+	switch {
+	case err != nil:
+		return &emptyPayload, err
+	case gid == 0:
+		return &emptyPayload, errNonExistentTablet
+	case gid != groups().groupId():
+		return &emptyPayload, errUnservedTablet
+	}
+
+	glog.Infof("Split predicate request: %+v", in)
+	if in.Cleanup {
+		return &emptyPayload, dropOtherShards(ctx, in)
+	}
+	if err := checkSplittable(ctx, in.Predicate); err != nil {
+		return &emptyPayload, err
+	}
+
+	closer, err := n.startTask(opPredMove)
+	if err != nil {
+		return &emptyPayload, errors.Wrapf(err, "unable to start task opPredMove")
+	}
+	defer closer.Done()
+
+	// Each sub-tablet is streamed separately, which reads the predicate once per group. That
+	// keeps the streams as simple as a predicate move.
+	for shard := 1; shard < len(in.Shards); shard++ {
+		if err := sendShard(ctx, in, shard); err != nil {
+			return &emptyPayload, errors.Wrapf(err, "while sending sub-tablet %d of predicate %s",
+				shard, in.Predicate)
+		}
+	}
+	return &emptyPayload, nil
+}
+
+// shardKeyToList returns the KeyToList function of a stream over the posting lists of the
+// predicate that keeps the postings of the sub-tablet shard. The data and reverse keys are kept
+// whole by the sub-tablet of their UID, and the other keys are filtered by the UIDs of their
+// postings. With drop set, the data and reverse keys of the sub-tablet are left out and the ones of
+// the other sub-tablets are returned empty, so that writing them out deletes the postings of the
+// other sub-tablets.
+func shardKeyToList(shard, n int, txnTs uint64,
+	drop bool) func(key []byte, itr *badger.Iterator) (*bpb.KVList, error) {
+	keep := func(uid uint64) bool {
+		return shardOf(uid, n) == shard
+	}
+	return func(key []byte, itr *badger.Iterator) (*bpb.KVList, error) {
+		pk, err := x.Parse(key)
+		if err != nil {
+			return nil, errors.Wrapf(err, "while parsing key %x", key)
+		}
+		if pk.HasStartUid {
+			// The parts of a list are read along with its main key.
+			return nil, nil
+		}
+		whole := pk.IsData() || pk.IsReverse()
+		if whole && keep(pk.Uid) == drop {
+			return nil, nil
+		}
+		l, err := posting.ReadPostingList(key, itr)
+		if err != nil {
+			return nil, err
+		}
+
+		var kvs []*bpb.KV
+		switch {
+		case whole && drop:
+			kv := posting.MarshalPostingList(&pb.PostingList{}, itr.Alloc)
+			kv.Key = itr.Alloc.Copy(key)
+			kvs = append(kvs, kv)
+		case whole:
+			if kvs, err = l.Rollup(itr.Alloc, math.MaxUint64); err != nil {
+				return nil, err
+			}
+		default:
+			kv, err := l.RollupFiltered(itr.Alloc, math.MaxUint64, keep)
+			if err != nil {
+				return nil, err
+			}
+			if len(kv.Value) == 0 && !drop {
+				return nil, nil
+			}
+			kvs = append(kvs, kv)
+		}
+		for _, kv := range kvs {
+			kv.Version = txnTs
+		}
+		return &bpb.KVList{Kv: kvs}, nil
+	}
+}
+
+// sendShard streams the postings of a sub-tablet to the leader of the group that serves it,
+// like a predicate move.
+func sendShard(ctx context.Context, in *pb.SplitPredicatePayload, shard int) error {
+	dstGid := in.Shards[shard]
+	pl := groups().Leader(dstGid)
+	if pl == nil {
+		return errors.Errorf("Unable to find a connection for group: %d\n", dstGid)
+	}
+	out, err := pb.NewWorkerClient(pl.Get()).ReceivePredicate(ctx)
+	if err != nil {
+		return errors.Wrapf(err, "while calling ReceivePredicate")
+	}
+
+	// Send schema first.
+	txn := pstore.NewTransactionAt(in.TxnTs, false)
+	defer txn.Discard()
+	item, err := txn.Get(x.SchemaKey(in.Predicate))
+	if err != nil {
+		return errors.Wrapf(err, "while reading schema of predicate %s", in.Predicate)
+	}
+	val, err := item.ValueCopy(nil)
+	if err != nil {
+		return err
+	}
+	buf := z.NewBuffer(1024, "SplitPredicate.SendShard")
+	defer func() {
+		if err := buf.Release(); err != nil {
+			glog.Warningf("error in releasing buffer: %v", err)
+		}
+	}()
+	badger.KVToBuffer(&bpb.KV{
+		Key:      x.SchemaKey(in.Predicate),
+		Value:    val,
+		Version:  in.TxnTs,
+		UserMeta: []byte{item.UserMeta()},
+	}, buf)
+	if err := out.Send(&pb.KVS{Data: buf.Bytes()}); err != nil {
+		return errors.Errorf("while sending: %v", err)
+	}
+
+	stream := pstore.NewStreamAt(in.TxnTs)
+	stream.LogPrefix = fmt.Sprintf("Sending sub-tablet %d of predicate: [%s]", shard,
+		in.Predicate)
+	stream.Prefix = x.PredicatePrefix(in.Predicate)
+	stream.KeyToList = shardKeyToList(shard, len(in.Shards), in.TxnTs, false)
+	stream.Send = func(buf *z.Buffer) error {
+		return out.Send(&pb.KVS{Data: buf.Bytes()})
+	}
+	if err := stream.Orchestrate(out.Context()); err != nil {
+		return err
+	}
+
+	payload, err := out.CloseAndRecv()
+	if err != nil {
+		return err
+	}
+	recvCount, err := strconv.Atoi(string(payload.Data))
+	if err != nil {
+		return err
+	}
+	glog.Infof("Receiver %s says it got %d keys of sub-tablet %d of predicate %s.\n",
+		pl.Addr, recvCount, shard, in.Predicate)
+	return nil
+}
+
+// dropOtherShards drops the postings of the other sub-tablets from this group, once its
+// members know that the predicate is split. The lists are rewritten whole at the txn ts, which
+// Zero leases after the split while the commits on the predicate are blocked. So, no rollup of
+// the predicate is written above them.
+func dropOtherShards(ctx context.Context, in *pb.SplitPredicatePayload) error {
+	end := time.Now().Add(10 * time.Second)
+	for atomic.LoadUint64(&groups().membershipChecksum) != in.ExpectedChecksum {
+		if time.Now().After(end) {
+			return errors.Errorf("Giving up on dropping other sub-tablets of predicate %s due "+
+				"to timeout. Wanted checksum: %d.", in.Predicate, in.ExpectedChecksum)
+		}
+		time.Sleep(100 * time.Millisecond)
+	}
+
+	n := groups().Node
+	stream := pstore.NewStreamAt(in.TxnTs)
+	stream.LogPrefix = fmt.Sprintf("Dropping other sub-tablets of predicate: [%s]", in.Predicate)
+	stream.Prefix = x.PredicatePrefix(in.Predicate)
+	stream.KeyToList = shardKeyToList(0, len(in.Shards), in.TxnTs, true)
+	stream.Send = func(buf *z.Buffer) error {
+		p := &pb.Proposal{}
+		if err := buf.SliceIterate(func(s []byte) error {
+			kv := &bpb.KV{}
+			if err := proto.Unmarshal(s, kv); err != nil {
+				return err
+			}
+			p.Kv = append(p.Kv, kv)
+			return nil
+		}); err != nil {
+			return err
+		}
+		return n.proposeAndWait(ctx, p)
+	}
+	return stream.Orchestrate(ctx)
+}
+
+// processTaskOnGroup processes the query on the group.
+func processTaskOnGroup(ctx context.Context, q *pb.Query, gid uint32) (*pb.Result, error) {
+	if groups().ServesGroup(gid) {
+		// No need for a network call, as this should be run from within this instance.
+		return processTask(ctx, q, gid)
+	}
+	result, err := processWithBackupRequest(ctx, gid,
+		func(ctx context.Context, c pb.WorkerClient) (interface{}, error) {
+			return c.ServeTask(ctx, q)
+		})
+	if err != nil {
+		return nil, err
+	}
+	return result.(*pb.Result), nil
+}
+
+// processSplitTask processes the query on a predicate split across the groups shards.
+func processSplitTask(ctx context.Context, q *pb.Query, shards []uint32) (*pb.Result, error) {
+	if q.SrcFunc == nil && len(q.GetUidList().GetUids()) > 0 {
+		return processShardedUids(ctx, q, shards)
+	}
+
+	// Every sub-tablet returns its first results, and the results are paginated once merged.
+	sq := q
+	if q.Offset != 0 {
+		sq = proto.Clone(q).(*pb.Query)
+		if q.First > 0 {
+			sq.First = q.First + q.Offset
+		}
+		sq.Offset = 0
+	}
+	results := make([]*pb.Result, len(shards))
+	g, gCtx := errgroup.WithContext(ctx)
+	for i, gid := range shards {
+		g.Go(func() error {
+			var err error
+			results[i], err = processTaskOnGroup(gCtx, sq, gid)
+			return err
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+
+	out := mergeShardResults(results)
+	if q.First != 0 || q.Offset != 0 {
+		// The has function skips the offset itself, the others leave it to the caller.
+		offset, first := 0, int(sq.First)
+		if q.SrcFunc.GetName() == "has" {
+			offset, first = int(q.Offset), int(q.First)
+		}
+		for i := range out.UidMatrix {
+			var facets *pb.FacetsList
+			if i < len(out.FacetMatrix) {
+				facets = out.FacetMatrix[i]
+			}
+			paginateShardList(out.UidMatrix[i], facets, offset, first)
+		}
+	}
+	return out, nil
+}
+
+// processShardedUids looks up the edges of the UIDs of the query on the sub-tablets serving
+// them, and puts the results back in the order of the UIDs.
+func processShardedUids(ctx context.Context, q *pb.Query, shards []uint32) (*pb.Result, error) {
+	uids := q.UidList.Uids
+	pos := make([][]int, len(shards))
+	for i, uid := range uids {
+		shard := shardOf(uid, len(shards))
+		pos[shard] = append(pos[shard], i)
+	}
+
+	results := make([]*pb.Result, len(shards))
+	g, gCtx := errgroup.WithContext(ctx)
+	for i, gid := range shards {
+		if len(pos[i]) == 0 {
+			continue
+		}
+		sq := proto.Clone(q).(*pb.Query)
+		sq.UidList = &pb.List{Uids: make([]uint64, 0, len(pos[i]))}
+		for _, p := range pos[i] {
+			sq.UidList.Uids = append(sq.UidList.Uids, uids[p])
+		}
+		g.Go(func() error {
+			var err error
+			results[i], err = processTaskOnGroup(gCtx, sq, gid)
+			return err
+		})
+	}
+	if err := g.Wait(); err != nil {
+		return nil, err
+	}
+
+	out := &pb.Result{}
+	for i, r := range results {
+		if r == nil {
+			continue
+		}
+		mergeResultFlags(out, r)
+		for _, l := range []int{len(r.UidMatrix), len(r.ValueMatrix), len(r.Counts),
+			len(r.FacetMatrix), len(r.LangMatrix)} {
+			if l != 0 && l != len(pos[i]) {
+				return nil, errors.Errorf("Got %d results for %d UIDs from group %d", l,
+					len(pos[i]), shards[i])
+			}
+		}
+		for j, p := range pos[i] {
+			if len(r.UidMatrix) > 0 {
+				out.UidMatrix = growTo(out.UidMatrix, len(uids), func() *pb.List {
+					return &pb.List{}
+				})
+				out.UidMatrix[p] = r.UidMatrix[j]
+			}
+			if len(r.ValueMatrix) > 0 {
+				out.ValueMatrix = growTo(out.ValueMatrix, len(uids), func() *pb.ValueList {
+					return &pb.ValueList{}
+				})
+				out.ValueMatrix[p] = r.ValueMatrix[j]
+			}
+			if len(r.Counts) > 0 {
+				out.Counts = growTo(out.Counts, len(uids), func() uint32 { return 0 })
+				out.Counts[p] = r.Counts[j]
+			}
+			if len(r.FacetMatrix) > 0 {
+				out.FacetMatrix = growTo(out.FacetMatrix, len(uids), func() *pb.FacetsList {
+					return &pb.FacetsList{}
+				})
+				out.FacetMatrix[p] = r.FacetMatrix[j]
+			}
+			if len(r.LangMatrix) > 0 {
+				out.LangMatrix = growTo(out.LangMatrix, len(uids), func() *pb.LangList {
+					return &pb.LangList{}
+				})
+				out.LangMatrix[p] = r.LangMatrix[j]
+			}
+		}
+	}
+	return out, nil
+}
+
+func growTo[T any](s []T, n int, empty func() T) []T {
+	for len(s) < n {
+		s = append(s, empty())
+	}
+	return s
+}
+
+func mergeResultFlags(out, r *pb.Result) {
+	out.IntersectDest = out.IntersectDest || r.IntersectDest
+	out.List = out.List || r.List
+	for k, v := range r.VectorMetrics {
+		if out.VectorMetrics == nil {
+			out.VectorMetrics = make(map[string]uint64)
+		}
+		out.VectorMetrics[k] += v
+	}
+}
+
+func isEmptyValueList(l *pb.ValueList) bool {
+	for _, v := range l.GetValues() {
+		if len(v.GetVal()) > 0 && !bytes.Equal(v.GetVal(), x.Nilbyte) {
+			return false
+		}
+	}
+	return true
+}
+
+// mergeShardResults merges the results of the same query on every sub-tablet. The sub-tablets
+// serve different subjects, so the UID lists are merged, the counts are added up, and the
+// values are taken from the sub-tablet that has them.
+func mergeShardResults(results []*pb.Result) *pb.Result {
+	out := &pb.Result{}
+	for _, r := range results {
+		mergeResultFlags(out, r)
+		hasFacets := len(r.FacetMatrix) > 0 || len(out.FacetMatrix) > 0
+		for i, l := range r.UidMatrix {
+			out.UidMatrix = growTo(out.UidMatrix, i+1, func() *pb.List { return &pb.List{} })
+			var facets *pb.FacetsList
+			if i < len(r.FacetMatrix) {
+				facets = r.FacetMatrix[i]
+			}
+			if hasFacets {
+				out.FacetMatrix = growTo(out.FacetMatrix, len(out.UidMatrix),
+					func() *pb.FacetsList { return &pb.FacetsList{} })
+			}
+			var outFacets *pb.FacetsList
+			if hasFacets {
+				outFacets = out.FacetMatrix[i]
+			}
+			mergeShardList(out.UidMatrix[i], outFacets, l, facets)
+		}
+		for i, l := range r.ValueMatrix {
+			out.ValueMatrix = growTo(out.ValueMatrix, i+1, func() *pb.ValueList {
+				return &pb.ValueList{}
+			})
+			if isEmptyValueList(out.ValueMatrix[i]) && !isEmptyValueList(l) {
+				out.ValueMatrix[i] = l
+			}
+		}
+		for i, c := range r.Counts {
+			out.Counts = growTo(out.Counts, i+1, func() uint32 { return 0 })
+			out.Counts[i] += c
+		}
+		for i, l := range r.LangMatrix {
+			out.LangMatrix = growTo(out.LangMatrix, i+1, func() *pb.LangList {
+				return &pb.LangList{}
+			})
+			if len(out.LangMatrix[i].GetLang()) == 0 {
+				out.LangMatrix[i] = l
+			}
+		}
+	}
+	return out
+}
+
+// mergeShardList merges the sorted UIDs of b, and their facets if any, into a.
+func mergeShardList(a *pb.List, af *pb.FacetsList, b *pb.List, bf *pb.FacetsList) {
+	facet := func(l *pb.FacetsList, i int) *pb.Facets {
+		if i < len(l.GetFacetsList()) {
+			return l.FacetsList[i]
+		}
+		return &pb.Facets{}
+	}
+	uids := make([]uint64, 0, len(a.Uids)+len(b.Uids))
+	var facets []*pb.Facets
+	i, j := 0, 0
+	for i < len(a.Uids) || j < len(b.Uids) {
+		switch {
+		case j == len(b.Uids) || (i < len(a.Uids) && a.Uids[i] < b.Uids[j]):
+			uids = append(uids, a.Uids[i])
+			facets = append(facets, facet(af, i))
+			i++
+		case i == len(a.Uids) || b.Uids[j] < a.Uids[i]:
+			uids = append(uids, b.Uids[j])
+			facets = append(facets, facet(bf, j))
+			j++
+		default:
+			uids = append(uids, a.Uids[i])
+			facets = append(facets, facet(af, i))
+			i++
+			j++
+		}
+	}
+	a.Uids = uids
+	if af != nil {
+		af.FacetsList = facets
+	}
+}
+
+// paginateShardList skips offset UIDs of the list and keeps first of them, or the last -first
+// of them if first is negative.
+func paginateShardList(l *pb.List, facets *pb.FacetsList, offset, first int) {
+	n := len(l.Uids)
+	start, end := offset, n
+	switch {
+	case first > 0 && start+first < end:
+		end = start + first
+	case first < 0 && end+first > start:
+		start = end + first
+	}
+	if start > end {
+		start = end
+	}
+	l.Uids = l.Uids[start:end]
+	if facets != nil && len(facets.FacetsList) == n {
+		facets.FacetsList = facets.FacetsList[start:end]
+	}
+}
@@ -0,0 +1,128 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package worker
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hypermodeinc/dgraph/v25/protos/pb"
+	"github.com/hypermodeinc/dgraph/v25/x"
+)
+
+func TestShardOf(t *testing.T) {
+	counts := make([]int, 3)
+	for uid := uint64(1); uid <= 3000; uid++ {
+		shard := shardOf(uid, 3)
+		require.Equal(t, shard, shardOf(uid, 3))
+		counts[shard]++
+	}
+	for _, c := range counts {
+		require.Greater(t, c, 800)
+	}
+}
+
+func TestServedBy(t *testing.T) {
+	require.True(t, servedBy(&pb.Tablet{GroupId: 1}, 1))
+	require.False(t, servedBy(&pb.Tablet{GroupId: 1}, 2))
+	require.True(t, servedBy(&pb.Tablet{GroupId: 1, Shards: []uint32{1, 2, 3}}, 3))
+	require.False(t, servedBy(&pb.Tablet{GroupId: 1, Shards: []uint32{1, 2, 3}}, 4))
+}
+
+func TestMergeShardResults(t *testing.T) {
+	out := mergeShardResults([]*pb.Result{
+		{
+			UidMatrix: []*pb.List{{Uids: []uint64{1, 4, 9}}, {Uids: []uint64{2}}},
+			Counts:    []uint32{3, 1},
+		},
+		{
+			UidMatrix: []*pb.List{{Uids: []uint64{2, 4, 7}}, {}},
+			Counts:    []uint32{3, 0},
+		},
+	})
+	require.Equal(t, []uint64{1, 2, 4, 7, 9}, out.UidMatrix[0].Uids)
+	require.Equal(t, []uint64{2}, out.UidMatrix[1].Uids)
+	require.Equal(t, []uint32{6, 1}, out.Counts)
+
+	out = mergeShardResults([]*pb.Result{
+		{ValueMatrix: []*pb.ValueList{{Values: []*pb.TaskValue{{}}}}},
+		{ValueMatrix: []*pb.ValueList{{Values: []*pb.TaskValue{{Val: []byte("a")}}}}},
+	})
+	require.Equal(t, []byte("a"), out.ValueMatrix[0].Values[0].Val)
+}
+
+func TestPaginateShardList(t *testing.T) {
+	for _, tc := range []struct {
+		offset, first int
+		want          []uint64
+	}{
+		{0, 2, []uint64{1, 2}},
+		{1, 2, []uint64{2, 3}},
+		{0, -2, []uint64{4, 5}},
+		{3, 0, []uint64{4, 5}},
+		{6, 2, []uint64{}},
+	} {
+		l := &pb.List{Uids: []uint64{1, 2, 3, 4, 5}}
+		paginateShardList(l, nil, tc.offset, tc.first)
+		require.Equal(t, tc.want, l.Uids)
+	}
+}
+
+func TestEdgeParts(t *testing.T) {
+	type part struct {
+		part    pb.DirectedEdge_Part
+		op      pb.DirectedEdge_Op
+		entity  uint64
+		valueId uint64
+	}
+	parts := func(edges []*pb.DirectedEdge) []part {
+		var out []part
+		for _, e := range edges {
+			out = append(out, part{e.Part, e.Op, e.Entity, e.ValueId})
+		}
+		return out
+	}
+	set := func(uids ...uint64) map[uint64]struct{} {
+		m := make(map[uint64]struct{})
+		for _, uid := range uids {
+			m[uid] = struct{}{}
+		}
+		return m
+	}
+	reversed := map[string]*pb.SchemaNode{
+		"0-friend": {Predicate: "0-friend", Reverse: true, List: true},
+		"0-boss":   {Predicate: "0-boss", Reverse: true},
+	}
+	objects := map[edgeSubject]map[uint64]struct{}{
+		{"0-friend", 1}: set(5, 6),
+		{"0-boss", 1}:   set(7),
+	}
+	edges := edgeParts([]*pb.DirectedEdge{
+		{Attr: "0-name", Entity: 1, Value: []byte("a")},
+		{Attr: "0-friend", Entity: 1, ValueId: 8},
+		{Attr: "0-friend", Entity: 1, Op: pb.DirectedEdge_DEL, Value: []byte(x.Star)},
+		{Attr: "0-boss", Entity: 1, ValueId: 9},
+	}, reversed, objects)
+	require.Equal(t, []part{
+		// The predicates without @reverse are left as they are.
+		{pb.DirectedEdge_ALL, pb.DirectedEdge_SET, 1, 0},
+		// The parts of an edge are sent to the sub-tablets of its subject and of its object.
+		{pb.DirectedEdge_FORWARD, pb.DirectedEdge_SET, 1, 8},
+		{pb.DirectedEdge_REVERSE, pb.DirectedEdge_SET, 1, 8},
+		// Deleting all the objects deletes their reverse edges, the one set above too.
+		{pb.DirectedEdge_REVERSE, pb.DirectedEdge_DEL, 1, 5},
+		{pb.DirectedEdge_REVERSE, pb.DirectedEdge_DEL, 1, 6},
+		{pb.DirectedEdge_REVERSE, pb.DirectedEdge_DEL, 1, 8},
+		{pb.DirectedEdge_FORWARD, pb.DirectedEdge_DEL, 1, 0},
+		// Replacing the object of a single uid predicate deletes the reverse edge of the old one.
+		{pb.DirectedEdge_REVERSE, pb.DirectedEdge_DEL, 1, 7},
+		{pb.DirectedEdge_FORWARD, pb.DirectedEdge_SET, 1, 9},
+		{pb.DirectedEdge_REVERSE, pb.DirectedEdge_SET, 1, 9},
+	}, parts(edges))
+	require.Equal(t, pb.Posting_UID, edges[3].ValueType)
+	require.Nil(t, edges[3].Value)
+}
@@ -0,0 +1,42 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package worker
+
+import (
+	"context"
+	"time"
+
+	"github.com/hypermodeinc/dgraph/v25/posting"
+)
+
+type staleReadCtxKey struct{}
+
+// WithStaleRead returns a context whose queries accept stale results, so the learners of the
+// groups may serve them.
+func WithStaleRead(ctx context.Context) context.Context {
+	return context.WithValue(ctx, staleReadCtxKey{}, true)
+}
+
+func isStaleRead(ctx context.Context) bool {
+	stale, _ := ctx.Value(staleReadCtxKey{}).(bool)
+	return stale
+}
+
+// StaleReadTs returns the timestamp a query that accepts results stale by up to maxStaleness can
+// read at without asking Zero for one. It returns false if this alpha may be staler than that.
+//
+// The leader of a group is never stale. The other members know how stale they are from the
+// deltas the leader proposes, which it does every second in the groups with learners.
+func StaleReadTs(maxStaleness time.Duration) (uint64, bool) {
+	if groups().Node.AmLeader() {
+		return posting.Oracle().MaxAssigned(), true
+	}
+	staleness, ok := posting.Oracle().Staleness()
+	if !ok || staleness > maxStaleness {
+		return 0, false
+	}
+	return posting.Oracle().MaxAssigned(), true
+}
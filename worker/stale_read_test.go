@@ -0,0 +1,58 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package worker
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/dgraph-io/ristretto/v2/z"
+	"github.com/hypermodeinc/dgraph/v25/posting"
+	"github.com/hypermodeinc/dgraph/v25/protos/pb"
+	"github.com/hypermodeinc/dgraph/v25/x"
+)
+
+func TestReadServers(t *testing.T) {
+	raft := x.WorkerConfig.Raft
+	defer func() { x.WorkerConfig.Raft = raft }()
+	x.WorkerConfig.Raft = z.NewSuperFlag("zone=east;").MergeAndCheckDefault(RaftDefaults)
+
+	g := &groupi{state: &pb.MembershipState{Groups: map[uint32]*pb.Group{
+		1: {Members: map[uint64]*pb.Member{
+			1: {Id: 1, Addr: "west-voter", Zone: "west"},
+			2: {Id: 2, Addr: "east-voter", Zone: "east"},
+			3: {Id: 3, Addr: "east-learner", Zone: "east", Learner: true},
+		}},
+		2: {Members: map[uint64]*pb.Member{
+			4: {Id: 4, Addr: "west-learner", Zone: "west", Learner: true},
+		}},
+	}}}
+
+	require.Equal(t, []string{"east-voter", "west-voter"}, g.readServers(1, false))
+	require.ElementsMatch(t, []string{"east-voter", "east-learner"}, g.readServers(1, true))
+	// A group of learners only still serves the reads.
+	require.Equal(t, []string{"west-learner"}, g.readServers(2, false))
+	require.Empty(t, g.readServers(3, true))
+}
+
+func TestOracleStaleness(t *testing.T) {
+	o := posting.Oracle()
+	o.ProcessDelta(&pb.OracleDelta{
+		MaxAssigned: o.MaxAssigned(),
+		ProposedAt:  time.Now().Add(-time.Minute).UnixNano(),
+	})
+	staleness, ok := o.Staleness()
+	require.True(t, ok)
+	require.GreaterOrEqual(t, staleness, time.Minute)
+
+	// A delta without a proposal time, like the ones of older leaders, doesn't reset it.
+	o.ProcessDelta(&pb.OracleDelta{MaxAssigned: o.MaxAssigned()})
+	staleness, ok = o.Staleness()
+	require.True(t, ok)
+	require.GreaterOrEqual(t, staleness, time.Minute)
+}
@@ -0,0 +1,109 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package worker
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/golang/glog"
+
+	"github.com/hypermodeinc/dgraph/v25/conn"
+	"github.com/hypermodeinc/dgraph/v25/protos/pb"
+)
+
+// tabletLoad counts the reads and writes of the tablets on this alpha. The leader of the group
+// reports their rates to Zero along with the tablet sizes, for the load placement policy. Every
+// replica applies all the writes, but the queries are spread over the replicas, so the leader
+// adds up the reads of all of them.
+type tabletLoad struct {
+	counts sync.Map // predicate -> *tabletCounts
+
+	sync.Mutex
+	since time.Time
+}
+
+type tabletCounts struct {
+	reads, writes atomic.Int64
+}
+
+var tabletLoads = &tabletLoad{since: time.Now()}
+
+func (t *tabletLoad) get(attr string) *tabletCounts {
+	if c, ok := t.counts.Load(attr); ok {
+		return c.(*tabletCounts)
+	}
+	c, _ := t.counts.LoadOrStore(attr, &tabletCounts{})
+	return c.(*tabletCounts)
+}
+
+// addRead counts a query task on the predicate.
+func (t *tabletLoad) addRead(attr string) {
+	t.get(attr).reads.Add(1)
+}
+
+// addWrite counts a mutated edge of the predicate.
+func (t *tabletLoad) addWrite(attr string) {
+	t.get(attr).writes.Add(1)
+}
+
+// rates returns the reads and writes per second of the predicates since the last call.
+func (t *tabletLoad) rates() (reads, writes map[string]float64) {
+	t.Lock()
+	defer t.Unlock()
+	secs := time.Since(t.since).Seconds()
+	t.since = time.Now()
+
+	reads, writes = make(map[string]float64), make(map[string]float64)
+	if secs <= 0 {
+		return
+	}
+	t.counts.Range(func(key, value any) bool {
+		attr, c := key.(string), value.(*tabletCounts)
+		r, w := c.reads.Swap(0), c.writes.Swap(0)
+		if r == 0 && w == 0 {
+			// Forget the predicates that aren't used anymore.
+			t.counts.Delete(attr)
+			return true
+		}
+		reads[attr], writes[attr] = float64(r)/secs, float64(w)/secs
+		return true
+	})
+	return
+}
+
+// TabletReads returns the reads per second of the predicates on this alpha since the last call.
+func (*grpcWorker) TabletReads(ctx context.Context,
+	_ *pb.TabletReadsRequest) (*pb.TabletReadsResponse, error) {
+
+	reads, _ := tabletLoads.rates()
+	return &pb.TabletReadsResponse{ReadsPerSec: reads}, nil
+}
+
+// addReplicaReads adds the reads per second of the other replicas of the group to reads. A
+// replica which can't be reached is skipped, its reads are counted in the next round.
+func addReplicaReads(ctx context.Context, reads map[string]float64) {
+	for _, m := range groups().members(groups().groupId()) {
+		if m.Id == groups().Node.Id {
+			continue
+		}
+		pl, err := conn.GetPools().Get(m.Addr)
+		if err != nil {
+			glog.Warningf("While getting the reads of alpha %s: %v", m.Addr, err)
+			continue
+		}
+		resp, err := pb.NewWorkerClient(pl.Get()).TabletReads(ctx, &pb.TabletReadsRequest{})
+		if err != nil {
+			glog.Warningf("While getting the reads of alpha %s: %v", m.Addr, err)
+			continue
+		}
+		for attr, r := range resp.ReadsPerSec {
+			reads[attr] += r
+		}
+	}
+}
@@ -0,0 +1,51 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package worker
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/hypermodeinc/dgraph/v25/protos/pb"
+)
+
+func TestTabletLoadRates(t *testing.T) {
+	load := &tabletLoad{since: time.Now().Add(-10 * time.Second)}
+	for i := 0; i < 20; i++ {
+		load.addRead("0-name")
+	}
+	load.addWrite("0-name")
+	load.addWrite("0-age")
+
+	reads, writes := load.rates()
+	require.InDelta(t, 2, reads["0-name"], 0.1)
+	require.InDelta(t, 0.1, writes["0-name"], 0.01)
+	require.InDelta(t, 0.1, writes["0-age"], 0.01)
+
+	// The counts start over, and the unused predicates are forgotten.
+	reads, writes = load.rates()
+	require.Empty(t, reads)
+	require.Empty(t, writes)
+	_, ok := load.counts.Load("0-name")
+	require.False(t, ok)
+}
+
+func TestTabletReads(t *testing.T) {
+	tabletLoads.rates()
+	tabletLoads.addRead("0-reads")
+	tabletLoads.addWrite("0-reads")
+
+	// Only the reads are reported, the writes are the same on every replica.
+	resp, err := (&grpcWorker{}).TabletReads(context.Background(), &pb.TabletReadsRequest{})
+	require.NoError(t, err)
+	require.Greater(t, resp.ReadsPerSec["0-reads"], 0.0)
+	resp, err = (&grpcWorker{}).TabletReads(context.Background(), &pb.TabletReadsRequest{})
+	require.NoError(t, err)
+	require.Empty(t, resp.ReadsPerSec)
+}
@@ -29,6 +29,7 @@ import (
 	"github.com/dgraph-io/dgo/v250/protos/api"
 	"github.com/hypermodeinc/dgraph/v25/algo"
 	"github.com/hypermodeinc/dgraph/v25/conn"
+	"github.com/hypermodeinc/dgraph/v25/enc"
 	"github.com/hypermodeinc/dgraph/v25/posting"
 	"github.com/hypermodeinc/dgraph/v25/protos/pb"
 	"github.com/hypermodeinc/dgraph/v25/schema"
@@ -62,7 +63,7 @@ func processWithBackupRequest(
 	ctx context.Context,
 	gid uint32,
 	f func(context.Context, pb.WorkerClient) (interface{}, error)) (interface{}, error) {
-	addrs := groups().AnyTwoServers(gid)
+	addrs := groups().readServers(gid, isStaleRead(ctx))
 	if len(addrs) == 0 {
 		return nil, errors.New("No network connection")
 	}
@@ -140,6 +141,9 @@ func ProcessTaskOverNetwork(ctx context.Context, q *pb.Query) (*pb.Result, error
 		attribute.String("readTs", fmt.Sprintf("%d", q.ReadTs)),
 		attribute.String("node_id", fmt.Sprintf("%d", groups().Node.Id))))
 
+	if shards := groups().tabletShards(attr); len(shards) > 0 {
+		return processSplitTask(ctx, q, shards)
+	}
 	if groups().ServesGroup(gid) {
 		// No need for a network call, as this should be run from within this instance.
 		return processTask(ctx, q, gid)
@@ -174,7 +178,24 @@ func convertValue(attr, data string) (types.Val, error) {
 	}
 	src := types.Val{Tid: types.StringID, Value: []byte(data)}
 	dst, err := types.Convert(src, t)
-	return dst, err
+	if err != nil {
+		return dst, err
+	}
+	// The values of @encrypted predicates are stored encrypted. As the encryption is
+	// deterministic, comparing with the encrypted argument finds the equal values.
+	if keyName := schema.State().EncryptionKey(attr); keyName != "" {
+		key, ok := x.WorkerConfig.FieldKeys[keyName]
+		if !ok {
+			return types.Val{}, errors.Errorf("Field key %q of predicate %s is not configured "+
+				"on this alpha", keyName, x.ParseAttr(attr))
+		}
+		val, err := enc.EncryptField(keyName, key, []byte(data))
+		if err != nil {
+			return types.Val{}, err
+		}
+		dst = types.Val{Tid: types.StringID, Value: val}
+	}
+	return dst, nil
 }
 
 // Returns nil byte on error
@@ -217,6 +238,7 @@ const (
 	customIndexFn
 	matchFn
 	similarToFn
+	scoreFn
 	standardFn = 100
 )
 
@@ -257,6 +279,8 @@ func parseFuncTypeHelper(name string) (FuncType, string) {
 		return uidInFn, f
 	case "similar_to":
 		return similarToFn, f
+	case "score":
+		return scoreFn, f
 	case "anyof", "allof":
 		return customIndexFn, f
 	case "match":
@@ -279,7 +303,7 @@ func needsIndex(fnType FuncType, uidList *pb.List) bool {
 			return false
 		}
 		return true
-	case geoFn, fullTextSearchFn, standardFn, matchFn:
+	case geoFn, fullTextSearchFn, standardFn, matchFn, scoreFn:
 		return true
 	case similarToFn:
 		return true
@@ -305,7 +329,7 @@ type funcArgs struct {
 // The function tells us whether we want to fetch value posting lists or uid posting lists.
 func (srcFn *functionContext) needsValuePostings(typ types.TypeID) (bool, error) {
 	switch srcFn.fnType {
-	case aggregatorFn, passwordFn, similarToFn:
+	case aggregatorFn, passwordFn, similarToFn, scoreFn:
 		return true, nil
 	case compareAttrFn:
 		if len(srcFn.tokens) > 0 {
@@ -990,6 +1014,7 @@ func processTask(ctx context.Context, q *pb.Query, gid uint32) (*pb.Result, erro
 
 	stop := x.SpanTimer(span, "processTask"+q.Attr)
 	defer stop()
+	tabletLoads.addRead(q.Attr)
 
 	span.SetAttributes(
 		attribute.String("startTs", fmt.Sprintf("%d", q.ReadTs)),
@@ -1020,9 +1045,14 @@ func processTask(ctx context.Context, q *pb.Query, gid uint32) (*pb.Result, erro
 		return nil, err
 	case knownGid == 0:
 		return nil, errNonExistentTablet
-	case knownGid != groups().groupId():
+	case knownGid != groups().groupId() && !groups().servesShard(q.Attr):
 		return nil, errUnservedTablet
 	}
+	if q.AsOf {
+		if err := groups().Node.history.checkAsOf(q.ReadTs, groups().groupId()); err != nil {
+			return nil, err
+		}
+	}
 
 	var qs queryState
 	if q.Cache == UseTxnCache {
@@ -1037,6 +1067,7 @@ func processTask(ctx context.Context, q *pb.Query, gid uint32) (*pb.Result, erro
 	if err != nil {
 		return nil, err
 	}
+	decryptFieldValues(q, out)
 	return out, nil
 }
 
@@ -1055,6 +1086,9 @@ func (qs *queryState) helpProcessTask(ctx context.Context, q *pb.Query, gid uint
 	if err != nil {
 		return nil, err
 	}
+	if err := checkFieldKeyFunc(q, srcFn); err != nil {
+		return nil, err
+	}
 
 	if q.Reverse && !schema.State().IsReversed(ctx, attr) {
 		return nil, errors.Errorf("Predicate %s doesn't have reverse edge", x.ParseAttr(attr))
@@ -1106,12 +1140,23 @@ func (qs *queryState) helpProcessTask(ctx context.Context, q *pb.Query, gid uint
 	if err != nil {
 		return nil, err
 	}
-	if needsValPostings {
+	switch {
+	case srcFn.nearest != nil:
+		span.AddEvent("handleNearestFunction")
+		if err := qs.handleNearestFunction(ctx, args, opts); err != nil {
+			return nil, err
+		}
+	case srcFn.fnType == scoreFn:
+		span.AddEvent("handleScoreFunction")
+		if err := qs.handleScoreFunction(ctx, args); err != nil {
+			return nil, err
+		}
+	case needsValPostings:
 		span.AddEvent("handleValuePostings")
 		if err := qs.handleValuePostings(ctx, args); err != nil {
 			return nil, err
 		}
-	} else {
+	default:
 		span.AddEvent("handleUidPostings")
 		if err = qs.handleUidPostings(ctx, args, opts); err != nil {
 			return nil, err
@@ -1652,6 +1697,108 @@ func (qs *queryState) filterGeoFunction(ctx context.Context, arg funcArgs) error
 	return nil
 }
 
+// handleNearestFunction finds the k values closest to the point of a nearest function. It looks
+// up the index around the point with a growing radius, until it has found k values within the
+// radius. Any value closer than the k-th one would have been found by then too.
+func (qs *queryState) handleNearestFunction(ctx context.Context, arg funcArgs,
+	opts posting.ListOptions) error {
+	span := trace.SpanFromContext(ctx)
+	stop := x.SpanTimer(span, "handleNearestFunction")
+	defer stop()
+
+	attr := arg.q.Attr
+	nq := arg.srcFn.nearest
+	// Only intersect with the uids that are being filtered, the rest of the options apply to
+	// the final result.
+	lopts := posting.ListOptions{ReadTs: opts.ReadTs, Intersect: opts.Intersect}
+
+	dists := make(map[uint64]float64)
+	distance := func(uid uint64) error {
+		if _, ok := dists[uid]; ok {
+			return nil
+		}
+		pl, err := qs.cache.Get(x.DataKey(attr, uid))
+		if err != nil {
+			return err
+		}
+		d := math.Inf(1)
+		var tv pb.TaskValue
+		err = pl.Iterate(arg.q.ReadTs, 0, func(p *pb.Posting) error {
+			tv.ValType = p.ValType
+			tv.Val = p.Value
+			if pd, ok := nq.Distance(&tv); ok && pd < d {
+				d = pd
+			}
+			return nil
+		})
+		dists[uid] = d
+		return err
+	}
+
+	for radius := float64(types.NearestMinRadius); ; radius *= 4 {
+		radius = math.Min(radius, types.NearestMaxRadius)
+		tokens, err := nq.Tokens(radius)
+		if err != nil {
+			return err
+		}
+		tok.EncodeGeoTokens(tokens)
+		for _, token := range tokens {
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			default:
+			}
+			pl, err := qs.cache.GetUids(x.IndexKey(attr, token))
+			if err != nil {
+				return err
+			}
+			uids, err := pl.Uids(lopts)
+			if err != nil {
+				return err
+			}
+			for _, uid := range uids.Uids {
+				if err := distance(uid); err != nil {
+					return err
+				}
+			}
+		}
+
+		var within int
+		for _, d := range dists {
+			if d <= radius {
+				within++
+			}
+		}
+		if within >= nq.K || radius >= types.NearestMaxRadius {
+			break
+		}
+	}
+
+	uids := make([]uint64, 0, len(dists))
+	for uid, d := range dists {
+		if !math.IsInf(d, 1) {
+			uids = append(uids, uid)
+		}
+	}
+	sort.Slice(uids, func(i, j int) bool {
+		di, dj := dists[uids[i]], dists[uids[j]]
+		if di != dj {
+			return di < dj
+		}
+		return uids[i] < uids[j]
+	})
+	if len(uids) > nq.K {
+		uids = uids[:nq.K]
+	}
+	// Results of a function are always sorted by uid. Use orderasc on geodistance() to get
+	// them sorted by distance.
+	sort.Slice(uids, func(i, j int) bool { return uids[i] < uids[j] })
+	span.AddEvent("Nearest result", trace.WithAttributes(
+		attribute.Int("uid_count", len(uids))))
+	arg.out.UidMatrix = append(arg.out.UidMatrix, &pb.List{Uids: uids})
+	return nil
+}
+
 // TODO: This function is really slow when there are a lot of UIDs to filter, for e.g. when used in
 // `has(name)`. We could potentially have a query level cache, which can be used to speed things up
 // a bit. Or, try to reduce the number of UIDs which make it here.
@@ -1766,6 +1913,7 @@ func matchRegex(value types.Val, regex *cregexp.Regexp) bool {
 type functionContext struct {
 	tokens        []string
 	geoQuery      *types.GeoQueryData
+	nearest       *types.NearestQuery
 	intersectDest bool
 	// eqTokens is used by compareAttr functions. It stores values corresponding to each
 	// function argument. There could be multiple arguments to `eq` function but only one for
@@ -1873,6 +2021,15 @@ func parseSrcFn(ctx context.Context, q *pb.Query) (*functionContext, error) {
 	fnType, f := parseFuncType(q.SrcFunc)
 	attr := q.Attr
 	fc := &functionContext{fnType: fnType, fname: f}
+	if schema.State().EncryptionKey(attr) != "" {
+		switch {
+		case fnType == notAFunction, fnType == hasFn, fnType == compareScalarFn:
+		case fnType == compareAttrFn && f == eq:
+		default:
+			return nil, errors.Errorf("Function %s is not supported on @encrypted predicate %s",
+				q.SrcFunc.Name, x.ParseAttr(attr))
+		}
+	}
 	isIndexedAttr := schema.State().IsIndexed(ctx, attr)
 	var err error
 
@@ -2013,6 +2170,15 @@ func parseSrcFn(ctx context.Context, q *pb.Query) (*functionContext, error) {
 		fc.threshold = thresholds
 		checkRoot(q, fc)
 	case geoFn:
+		if fc.fname == "nearest" {
+			// The tokens for nearest depend on how far the nearest values are, so they are
+			// looked up in handleNearestFunction.
+			fc.nearest, err = types.ParseNearest(q.SrcFunc)
+			if err != nil {
+				return nil, err
+			}
+			break
+		}
 		// For geo functions, we get extra information used for filtering.
 		fc.tokens, fc.geoQuery, err = types.GetGeoTokens(q.SrcFunc)
 		tok.EncodeGeoTokens(fc.tokens)
@@ -2025,6 +2191,16 @@ func parseSrcFn(ctx context.Context, q *pb.Query) (*functionContext, error) {
 			return nil, err
 		}
 		fc.n = len(q.UidList.Uids)
+	case scoreFn:
+		if err = ensureArgsCount(q.SrcFunc, 1); err != nil {
+			return nil, err
+		}
+		required, found := verifyStringIndex(ctx, attr, fullTextSearchFn)
+		if !found {
+			return nil, errors.Errorf("Attribute %s is not indexed with type %s", x.ParseAttr(attr),
+				required)
+		}
+		fc.n = len(q.UidList.Uids)
 	case standardFn, fullTextSearchFn:
 		// srcfunc 0th val is func name and [2:] are args.
 		// we tokenize the arguments of the query.
@@ -2177,6 +2353,9 @@ func (w *grpcWorker) ServeTask(ctx context.Context, q *pb.Query) (*pb.Result, er
 		return nil, err
 	case gid == 0:
 		return nil, errNonExistentTablet
+	case groups().servesShard(q.Attr):
+		// The predicate is split, and this group serves one of its sub-tablets.
+		gid = groups().groupId()
 	case gid != groups().groupId():
 		return nil, errUnservedTablet
 	}
@@ -0,0 +1,77 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package worker
+
+import (
+	"io"
+	"sync"
+	"time"
+)
+
+// throttle limits the bytes per second read or written by a backup or an export, so that it
+// doesn't take the disk or the network away from the queries. A nil throttle doesn't limit
+// anything.
+type throttle struct {
+	sync.Mutex
+	bytesPerSec float64
+	// next is when the bytes taken so far are paid for at the limit.
+	next time.Time
+}
+
+func newThrottle(bytesPerSec uint64) *throttle {
+	if bytesPerSec == 0 {
+		return nil
+	}
+	return &throttle{bytesPerSec: float64(bytesPerSec)}
+}
+
+// take accounts for n bytes, and blocks until they fit in the limit.
+func (t *throttle) take(n int) {
+	if t == nil || n <= 0 {
+		return
+	}
+	t.Lock()
+	now := time.Now()
+	if t.next.Before(now) {
+		t.next = now
+	}
+	t.next = t.next.Add(time.Duration(float64(n) / t.bytesPerSec * float64(time.Second)))
+	wait := t.next.Sub(now)
+	t.Unlock()
+	time.Sleep(wait)
+}
+
+// throttledWriter limits the bytes per second written to a writer.
+type throttledWriter struct {
+	io.WriteCloser
+	t *throttle
+}
+
+// newThrottledWriter returns w if there is no limit.
+func newThrottledWriter(w io.WriteCloser, bytesPerSec uint64) io.WriteCloser {
+	t := newThrottle(bytesPerSec)
+	if t == nil {
+		return w
+	}
+	return &throttledWriter{WriteCloser: w, t: t}
+}
+
+func (w *throttledWriter) Write(p []byte) (int, error) {
+	w.t.take(len(p))
+	return w.WriteCloser.Write(p)
+}
+
+// throttledReader limits the bytes per second read from a reader.
+type throttledReader struct {
+	io.Reader
+	t *throttle
+}
+
+func (r *throttledReader) Read(p []byte) (int, error) {
+	n, err := r.Reader.Read(p)
+	r.t.take(n)
+	return n, err
+}
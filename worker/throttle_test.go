@@ -0,0 +1,43 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package worker
+
+import (
+	"bytes"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+type nopWriteCloser struct {
+	io.Writer
+}
+
+func (nopWriteCloser) Close() error { return nil }
+
+func TestThrottledWriter(t *testing.T) {
+	var buf bytes.Buffer
+	w := nopWriteCloser{&buf}
+	require.Equal(t, w, newThrottledWriter(w, 0))
+
+	tw := newThrottledWriter(w, 10<<10)
+	start := time.Now()
+	for range 5 {
+		_, err := tw.Write(make([]byte, 1<<10))
+		require.NoError(t, err)
+	}
+	// 5KB at 10KB per second.
+	require.GreaterOrEqual(t, time.Since(start), 450*time.Millisecond)
+	require.Equal(t, 5<<10, buf.Len())
+
+	// A nil throttle doesn't limit anything.
+	var nilThrottle *throttle
+	start = time.Now()
+	nilThrottle.take(1 << 30)
+	require.Less(t, time.Since(start), 100*time.Millisecond)
+}
@@ -8,6 +8,7 @@ package worker
 import (
 	"context"
 
+	"github.com/dgraph-io/dgo/v250/protos/api"
 	"github.com/hypermodeinc/dgraph/v25/conn"
 	"github.com/hypermodeinc/dgraph/v25/protos/pb"
 )
@@ -35,3 +36,83 @@ func MoveTabletOverNetwork(ctx context.Context, req *pb.MoveTabletRequest) (*pb.
 	c := pb.NewZeroClient(pl.Get())
 	return c.MoveTablet(ctx, req)
 }
+
+// DecommissionGroupOverNetwork sends a request to mark the given group as being decommissioned,
+// or not, to the current zero leader.
+func DecommissionGroupOverNetwork(ctx context.Context,
+	req *pb.DecommissionGroupRequest) (*pb.Status, error) {
+	pl := groups().Leader(0)
+	if pl == nil {
+		return nil, conn.ErrNoConnection
+	}
+
+	c := pb.NewZeroClient(pl.Get())
+	return c.DecommissionGroup(ctx, req)
+}
+
+// SplitTabletOverNetwork sends a request to split the given tablet across groups to the current
+// zero leader.
+func SplitTabletOverNetwork(ctx context.Context, req *pb.SplitTabletRequest) (*pb.Status, error) {
+	pl := groups().Leader(0)
+	if pl == nil {
+		return nil, conn.ErrNoConnection
+	}
+
+	c := pb.NewZeroClient(pl.Get())
+	return c.SplitTablet(ctx, req)
+}
+
+// SetTabletPlacementOverNetwork sends a request to set the placement of a tablet to the current
+// zero leader.
+func SetTabletPlacementOverNetwork(ctx context.Context, p *pb.TabletPlacement) error {
+	pl := groups().Leader(0)
+	if pl == nil {
+		return conn.ErrNoConnection
+	}
+	_, err := pb.NewZeroClient(pl.Get()).SetTabletPlacement(ctx, p)
+	return err
+}
+
+// SetReplicationOverNetwork sends a request to set the replication role of the cluster to the
+// current zero leader.
+func SetReplicationOverNetwork(ctx context.Context, r *pb.Replication) error {
+	pl := groups().Leader(0)
+	if pl == nil {
+		return conn.ErrNoConnection
+	}
+	_, err := pb.NewZeroClient(pl.Get()).SetReplication(ctx, r)
+	return err
+}
+
+// SetJobScheduleOverNetwork sends a request to set or remove the schedule of a job to the current
+// zero leader.
+func SetJobScheduleOverNetwork(ctx context.Context, js *pb.JobSchedule) error {
+	pl := groups().Leader(0)
+	if pl == nil {
+		return conn.ErrNoConnection
+	}
+	_, err := pb.NewZeroClient(pl.Get()).SetJobSchedule(ctx, js)
+	return err
+}
+
+// recordJobRunOverNetwork sends a run of a scheduled job to the current zero leader, to add it
+// to the history of the job.
+func recordJobRunOverNetwork(ctx context.Context, run *pb.JobRun) error {
+	pl := groups().Leader(0)
+	if pl == nil {
+		return conn.ErrNoConnection
+	}
+	_, err := pb.NewZeroClient(pl.Get()).RecordJobRun(ctx, run)
+	return err
+}
+
+// FinalizeUpgradeOverNetwork sends a request to finalize the upgrade of the cluster to the
+// current zero leader.
+func FinalizeUpgradeOverNetwork(ctx context.Context) error {
+	pl := groups().Leader(0)
+	if pl == nil {
+		return conn.ErrNoConnection
+	}
+	_, err := pb.NewZeroClient(pl.Get()).FinalizeUpgrade(ctx, &api.Payload{})
+	return err
+}
@@ -8,8 +8,11 @@ package x
 import (
 	"crypto"
 	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"os"
+	"path/filepath"
 	"strconv"
 	"strings"
 	"time"
@@ -30,7 +33,15 @@ type Keys struct {
 	AclSecretKeyBytes Sensitive // we need this to compute hash for auth
 	AclAccessTtl      time.Duration
 	AclRefreshTtl     time.Duration
+	AclOidc           *OIDCConfig
+	AclCertIdentity   *CertIdentityConfig
 	EncKey            Sensitive
+	// EncKeyRing holds the previous encryption keys, read from files or from Vault. They are
+	// used to read the backups taken before the key was rotated, and to rotate the key registries
+	// of the stores on startup.
+	EncKeyRing []Sensitive
+	// FieldKeys are the named keys used by the @encrypted directive.
+	FieldKeys map[string]Sensitive
 }
 
 // GetEncAclKeys returns the ACL and encryption keys as configured by the user
@@ -40,7 +51,7 @@ func GetEncAclKeys(config *viper.Viper) (*Keys, error) {
 	encSuperFlag := z.NewSuperFlag(config.GetString("encryption")).MergeAndCheckDefault(EncDefaults)
 
 	// Get SecretKey and EncKey from vault / acl / encryption SuperFlags
-	aclKey, encKey := vaultGetKeys(config)
+	aclKey, encKey, encKeyRing, fieldKeys := vaultGetKeys(config)
 
 	encKeyFile := encSuperFlag.GetPath(flagEncKeyFile)
 	if encKeyFile != "" {
@@ -55,6 +66,38 @@ func GetEncAclKeys(config *viper.Viper) (*Keys, error) {
 	if l := len(encKey); encKey != nil && l != 16 && l != 32 && l != 64 {
 		return nil, fmt.Errorf("encryption key must have length of 16, 32, or 64 bytes, got %d bytes instead", l)
 	}
+	if oldKeyFiles := encSuperFlag.GetString(flagEncOldKeyFiles); oldKeyFiles != "" {
+		for _, file := range strings.Split(oldKeyFiles, ",") {
+			file = strings.TrimSpace(file)
+			if file == "" {
+				continue
+			}
+			key, err := os.ReadFile(file)
+			if err != nil {
+				return nil, fmt.Errorf("error reading old encryption key from file: %s: %s", file, err)
+			}
+			encKeyRing = append(encKeyRing, key)
+		}
+	}
+	if len(encKeyRing) > 0 && encKey == nil {
+		return nil, fmt.Errorf("flags: old encryption keys need an encryption key")
+	}
+
+	if fieldKeysDir := encSuperFlag.GetPath(flagEncFieldKeysDir); fieldKeysDir != "" {
+		if fieldKeys != nil {
+			return nil, fmt.Errorf("flags: Field keys set in both vault and encryption flags")
+		}
+		var err error
+		if fieldKeys, err = readFieldKeys(fieldKeysDir); err != nil {
+			return nil, err
+		}
+	}
+	for name, key := range fieldKeys {
+		if len(key) < 16 {
+			return nil, fmt.Errorf("field key %q must be at least 16 bytes long, got %d bytes "+
+				"instead", name, len(key))
+		}
+	}
 
 	aclSecretFile := aclSuperFlag.GetPath(flagAclKeyFile)
 	if aclSecretFile != "" {
@@ -72,6 +115,8 @@ func GetEncAclKeys(config *viper.Viper) (*Keys, error) {
 		AclAccessTtl:      aclSuperFlag.GetDuration(flagAclAccessTtl),
 		AclRefreshTtl:     aclSuperFlag.GetDuration(flagAclRefreshTtl),
 		EncKey:            encKey,
+		EncKeyRing:        encKeyRing,
+		FieldKeys:         fieldKeys,
 	}
 
 	if aclKey != nil {
@@ -93,6 +138,67 @@ func GetEncAclKeys(config *viper.Viper) (*Keys, error) {
 		keys.AclPublicKey = pubKey
 	}
 
+	oidc, err := parseOIDCConfig(aclSuperFlag)
+	if err != nil {
+		return nil, err
+	}
+	if oidc != nil && aclKey == nil {
+		return nil, fmt.Errorf("flags: ACL secret key is required to accept OIDC tokens")
+	}
+	keys.AclOidc = oidc
+
+	certIdentity, err := parseCertIdentityConfig(aclSuperFlag.GetPath(flagAclCertIdentity))
+	if err != nil {
+		return nil, err
+	}
+	if certIdentity != nil {
+		if aclKey == nil {
+			return nil, fmt.Errorf("flags: ACL secret key is required to authenticate clients " +
+				"with their certificates")
+		}
+		certIdentity.alg = keys.AclJwtAlg
+		certIdentity.key = keys.AclSecretKey
+		certIdentity.ttl = keys.AclAccessTtl
+	}
+	keys.AclCertIdentity = certIdentity
+
+	return keys, nil
+}
+
+// KeyFingerprint returns a short identifier of a key, which doesn't reveal it.
+func KeyFingerprint(key Sensitive) string {
+	sum := sha256.Sum256(key)
+	return hex.EncodeToString(sum[:8])
+}
+
+// FindKey returns the key with the given fingerprint, or nil if there is none.
+func FindKey(fingerprint string, keys ...Sensitive) Sensitive {
+	for _, key := range keys {
+		if len(key) > 0 && KeyFingerprint(key) == fingerprint {
+			return key
+		}
+	}
+	return nil
+}
+
+// readFieldKeys reads the keys used by the @encrypted directive from a directory. Every file in
+// the directory is a key named after the file.
+func readFieldKeys(dir string) (map[string]Sensitive, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("error reading field keys from directory: %s: %s", dir, err)
+	}
+	keys := make(map[string]Sensitive, len(entries))
+	for _, entry := range entries {
+		if entry.IsDir() || strings.HasPrefix(entry.Name(), ".") {
+			continue
+		}
+		key, err := os.ReadFile(filepath.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("error reading field key from file: %s: %s", entry.Name(), err)
+		}
+		keys[entry.Name()] = key
+	}
 	return keys, nil
 }
 
@@ -165,6 +271,39 @@ func registerAclFlag(flag *pflag.FlagSet) {
 			"The TTL for the access JWT.").
 		Flag("refresh-ttl",
 			"The TTL for the refresh JWT.").
+		Flag("oidc-issuer",
+			"The issuer of the tokens of an external OIDC provider. Tokens with this iss claim "+
+				"are accepted as access JWTs if they are verified against the OIDC JWKS.").
+		Flag("oidc-audience",
+			"The aud claim required in the OIDC tokens. It isn't checked if empty.").
+		Flag("oidc-jwks-url",
+			"The URL of the JWKS used to verify the OIDC tokens.").
+		Flag("oidc-jwks-file",
+			"The file that stores the JWKS used to verify the OIDC tokens.").
+		Flag("oidc-user-claim",
+			"The OIDC token claim that holds the user id.").
+		Flag("oidc-groups-claim",
+			"The OIDC token claim that holds the list of ACL groups. Nested claims can be "+
+				"given as a path separated by dots.").
+		Flag("oidc-namespace-claim",
+			"The OIDC token claim that holds the namespace. Tokens without it belong to the "+
+				"root namespace.").
+		Flag("oidc-groups",
+			"The comma separated OIDC groups accepted as ACL groups, as provider-group:acl-group "+
+				"or just group if the names are the same. The other groups in the tokens are "+
+				"ignored. If empty, the groups are accepted as they are, except the guardians "+
+				"group, which must always be listed to be accepted.").
+		Flag("oidc-namespaces",
+			"The comma separated namespaces, other than the root namespace, that the OIDC "+
+				"tokens may claim. Tokens claiming any other namespace are rejected.").
+		Flag("cert-identity-file",
+			"The JSON file with the rules that map the verified client certificates of mutual "+
+				"TLS connections to a user, its groups and its namespace. Requests without an "+
+				"access JWT are authenticated as the user their certificate maps to. Each rule "+
+				"has the form {\"match\": \"spiffe://example.org/svc/*\", \"user\": \"svc\", "+
+				"\"groups\": [\"dev\"], \"namespace\": 0}, where match is compared with the "+
+				"URI, DNS and email SANs of the certificate and CN=<common name>, and a trailing "+
+				"* matches any suffix. The matched identity is the user if user is empty.").
 		String()
 	flag.String(flagAcl, AclDefaults, helpText)
 }
@@ -174,6 +313,12 @@ func registerEncFlag(flag *pflag.FlagSet) {
 		Head("Encryption At Rest options").
 		Flag("key-file", "The file that stores the symmetric key of length 16, 24, or 32 bytes."+
 			"The key size determines the chosen AES cipher (AES-128, AES-192, and AES-256 respectively).").
+		Flag("old-key-files", "Comma separated list of the files that store the previous "+
+			"encryption keys. They are used to restore the backups taken before the key was "+
+			"rotated, and to rotate the key of the stores on startup. To rotate the key, restart "+
+			"the alpha with the new key-file and the previous key in old-key-files.").
+		Flag("field-keys-dir", "The directory that stores the keys used by the @encrypted "+
+			"directive. Every file in the directory is a key named after the file.").
 		String()
 	flag.String(flagEnc, EncDefaults, helpText)
 }
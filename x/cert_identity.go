@@ -0,0 +1,225 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package x
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+)
+
+// CertIdentityRule maps the client certificates with a matching identity to an ACL user.
+type CertIdentityRule struct {
+	// Match is compared with the identities of the certificate: its URI SANs, like SPIFFE IDs,
+	// its DNS and email SANs, and the common name of its subject as CN=<name>. A trailing *
+	// matches any suffix, like spiffe://example.org/billing/*.
+	Match string `json:"match"`
+	// User is the user id of the requests. The matched identity is used if it is empty.
+	User string `json:"user"`
+	// Groups are the ACL groups of the user.
+	Groups []string `json:"groups"`
+	// Namespace is the namespace of the requests.
+	Namespace uint64 `json:"namespace"`
+}
+
+// CertIdentityConfig holds the configuration for authenticating the clients with the verified
+// certificate they present on a mutual TLS connection, instead of an access JWT obtained on
+// login. The requests without an access JWT are given one for the user their certificate maps
+// to, so that they go through the same ACL checks.
+type CertIdentityConfig struct {
+	// Rules are tried in order, the first one that matches an identity of the certificate is
+	// used.
+	Rules []CertIdentityRule
+
+	alg jwt.SigningMethod
+	key interface{}
+	ttl time.Duration
+
+	sync.Mutex
+	tokens map[[sha256.Size]byte]certToken
+}
+
+type certToken struct {
+	jwt    string
+	expiry time.Time
+}
+
+func parseCertIdentityConfig(file string) (*CertIdentityConfig, error) {
+	if file == "" {
+		return nil, nil
+	}
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, errors.Wrapf(err, "error reading certificate identity file: %s", file)
+	}
+	c := &CertIdentityConfig{tokens: make(map[[sha256.Size]byte]certToken)}
+	if err := json.Unmarshal(data, &c.Rules); err != nil {
+		return nil, errors.Wrapf(err, "error parsing certificate identity file: %s", file)
+	}
+	for i, rule := range c.Rules {
+		if rule.Match == "" {
+			return nil, errors.Errorf("rule %d of certificate identity file %s has no match",
+				i, file)
+		}
+	}
+	return c, nil
+}
+
+// certIdentities returns the identities of the certificate that the rules are matched against.
+func certIdentities(cert *x509.Certificate) []string {
+	var ids []string
+	for _, uri := range cert.URIs {
+		ids = append(ids, uri.String())
+	}
+	ids = append(ids, cert.DNSNames...)
+	ids = append(ids, cert.EmailAddresses...)
+	if cert.Subject.CommonName != "" {
+		ids = append(ids, "CN="+cert.Subject.CommonName)
+	}
+	return ids
+}
+
+func (r *CertIdentityRule) matches(id string) bool {
+	if prefix, ok := strings.CutSuffix(r.Match, "*"); ok {
+		return strings.HasPrefix(id, prefix)
+	}
+	return id == r.Match
+}
+
+// Map returns the claims of the access JWT of the user the certificate maps to. It returns
+// false if the certificate doesn't match any rule.
+func (c *CertIdentityConfig) Map(cert *x509.Certificate) (jwt.MapClaims, bool) {
+	ids := certIdentities(cert)
+	for _, rule := range c.Rules {
+		for _, id := range ids {
+			if !rule.matches(id) {
+				continue
+			}
+			userId := rule.User
+			if userId == "" {
+				userId = id
+			}
+			groups := make([]interface{}, 0, len(rule.Groups))
+			for _, group := range rule.Groups {
+				groups = append(groups, group)
+			}
+			return jwt.MapClaims{
+				"userid":    userId,
+				"groups":    groups,
+				"namespace": rule.Namespace,
+			}, true
+		}
+	}
+	return nil, false
+}
+
+// accessJwt returns an access JWT for the user the certificate maps to. The JWTs are reused
+// until half of their TTL has passed. It returns an empty string if the certificate doesn't
+// match any rule.
+func (c *CertIdentityConfig) accessJwt(cert *x509.Certificate) (string, error) {
+	sum := sha256.Sum256(cert.Raw)
+	now := time.Now()
+	c.Lock()
+	defer c.Unlock()
+	if tok, ok := c.tokens[sum]; ok && now.Before(tok.expiry) {
+		return tok.jwt, nil
+	}
+
+	claims, ok := c.Map(cert)
+	if !ok {
+		return "", nil
+	}
+	claims["exp"] = now.Add(c.ttl).Unix()
+	jwtStr, err := jwt.NewWithClaims(c.alg, claims).SignedString(MaybeKeyToBytes(c.key))
+	if err != nil {
+		return "", errors.Errorf("unable to encode jwt to string: %v", err)
+	}
+	for k, tok := range c.tokens {
+		if now.After(tok.expiry) {
+			delete(c.tokens, k)
+		}
+	}
+	c.tokens[sum] = certToken{jwt: jwtStr, expiry: now.Add(c.ttl / 2)}
+	return jwtStr, nil
+}
+
+// attachCertJwt adds the access JWT of the user the verified client certificate of the
+// connection maps to, unless the request already has one.
+func attachCertJwt(ctx context.Context, state *tls.ConnectionState) context.Context {
+	c := WorkerConfig.AclCertIdentity
+	if c == nil || state == nil || len(state.VerifiedChains) == 0 ||
+		len(state.VerifiedChains[0]) == 0 {
+		return ctx
+	}
+	if _, err := ExtractJwt(ctx); err == nil {
+		return ctx
+	}
+	jwtStr, err := c.accessJwt(state.VerifiedChains[0][0])
+	if err != nil || jwtStr == "" {
+		return ctx
+	}
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		md = metadata.New(nil)
+	} else {
+		md = md.Copy()
+	}
+	md.Set("accessJwt", jwtStr)
+	return metadata.NewIncomingContext(ctx, md)
+}
+
+// attachCertJwtGRPC is attachCertJwt for the gRPC requests.
+func attachCertJwtGRPC(ctx context.Context) context.Context {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return ctx
+	}
+	info, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok {
+		return ctx
+	}
+	return attachCertJwt(ctx, &info.State)
+}
+
+// CertIdentityUnaryInterceptor authenticates the unary gRPC requests with the client
+// certificate of the connection if they don't have an access JWT.
+func CertIdentityUnaryInterceptor(ctx context.Context, req interface{},
+	info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+	return handler(attachCertJwtGRPC(ctx), req)
+}
+
+type certIdentityStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *certIdentityStream) Context() context.Context {
+	return s.ctx
+}
+
+// CertIdentityStreamInterceptor authenticates the streaming gRPC requests with the client
+// certificate of the connection if they don't have an access JWT.
+func CertIdentityStreamInterceptor(srv interface{}, ss grpc.ServerStream,
+	info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	if WorkerConfig.AclCertIdentity == nil {
+		return handler(srv, ss)
+	}
+	return handler(srv, &certIdentityStream{ServerStream: ss,
+		ctx: attachCertJwtGRPC(ss.Context())})
+}
@@ -0,0 +1,95 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package x
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestCertIdentity(t *testing.T) {
+	file := filepath.Join(t.TempDir(), "identities.json")
+	require.NoError(t, os.WriteFile(file, []byte(`[
+		{"match": "spiffe://example.org/billing/api", "user": "billing", "groups": ["dev"],
+			"namespace": 2},
+		{"match": "spiffe://example.org/reports/*", "groups": ["reports"]},
+		{"match": "CN=admin", "user": "groot", "groups": ["guardians"]}
+	]`), 0600))
+	c, err := parseCertIdentityConfig(file)
+	require.NoError(t, err)
+
+	spiffe := func(id string) *x509.Certificate {
+		u, err := url.Parse(id)
+		require.NoError(t, err)
+		return &x509.Certificate{Raw: []byte(id), URIs: []*url.URL{u}}
+	}
+
+	claims, ok := c.Map(spiffe("spiffe://example.org/billing/api"))
+	require.True(t, ok)
+	require.Equal(t, "billing", claims["userid"])
+	require.Equal(t, []interface{}{"dev"}, claims["groups"])
+	require.Equal(t, uint64(2), claims["namespace"])
+
+	claims, ok = c.Map(spiffe("spiffe://example.org/reports/daily"))
+	require.True(t, ok)
+	require.Equal(t, "spiffe://example.org/reports/daily", claims["userid"])
+	require.Equal(t, uint64(0), claims["namespace"])
+
+	claims, ok = c.Map(&x509.Certificate{Subject: pkix.Name{CommonName: "admin"}})
+	require.True(t, ok)
+	require.Equal(t, "groot", claims["userid"])
+
+	_, ok = c.Map(spiffe("spiffe://example.org/billing/worker"))
+	require.False(t, ok)
+
+	// The requests are given an access JWT that is verified like the ones issued on login.
+	key := Sensitive("0123456789abcdef0123456789abcdef")
+	c.alg, c.key, c.ttl = jwt.SigningMethodHS256, key, time.Hour
+	defer func(old WorkerOptions) { WorkerConfig = old }(WorkerConfig)
+	WorkerConfig.AclCertIdentity = c
+	WorkerConfig.AclJwtAlg = jwt.SigningMethodHS256
+	WorkerConfig.AclPublicKey = key
+
+	cert := spiffe("spiffe://example.org/billing/api")
+	state := &tls.ConnectionState{VerifiedChains: [][]*x509.Certificate{{cert}}}
+	ctx := attachCertJwt(context.Background(), state)
+	ns, err := ExtractNamespaceFrom(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), ns)
+	jwtStr, err := ExtractJwt(ctx)
+	require.NoError(t, err)
+	user, err := ExtractUserName(jwtStr)
+	require.NoError(t, err)
+	require.Equal(t, "billing", user)
+
+	// The same JWT is reused for the same certificate.
+	again, err := ExtractJwt(attachCertJwt(context.Background(), state))
+	require.NoError(t, err)
+	require.Equal(t, jwtStr, again)
+
+	// An access JWT sent with the request takes precedence.
+	ctx = metadata.NewIncomingContext(context.Background(), metadata.Pairs("accessJwt", "x"))
+	jwtStr, err = ExtractJwt(attachCertJwt(ctx, state))
+	require.NoError(t, err)
+	require.Equal(t, "x", jwtStr)
+
+	// Certificates that weren't verified are ignored.
+	ctx = attachCertJwt(context.Background(), &tls.ConnectionState{
+		PeerCertificates: []*x509.Certificate{cert}})
+	_, err = ExtractJwt(ctx)
+	require.ErrorIs(t, err, ErrNoJwt)
+}
@@ -110,6 +110,12 @@ type WorkerOptions struct {
 	// depending upon the JWT signing algorithm. Note that for symmetric algorithms,
 	// this will contain the same key as the private key, needs to be used carefully.
 	AclPublicKey interface{}
+	// AclOidc stores the configuration used to verify tokens issued by an external OIDC
+	// provider. It is nil if such tokens are not accepted.
+	AclOidc *OIDCConfig
+	// AclCertIdentity stores the rules that map the client certificates to ACL users. It is nil
+	// if the clients can't authenticate with their certificates.
+	AclCertIdentity *CertIdentityConfig
 	// AbortOlderThan tells Dgraph to discard transactions that are older than this duration.
 	AbortOlderThan time.Duration
 	// ProposedGroupId will be used if there's a file in the p directory called group_id with the
@@ -124,6 +130,10 @@ type WorkerOptions struct {
 	Security *z.SuperFlag
 	// EncryptionKey is the key used for encryption at rest, backups, exports.
 	EncryptionKey Sensitive
+	// EncryptionKeyRing holds the previous encryption keys.
+	EncryptionKeyRing []Sensitive
+	// FieldKeys are the named keys used to encrypt the values of @encrypted predicates.
+	FieldKeys map[string]Sensitive
 	// LogDQLRequest indicates whether alpha should log all query/mutation requests coming to it.
 	// Ideally LogDQLRequest should be a bool value. But we are reading it using atomics across
 	// queries hence it has been kept as int32. LogDQLRequest value 1 enables logging of requests
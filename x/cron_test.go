@@ -0,0 +1,57 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package x
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseCron(t *testing.T) {
+	// Wednesday.
+	at := func(day, hour, minute int) time.Time {
+		return time.Date(2024, 5, day, hour, minute, 30, 0, time.UTC)
+	}
+	tests := []struct {
+		expr    string
+		matches []time.Time
+		misses  []time.Time
+	}{
+		{"* * * * *", []time.Time{at(15, 0, 0), at(18, 23, 59)}, nil},
+		{"@daily", []time.Time{at(15, 0, 0)}, []time.Time{at(15, 0, 1), at(15, 1, 0)}},
+		{"*/15 2-4 * * *", []time.Time{at(15, 2, 0), at(15, 4, 45)},
+			[]time.Time{at(15, 2, 10), at(15, 5, 0)}},
+		{"5/20 * * * *", []time.Time{at(15, 1, 5), at(15, 1, 45)}, []time.Time{at(15, 1, 0)}},
+		{"0,30 12 * * 1-5", []time.Time{at(15, 12, 30), at(17, 12, 0)},
+			[]time.Time{at(18, 12, 0), at(19, 12, 0)}},
+		// Both 0 and 7 are Sunday.
+		{"0 0 * * 7", []time.Time{at(19, 0, 0)}, []time.Time{at(18, 0, 0)}},
+		// A day matching either the day of month or the day of week matches.
+		{"0 0 1 * 3", []time.Time{at(1, 0, 0), at(15, 0, 0)}, []time.Time{at(16, 0, 0)}},
+		{"0 0 1 * *", []time.Time{at(1, 0, 0)}, []time.Time{at(15, 0, 0)}},
+		// A field starting with * isn't restricted, so both fields have to match.
+		{"0 0 */2 * 1", []time.Time{at(13, 0, 0)}, []time.Time{at(6, 0, 0), at(15, 0, 0)}},
+		{"0 0 14 * */2", []time.Time{at(14, 0, 0)}, []time.Time{at(13, 0, 0), at(16, 0, 0)}},
+	}
+	for _, tc := range tests {
+		c, err := ParseCron(tc.expr)
+		require.NoError(t, err, tc.expr)
+		for _, m := range tc.matches {
+			require.True(t, c.Matches(m), "%s should match %s", tc.expr, m)
+		}
+		for _, m := range tc.misses {
+			require.False(t, c.Matches(m), "%s shouldn't match %s", tc.expr, m)
+		}
+	}
+
+	for _, expr := range []string{"", "* * * *", "60 * * * *", "* * 0 * *", "*/0 * * * *",
+		"5-1 * * * *", "a * * * *", "@often"} {
+		_, err := ParseCron(expr)
+		require.Error(t, err, expr)
+	}
+}
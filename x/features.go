@@ -0,0 +1,46 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package x
+
+import (
+	"github.com/pkg/errors"
+)
+
+// FeatureVersion is the version of the cluster features supported by this binary. It must be
+// bumped by a release adding a feature which the members running an older release can't apply,
+// e.g. a new field of a proposal or of the membership state. The cluster only uses the features
+// supported by its lowest member, so that the members can be upgraded one by one.
+const FeatureVersion uint32 = 1
+
+// Feature is a cluster feature, along with the feature version it was added in.
+type Feature struct {
+	Name    string
+	Version uint32
+}
+
+var (
+	FeaturePredicateTTL     = Feature{Name: "predicate TTL", Version: 1}
+	FeaturePredicateHistory = Feature{Name: "predicate history", Version: 1}
+	FeatureFieldEncryption  = Feature{Name: "field encryption", Version: 1}
+	FeatureNamespaceQuotas  = Feature{Name: "namespace quotas", Version: 1}
+	FeatureTabletSplits     = Feature{Name: "tablet splits", Version: 1}
+	FeatureTabletPlacement  = Feature{Name: "tablet placement", Version: 1}
+	FeatureReplication      = Feature{Name: "cross-cluster replication", Version: 1}
+	FeatureJobSchedules     = Feature{Name: "scheduled backups and exports", Version: 1}
+	FeatureOnlineMoves      = Feature{Name: "online predicate moves", Version: 1}
+	FeatureDecommission     = Feature{Name: "group decommissioning", Version: 1}
+)
+
+// Check returns an error if the feature isn't supported by the cluster, whose lowest member
+// supports the given feature version.
+func (f Feature) Check(clusterVersion uint32) error {
+	if clusterVersion >= f.Version {
+		return nil
+	}
+	return errors.Errorf("%s needs feature version %d, but a member of the cluster only "+
+		"supports version %d. Upgrade every member of the cluster first", f.Name, f.Version,
+		clusterVersion)
+}
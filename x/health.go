@@ -34,6 +34,11 @@ func UpdateDrainingMode(enable bool) {
 	setStatus(&drainingMode, enable)
 }
 
+// DrainingMode returns whether the server is in draining mode.
+func DrainingMode() bool {
+	return atomic.LoadUint32(&drainingMode) == 1
+}
+
 // HealthCheck returns whether the server is ready to accept requests or not
 // Load balancer would add the node to the endpoint once health check starts
 // returning true
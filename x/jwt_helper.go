@@ -27,7 +27,12 @@ func MaybeKeyToBytes(k interface{}) interface{} {
 	return k
 }
 
+// ParseJWT verifies the access JWT and returns its claims. Tokens issued by the configured OIDC
+// provider are verified against its JWKS, and their claims are mapped to the ones used by Dgraph.
 func ParseJWT(jwtStr string) (jwt.MapClaims, error) {
+	if WorkerConfig.AclJwtAlg != nil && IsOIDCToken(jwtStr) {
+		return WorkerConfig.AclOidc.Parse(jwtStr)
+	}
 	token, err := jwt.Parse(jwtStr, func(token *jwt.Token) (interface{}, error) {
 		if WorkerConfig.AclJwtAlg == nil {
 			return nil, errors.Errorf("ACL is disabled")
@@ -628,6 +628,7 @@ var aclPredicateMap = map[string]struct{}{
 	"dgraph.user.group":      {},
 	"dgraph.rule.predicate":  {},
 	"dgraph.rule.permission": {},
+	"dgraph.rule.filter":     {},
 	"dgraph.acl.rule":        {},
 }
 
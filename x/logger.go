@@ -6,6 +6,7 @@
 package x
 
 import (
+	"crypto/tls"
 	"os"
 	"path/filepath"
 
@@ -20,6 +21,22 @@ type LoggerConf struct {
 	Size          int64
 	Days          int64
 	MessageKey    string
+
+	// Syslog is the address of a syslog server the logs are shipped to, as tcp://host:port,
+	// udp://host:port or tls://host:port.
+	Syslog string
+	// SyslogTLS is the TLS configuration used with a tls:// syslog address.
+	SyslogTLS *tls.Config
+	// OTLP is the URL of the OTLP/HTTP logs endpoint the logs are shipped to.
+	OTLP string
+	// OTLPHeaders are the headers sent with the requests to the OTLP endpoint.
+	OTLPHeaders map[string]string
+	// BufferSize is the number of log entries buffered for each remote output before new
+	// entries are dropped from it.
+	BufferSize int
+	// ChainHeadDir is the directory the head of the hash chain of the audit logs is recorded
+	// in, the directory of the logs if empty.
+	ChainHeadDir string
 }
 
 func InitLogger(conf *LoggerConf, filename string) (*Logger, error) {
@@ -93,6 +110,26 @@ func (l *Logger) AuditE(msg string, args ...interface{}) {
 	l.logger.Error(msg, flds...)
 }
 
+// Write writes an already encoded log line, bypassing the encoder of the logger.
+func (l *Logger) Write(p []byte) (int, error) {
+	if l == nil {
+		return 0, nil
+	}
+	if l.writer == nil {
+		return os.Stdout.Write(p)
+	}
+	return l.writer.Write(p)
+}
+
+// FilePath returns the path of the file the logs are written to. It is empty if the logs are
+// written to stdout.
+func (l *Logger) FilePath() string {
+	if l == nil || l.writer == nil {
+		return ""
+	}
+	return l.writer.FilePath
+}
+
 func (l *Logger) Sync() {
 	if l == nil {
 		return
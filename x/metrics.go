@@ -115,6 +115,13 @@ var (
 	// MaxAssignedTs records the latest max assigned timestamp.
 	MaxAssignedTs = ostats.Int64("max_assigned_ts",
 		"Latest max assigned timestamp", ostats.UnitDimensionless)
+	// ReplicationLag records how far behind the primary cluster its secondary is.
+	ReplicationLag = ostats.Int64("replication_lag_seconds",
+		"Seconds since the changes shipped to the secondary cluster", ostats.UnitSeconds)
+	// NumAuditDropped is the number of audit entries dropped by the remote audit outputs
+	// because their buffer was full.
+	NumAuditDropped = ostats.Int64("audit_dropped_total",
+		"Total number of audit entries dropped by remote outputs", ostats.UnitDimensionless)
 	// TxnCommits records count of committed transactions.
 	TxnCommits = ostats.Int64("txn_commits_total",
 		"Number of transaction commits", ostats.UnitDimensionless)
@@ -259,6 +266,13 @@ var (
 			Aggregation: view.Count(),
 			TagKeys:     nil,
 		},
+		{
+			Name:        NumAuditDropped.Name(),
+			Measure:     NumAuditDropped,
+			Description: NumAuditDropped.Description(),
+			Aggregation: view.Sum(),
+			TagKeys:     nil,
+		},
 		{
 			Name:        TxnCommits.Name(),
 			Measure:     TxnCommits,
@@ -430,6 +444,13 @@ var (
 			Aggregation: view.LastValue(),
 			TagKeys:     allRaftKeys,
 		},
+		{
+			Name:        ReplicationLag.Name(),
+			Measure:     ReplicationLag,
+			Description: ReplicationLag.Description(),
+			Aggregation: view.LastValue(),
+			TagKeys:     allRaftKeys,
+		},
 		{
 			Name:        RaftLeaderChanges.Name(),
 			Measure:     RaftLeaderChanges,
@@ -0,0 +1,382 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package x
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/golang/glog"
+	"github.com/pkg/errors"
+
+	"github.com/dgraph-io/ristretto/v2/z"
+)
+
+const (
+	// oidcRefreshInterval is the minimum time between two fetches of the JWKS URL that are
+	// triggered by a token signed with an unknown key.
+	oidcRefreshInterval = time.Minute
+	// oidcDefaultKeyTtl is how long keys fetched from the JWKS URL are used before they are
+	// fetched again.
+	oidcDefaultKeyTtl = time.Hour
+)
+
+// OIDCConfig holds the configuration for accepting tokens issued by an external OpenID Connect
+// provider instead of the access JWTs issued by Dgraph on login. The claims of such tokens are
+// mapped to the user id, the ACL groups and the namespace of the request.
+type OIDCConfig struct {
+	// Issuer is the expected value of the iss claim. Tokens with any other issuer are verified
+	// as Dgraph tokens.
+	Issuer string
+	// Audience is the expected value of the aud claim. It isn't checked if empty.
+	Audience string
+	// JWKSUrl is the URL of the JSON Web Key Set used to verify the tokens.
+	JWKSUrl string
+	// JWKSFile is the path of a local JSON Web Key Set used to verify the tokens.
+	JWKSFile string
+	// UserClaim is the claim that holds the user id.
+	UserClaim string
+	// GroupsClaim is the claim that holds the list of ACL groups. Nested claims can be given
+	// as a path separated by dots, like realm_access.roles.
+	GroupsClaim string
+	// NamespaceClaim is the claim that holds the namespace. Tokens without it belong to the
+	// root namespace.
+	NamespaceClaim string
+	// Groups maps the groups of the provider to the ACL groups they are accepted as. The other
+	// groups are ignored. If nil, every group but the guardians is accepted as it is.
+	Groups map[string]string
+	// Namespaces are the namespaces other than the root namespace that the tokens may claim.
+	Namespaces map[uint64]struct{}
+
+	httpClient *http.Client
+
+	sync.RWMutex
+	keys      *jose.JSONWebKeySet
+	expiry    time.Time
+	lastFetch time.Time
+}
+
+func parseOIDCConfig(sf *z.SuperFlag) (*OIDCConfig, error) {
+	c := &OIDCConfig{
+		Issuer:         sf.GetString(flagAclOidcIssuer),
+		Audience:       sf.GetString(flagAclOidcAudience),
+		JWKSUrl:        sf.GetString(flagAclOidcJwksUrl),
+		JWKSFile:       sf.GetPath(flagAclOidcJwksFile),
+		UserClaim:      sf.GetString(flagAclOidcUserClaim),
+		GroupsClaim:    sf.GetString(flagAclOidcGroupsClaim),
+		NamespaceClaim: sf.GetString(flagAclOidcNsClaim),
+	}
+	switch {
+	case c.Issuer == "" && c.JWKSUrl == "" && c.JWKSFile == "":
+		return nil, nil
+	case c.Issuer == "":
+		return nil, errors.Errorf("flags: acl %s is required to accept OIDC tokens",
+			flagAclOidcIssuer)
+	case c.JWKSUrl == "" && c.JWKSFile == "":
+		return nil, errors.Errorf("flags: one of acl %s or %s is required to accept OIDC tokens",
+			flagAclOidcJwksUrl, flagAclOidcJwksFile)
+	case c.JWKSUrl != "" && c.JWKSFile != "":
+		return nil, errors.Errorf("flags: acl %s and %s can't both be set",
+			flagAclOidcJwksUrl, flagAclOidcJwksFile)
+	case c.UserClaim == "":
+		return nil, errors.Errorf("flags: acl %s can't be empty", flagAclOidcUserClaim)
+	}
+
+	if groups := sf.GetString(flagAclOidcGroups); groups != "" {
+		c.Groups = make(map[string]string)
+		for _, group := range strings.Split(groups, ",") {
+			from, to, found := strings.Cut(strings.TrimSpace(group), ":")
+			if !found {
+				to = from
+			}
+			if from == "" || to == "" {
+				return nil, errors.Errorf("flags: acl %s has an invalid group: %q",
+					flagAclOidcGroups, group)
+			}
+			c.Groups[from] = to
+		}
+	}
+	c.Namespaces = make(map[uint64]struct{})
+	if namespaces := sf.GetString(flagAclOidcNamespaces); namespaces != "" {
+		for _, ns := range strings.Split(namespaces, ",") {
+			id, err := strconv.ParseUint(strings.TrimSpace(ns), 0, 64)
+			if err != nil {
+				return nil, errors.Wrapf(err, "flags: acl %s has an invalid namespace",
+					flagAclOidcNamespaces)
+			}
+			c.Namespaces[id] = struct{}{}
+		}
+	}
+
+	if c.JWKSFile != "" {
+		data, err := os.ReadFile(c.JWKSFile)
+		if err != nil {
+			return nil, errors.Wrapf(err, "error reading OIDC JWKS file: %s", c.JWKSFile)
+		}
+		if c.keys, err = parseJWKS(data); err != nil {
+			return nil, errors.Wrapf(err, "error parsing OIDC JWKS file: %s", c.JWKSFile)
+		}
+	} else {
+		c.httpClient = &http.Client{Timeout: 30 * time.Second}
+	}
+	return c, nil
+}
+
+func parseJWKS(data []byte) (*jose.JSONWebKeySet, error) {
+	var keys jose.JSONWebKeySet
+	if err := json.Unmarshal(data, &keys); err != nil {
+		return nil, err
+	}
+	if len(keys.Keys) == 0 {
+		return nil, errors.Errorf("no keys found in JWKS")
+	}
+	return &keys, nil
+}
+
+// isIssuerOf returns true if the unverified iss claim of the token matches the issuer.
+func (c *OIDCConfig) isIssuerOf(jwtStr string) bool {
+	var claims jwt.MapClaims
+	if _, _, err := jwt.NewParser().ParseUnverified(jwtStr, &claims); err != nil {
+		return false
+	}
+	iss, err := claims.GetIssuer()
+	return err == nil && iss == c.Issuer
+}
+
+// Parse verifies a token issued by the OIDC provider and returns its claims mapped to the
+// userid, namespace and groups claims of the Dgraph access JWT.
+func (c *OIDCConfig) Parse(jwtStr string) (jwt.MapClaims, error) {
+	opts := []jwt.ParserOption{
+		// Only asymmetric algorithms are accepted, the keys in a JWKS are public.
+		jwt.WithValidMethods([]string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512",
+			"ES256", "ES384", "ES512", "EdDSA"}),
+		jwt.WithIssuer(c.Issuer),
+		jwt.WithExpirationRequired(),
+	}
+	if c.Audience != "" {
+		opts = append(opts, jwt.WithAudience(c.Audience))
+	}
+	token, err := jwt.Parse(jwtStr, c.keyFunc, opts...)
+	if err != nil {
+		if errors.Is(err, jwt.ErrTokenExpired) {
+			err = errors.Wrap(errTokenExpired, jwt.ErrTokenInvalidClaims.Error())
+		}
+		return nil, errors.Wrapf(err, "unable to parse OIDC token")
+	}
+	claims, ok := token.Claims.(jwt.MapClaims)
+	if !ok || !token.Valid {
+		return nil, errors.Errorf("claims in OIDC token is not map claims")
+	}
+	return c.mapClaims(claims)
+}
+
+func (c *OIDCConfig) mapClaims(claims jwt.MapClaims) (jwt.MapClaims, error) {
+	userId, ok := lookupClaim(claims, c.UserClaim).(string)
+	if !ok || userId == "" {
+		return nil, errors.Errorf("claim %s in OIDC token is not a string", c.UserClaim)
+	}
+
+	namespace := RootNamespace
+	if c.NamespaceClaim != "" {
+		switch ns := lookupClaim(claims, c.NamespaceClaim).(type) {
+		case nil:
+		case float64:
+			if ns < 0 || ns != float64(uint64(ns)) {
+				return nil, errors.Errorf("claim %s in OIDC token is not a valid namespace: %v",
+					c.NamespaceClaim, ns)
+			}
+			namespace = uint64(ns)
+		case string:
+			var err error
+			if namespace, err = strconv.ParseUint(ns, 0, 64); err != nil {
+				return nil, errors.Wrapf(err, "claim %s in OIDC token is not a valid namespace",
+					c.NamespaceClaim)
+			}
+		default:
+			return nil, errors.Errorf("claim %s in OIDC token is not a valid namespace: %v",
+				c.NamespaceClaim, ns)
+		}
+	}
+	// The provider may let users set their own claims, so only the listed namespaces can be
+	// claimed.
+	if _, ok := c.Namespaces[namespace]; !ok && namespace != RootNamespace {
+		return nil, errors.Errorf("namespace %#x in OIDC token is not accepted. It must be "+
+			"listed in acl %s", namespace, flagAclOidcNamespaces)
+	}
+
+	var groups []interface{}
+	if c.GroupsClaim != "" {
+		switch g := lookupClaim(claims, c.GroupsClaim).(type) {
+		case nil:
+		case string:
+			// Some providers send a single group as a string.
+			groups = []interface{}{g}
+		case []interface{}:
+			for _, group := range g {
+				if _, ok := group.(string); !ok {
+					return nil, errors.Errorf("claim %s in OIDC token has a group that is not "+
+						"a string: %v", c.GroupsClaim, group)
+				}
+			}
+			groups = g
+		default:
+			return nil, errors.Errorf("claim %s in OIDC token is not a list of groups",
+				c.GroupsClaim)
+		}
+	}
+	groups = c.mapGroups(groups)
+
+	return jwt.MapClaims{
+		"userid":    userId,
+		"namespace": float64(namespace),
+		"groups":    groups,
+		"exp":       claims["exp"],
+	}, nil
+}
+
+// mapGroups returns the ACL groups the groups of a token are accepted as. The guardians group
+// gives full access to the cluster, so it's only accepted if it's listed in the groups flag.
+func (c *OIDCConfig) mapGroups(groups []interface{}) []interface{} {
+	var mapped []interface{}
+	for _, g := range groups {
+		group := g.(string)
+		switch to, ok := c.Groups[group]; {
+		case c.Groups == nil && group != SuperAdminId:
+			mapped = append(mapped, group)
+		case ok:
+			mapped = append(mapped, to)
+		default:
+			glog.V(2).Infof("Ignoring group %q of OIDC token, which is not accepted", group)
+		}
+	}
+	return mapped
+}
+
+// lookupClaim returns the claim at the path separated by dots.
+func lookupClaim(claims map[string]interface{}, path string) interface{} {
+	var cur interface{} = claims
+	for _, part := range strings.Split(path, ".") {
+		m, ok := cur.(map[string]interface{})
+		if !ok {
+			return nil
+		}
+		if cur, ok = m[part]; !ok {
+			return nil
+		}
+	}
+	return cur
+}
+
+func (c *OIDCConfig) keyFunc(token *jwt.Token) (interface{}, error) {
+	kid, _ := token.Header["kid"].(string)
+	if key := c.lookupKey(kid); key != nil {
+		return key, nil
+	}
+	if c.JWKSUrl == "" {
+		return nil, errors.Errorf("no key found in OIDC JWKS for kid: %q", kid)
+	}
+	if err := c.refreshKeys(); err != nil {
+		return nil, err
+	}
+	if key := c.lookupKey(kid); key != nil {
+		return key, nil
+	}
+	return nil, errors.Errorf("no key found in OIDC JWKS for kid: %q", kid)
+}
+
+// lookupKey returns the public key with the given kid. An empty kid matches the only key of a
+// JWKS with a single key. It returns nil if the keys need to be fetched again.
+func (c *OIDCConfig) lookupKey(kid string) interface{} {
+	c.RLock()
+	defer c.RUnlock()
+	if c.keys == nil || (!c.expiry.IsZero() && time.Now().After(c.expiry)) {
+		return nil
+	}
+	if kid == "" {
+		if len(c.keys.Keys) == 1 {
+			return c.keys.Keys[0].Key
+		}
+		return nil
+	}
+	for _, k := range c.keys.Key(kid) {
+		if k.Use == "" || k.Use == "sig" {
+			return k.Key
+		}
+	}
+	return nil
+}
+
+// refreshKeys fetches the keys from the JWKS URL. The keys are fetched at most once every
+// oidcRefreshInterval, so that tokens with unknown kids can't be used to flood the provider.
+func (c *OIDCConfig) refreshKeys() error {
+	c.Lock()
+	defer c.Unlock()
+	if c.keys != nil && time.Since(c.lastFetch) < oidcRefreshInterval {
+		return nil
+	}
+	c.lastFetch = time.Now()
+
+	resp, err := c.httpClient.Get(c.JWKSUrl)
+	if err != nil {
+		return errors.Wrapf(err, "error fetching OIDC JWKS from %s", c.JWKSUrl)
+	}
+	defer func() {
+		if err := resp.Body.Close(); err != nil {
+			glog.Warningf("error closing body: %v", err)
+		}
+	}()
+	if resp.StatusCode != http.StatusOK {
+		return errors.Errorf("error fetching OIDC JWKS from %s: %s", c.JWKSUrl, resp.Status)
+	}
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return errors.Wrapf(err, "error reading OIDC JWKS from %s", c.JWKSUrl)
+	}
+	keys, err := parseJWKS(data)
+	if err != nil {
+		return errors.Wrapf(err, "error parsing OIDC JWKS from %s", c.JWKSUrl)
+	}
+
+	ttl := oidcDefaultKeyTtl
+	if cc := resp.Header.Get("Cache-Control"); cc != "" {
+		if maxAge, ok := parseMaxAge(cc); ok {
+			ttl = max(time.Duration(maxAge)*time.Second, oidcRefreshInterval)
+		}
+	}
+	c.keys = keys
+	c.expiry = time.Now().Add(ttl)
+	return nil
+}
+
+// parseMaxAge returns the max-age directive of a Cache-Control header.
+func parseMaxAge(cacheControl string) (int64, bool) {
+	for _, directive := range strings.Split(cacheControl, ",") {
+		name, value, found := strings.Cut(strings.TrimSpace(directive), "=")
+		if !found || !strings.EqualFold(name, "max-age") {
+			continue
+		}
+		maxAge, err := strconv.ParseInt(strings.Trim(value, `"`), 10, 64)
+		if err != nil || maxAge < 0 {
+			return 0, false
+		}
+		return maxAge, true
+	}
+	return 0, false
+}
+
+// IsOIDCToken returns true if the token claims to be issued by the configured OIDC provider.
+func IsOIDCToken(jwtStr string) bool {
+	oidc := WorkerConfig.AclOidc
+	return oidc != nil && oidc.isIssuerOf(jwtStr)
+}
@@ -0,0 +1,213 @@
+/*
+ * SPDX-FileCopyrightText: © Hypermode Inc. <hello@hypermode.com>
+ * SPDX-License-Identifier: Apache-2.0
+ */
+
+package x
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/require"
+
+	"github.com/dgraph-io/ristretto/v2/z"
+)
+
+const testOidcIssuer = "https://sso.example.com"
+
+func testJWKS(t *testing.T, kid string, key *rsa.PrivateKey) []byte {
+	data, err := json.Marshal(jose.JSONWebKeySet{Keys: []jose.JSONWebKey{
+		{Key: &key.PublicKey, KeyID: kid, Algorithm: "RS256", Use: "sig"},
+	}})
+	require.NoError(t, err)
+	return data
+}
+
+func signOidcToken(t *testing.T, kid string, key *rsa.PrivateKey, claims jwt.MapClaims) string {
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, claims)
+	token.Header["kid"] = kid
+	str, err := token.SignedString(key)
+	require.NoError(t, err)
+	return str
+}
+
+func TestOIDCConfigFile(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	jwksFile := filepath.Join(t.TempDir(), "jwks.json")
+	require.NoError(t, os.WriteFile(jwksFile, testJWKS(t, "k1", key), 0600))
+
+	sf := z.NewSuperFlag("oidc-issuer=" + testOidcIssuer + "; oidc-audience=dgraph; " +
+		"oidc-jwks-file=" + jwksFile + "; oidc-groups-claim=realm_access.roles; " +
+		"oidc-namespace-claim=tenant; oidc-namespaces=2").MergeAndCheckDefault(AclDefaults)
+	c, err := parseOIDCConfig(sf)
+	require.NoError(t, err)
+	require.NotNil(t, c)
+
+	exp := time.Now().Add(time.Hour).Unix()
+	token := signOidcToken(t, "k1", key, jwt.MapClaims{
+		"iss":          testOidcIssuer,
+		"aud":          "dgraph",
+		"sub":          "alice",
+		"exp":          exp,
+		"tenant":       "2",
+		"realm_access": map[string]interface{}{"roles": []string{"dev", "ops"}},
+	})
+	require.True(t, c.isIssuerOf(token))
+	claims, err := c.Parse(token)
+	require.NoError(t, err)
+	require.Equal(t, "alice", claims["userid"])
+	require.Equal(t, float64(2), claims["namespace"])
+	require.Equal(t, []interface{}{"dev", "ops"}, claims["groups"])
+
+	// Only the listed namespaces can be claimed.
+	token = signOidcToken(t, "k1", key, jwt.MapClaims{
+		"iss": testOidcIssuer, "aud": "dgraph", "sub": "alice", "exp": exp, "tenant": "3"})
+	_, err = c.Parse(token)
+	require.ErrorContains(t, err, "is not accepted")
+
+	// Wrong audience.
+	token = signOidcToken(t, "k1", key, jwt.MapClaims{
+		"iss": testOidcIssuer, "aud": "other", "sub": "alice", "exp": exp})
+	_, err = c.Parse(token)
+	require.Error(t, err)
+
+	// Expired token.
+	token = signOidcToken(t, "k1", key, jwt.MapClaims{
+		"iss": testOidcIssuer, "aud": "dgraph", "sub": "alice",
+		"exp": time.Now().Add(-time.Hour).Unix()})
+	_, err = c.Parse(token)
+	require.ErrorContains(t, err, "Token is expired")
+
+	// Signed by a key that is not in the JWKS.
+	other, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	token = signOidcToken(t, "k1", other, jwt.MapClaims{
+		"iss": testOidcIssuer, "aud": "dgraph", "sub": "alice", "exp": exp})
+	_, err = c.Parse(token)
+	require.Error(t, err)
+	token = signOidcToken(t, "k2", other, jwt.MapClaims{
+		"iss": testOidcIssuer, "aud": "dgraph", "sub": "alice", "exp": exp})
+	_, err = c.Parse(token)
+	require.ErrorContains(t, err, "no key found")
+
+	// Symmetric algorithms are rejected.
+	hs := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+		"iss": testOidcIssuer, "aud": "dgraph", "sub": "alice", "exp": exp})
+	hs.Header["kid"] = "k1"
+	str, err := hs.SignedString([]byte("secret"))
+	require.NoError(t, err)
+	_, err = c.Parse(str)
+	require.Error(t, err)
+
+	// Tokens of other issuers are not OIDC tokens.
+	token = signOidcToken(t, "k1", key, jwt.MapClaims{"iss": "https://other.example.com"})
+	require.False(t, c.isIssuerOf(token))
+}
+
+func TestOIDCConfigURL(t *testing.T) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	jwks := testJWKS(t, "k1", key)
+	var fetches atomic.Int32
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fetches.Add(1)
+		w.Header().Set("Cache-Control", "public, max-age=600")
+		_, _ = w.Write(jwks)
+	}))
+	defer srv.Close()
+
+	sf := z.NewSuperFlag("oidc-issuer=" + testOidcIssuer + "; oidc-jwks-url=" + srv.URL).
+		MergeAndCheckDefault(AclDefaults)
+	c, err := parseOIDCConfig(sf)
+	require.NoError(t, err)
+
+	exp := time.Now().Add(time.Hour).Unix()
+	token := signOidcToken(t, "k1", key, jwt.MapClaims{
+		"iss": testOidcIssuer, "sub": "bob", "exp": exp, "groups": "dev"})
+	for range 3 {
+		claims, err := c.Parse(token)
+		require.NoError(t, err)
+		require.Equal(t, "bob", claims["userid"])
+		require.Equal(t, float64(RootNamespace), claims["namespace"])
+		require.Equal(t, []interface{}{"dev"}, claims["groups"])
+	}
+	require.Equal(t, int32(1), fetches.Load())
+
+	// Unknown kids don't cause a fetch more than once every refresh interval.
+	token = signOidcToken(t, "k2", key, jwt.MapClaims{"iss": testOidcIssuer, "sub": "bob",
+		"exp": exp})
+	_, err = c.Parse(token)
+	require.Error(t, err)
+	require.Equal(t, int32(1), fetches.Load())
+}
+
+func TestOIDCMapClaims(t *testing.T) {
+	claims := jwt.MapClaims{"sub": "alice", "groups": []interface{}{"dev", "guardians", "ops"}}
+
+	// Without a mapping, the groups are accepted as they are, except the guardians.
+	sf := z.NewSuperFlag("oidc-issuer=" + testOidcIssuer + "; oidc-jwks-url=" +
+		"https://sso.example.com/jwks").MergeAndCheckDefault(AclDefaults)
+	c, err := parseOIDCConfig(sf)
+	require.NoError(t, err)
+	mapped, err := c.mapClaims(claims)
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{"dev", "ops"}, mapped["groups"])
+	require.Equal(t, float64(RootNamespace), mapped["namespace"])
+
+	// Any namespace other than the root one must be listed.
+	_, err = c.mapClaims(jwt.MapClaims{"sub": "alice", "namespace": float64(1)})
+	require.ErrorContains(t, err, "is not accepted")
+
+	// With a mapping, only the listed groups are accepted.
+	sf = z.NewSuperFlag("oidc-issuer=" + testOidcIssuer + "; oidc-jwks-url=" +
+		"https://sso.example.com/jwks; oidc-groups=admins:guardians,dev; oidc-namespaces=1,5").
+		MergeAndCheckDefault(AclDefaults)
+	c, err = parseOIDCConfig(sf)
+	require.NoError(t, err)
+	mapped, err = c.mapClaims(jwt.MapClaims{"sub": "alice", "namespace": float64(5),
+		"groups": []interface{}{"dev", "guardians", "ops", "admins"}})
+	require.NoError(t, err)
+	require.Equal(t, []interface{}{"dev", "guardians"}, mapped["groups"])
+	require.Equal(t, float64(5), mapped["namespace"])
+}
+
+func TestParseOIDCConfigErrors(t *testing.T) {
+	c, err := parseOIDCConfig(z.NewSuperFlag("").MergeAndCheckDefault(AclDefaults))
+	require.NoError(t, err)
+	require.Nil(t, c)
+
+	for _, flag := range []string{
+		"oidc-jwks-url=https://sso.example.com/jwks",
+		"oidc-issuer=" + testOidcIssuer,
+		"oidc-issuer=" + testOidcIssuer + "; oidc-jwks-url=https://sso.example.com/jwks; " +
+			"oidc-jwks-file=jwks.json",
+		"oidc-issuer=" + testOidcIssuer + "; oidc-jwks-file=/does/not/exist.json",
+		"oidc-issuer=" + testOidcIssuer + "; oidc-jwks-url=https://sso.example.com/jwks; " +
+			"oidc-groups=dev,:guardians",
+		"oidc-issuer=" + testOidcIssuer + "; oidc-jwks-url=https://sso.example.com/jwks; " +
+			"oidc-namespaces=1,two",
+	} {
+		_, err := parseOIDCConfig(z.NewSuperFlag(flag).MergeAndCheckDefault(AclDefaults))
+		require.Error(t, err, flag)
+	}
+}
+
+func TestParseMaxAge(t *testing.T) {
+	maxAge, ok := parseMaxAge("public, max-age=300, must-revalidate")
+	require.True(t, ok)
+	require.Equal(t, int64(300), maxAge)
+	_, ok = parseMaxAge("no-store")
+	require.False(t, ok)
+}
@@ -27,8 +27,21 @@ const (
 	flagAclJwtAlg     = "jwt-alg"
 	flagAclKeyFile    = "secret-file"
 
-	flagEnc        = "encryption"
-	flagEncKeyFile = "key-file"
+	flagAclOidcIssuer      = "oidc-issuer"
+	flagAclOidcAudience    = "oidc-audience"
+	flagAclOidcJwksUrl     = "oidc-jwks-url"
+	flagAclOidcJwksFile    = "oidc-jwks-file"
+	flagAclOidcUserClaim   = "oidc-user-claim"
+	flagAclOidcGroupsClaim = "oidc-groups-claim"
+	flagAclOidcNsClaim     = "oidc-namespace-claim"
+	flagAclOidcGroups      = "oidc-groups"
+	flagAclOidcNamespaces  = "oidc-namespaces"
+	flagAclCertIdentity    = "cert-identity-file"
+
+	flagEnc             = "encryption"
+	flagEncKeyFile      = "key-file"
+	flagEncFieldKeysDir = "field-keys-dir"
+	flagEncOldKeyFiles  = "old-key-files"
 
 	flagVault             = "vault"
 	flagVaultAddr         = "addr"
@@ -39,18 +52,34 @@ const (
 	flagVaultAclFormat    = "acl-format"
 	flagVaultEncField     = "enc-field"
 	flagVaultEncFormat    = "enc-format"
+	flagVaultOldEncFields = "old-enc-fields"
+	flagVaultFieldPath    = "field-keys-path"
+	flagVaultFieldFormat  = "field-keys-format"
 )
 
 var (
-	AclDefaults = fmt.Sprintf("%s=%s; %s=%s; %s=%s; %s=%s",
+	AclDefaults = fmt.Sprintf("%s=%s; %s=%s; %s=%s; %s=%s; "+
+		"%s=%s; %s=%s; %s=%s; %s=%s; %s=%s; %s=%s; %s=%s; %s=%s; %s=%s; %s=%s",
 		flagAclAccessTtl, "6h",
 		flagAclRefreshTtl, "30d",
 		flagAclJwtAlg, "HS256",
-		flagAclKeyFile, "")
-	EncDefaults = fmt.Sprintf("%s=%s", flagEncKeyFile, "")
+		flagAclKeyFile, "",
+		flagAclOidcIssuer, "",
+		flagAclOidcAudience, "",
+		flagAclOidcJwksUrl, "",
+		flagAclOidcJwksFile, "",
+		flagAclOidcUserClaim, "sub",
+		flagAclOidcGroupsClaim, "groups",
+		flagAclOidcNsClaim, "namespace",
+		flagAclOidcGroups, "",
+		flagAclOidcNamespaces, "",
+		flagAclCertIdentity, "")
+	EncDefaults = fmt.Sprintf("%s=%s; %s=%s; %s=%s", flagEncKeyFile, "", flagEncFieldKeysDir, "",
+		flagEncOldKeyFiles, "")
 )
 
-func vaultGetKeys(config *viper.Viper) (aclKey, encKey Sensitive) {
+func vaultGetKeys(config *viper.Viper) (aclKey, encKey Sensitive, encKeyRing []Sensitive,
+	fieldKeys map[string]Sensitive) {
 	// Avoid querying Vault unless the flag has been explicitly set.
 	if !config.IsSet(flagVault) {
 		return
@@ -65,7 +94,8 @@ func vaultGetKeys(config *viper.Viper) (aclKey, encKey Sensitive) {
 	}
 
 	// Avoid querying Vault unless there is data we want to retrieve from Vault.
-	if vaultConfig.aclField == "" && vaultConfig.encField == "" {
+	if vaultConfig.aclField == "" && vaultConfig.encField == "" && vaultConfig.fieldPath == "" &&
+		len(vaultConfig.oldEncFields) == 0 {
 		return
 	}
 
@@ -74,6 +104,23 @@ func vaultGetKeys(config *viper.Viper) (aclKey, encKey Sensitive) {
 		glog.Exit(err)
 	}
 
+	if vaultConfig.fieldPath != "" {
+		fieldKv, err := vaultGetKvStore(client, vaultConfig.fieldPath)
+		if err != nil {
+			glog.Exit(err)
+		}
+		fieldKeys = make(map[string]Sensitive, len(fieldKv))
+		for name := range fieldKv {
+			if fieldKeys[name], err = fieldKv.getSensitiveBytes(name, vaultConfig.fieldFormat); err != nil {
+				glog.Exit(err)
+			}
+		}
+	}
+	if vaultConfig.aclField == "" && vaultConfig.encField == "" &&
+		len(vaultConfig.oldEncFields) == 0 {
+		return
+	}
+
 	kv, err := vaultGetKvStore(client, vaultConfig.path)
 	if err != nil {
 		glog.Exit(err)
@@ -89,6 +136,13 @@ func vaultGetKeys(config *viper.Viper) (aclKey, encKey Sensitive) {
 			glog.Exit(err)
 		}
 	}
+	for _, field := range vaultConfig.oldEncFields {
+		key, err := kv.getSensitiveBytes(field, vaultConfig.encFormat)
+		if err != nil {
+			glog.Exit(err)
+		}
+		encKeyRing = append(encKeyRing, key)
+	}
 
 	return
 }
@@ -190,6 +244,9 @@ type vaultConfig struct {
 	aclFormat    string
 	encField     string
 	encFormat    string
+	oldEncFields []string
+	fieldPath    string
+	fieldFormat  string
 }
 
 // vaultParseFlag parses and validates a Vault SuperFlag.
@@ -232,10 +289,23 @@ func vaultParseFlag(flag *z.SuperFlag) (*vaultConfig, error) {
 	}
 	aclField := flag.GetString(flagVaultAclField)
 	encField := flag.GetString(flagVaultEncField)
-	if aclField == "" && encField == "" {
+	var oldEncFields []string
+	for _, field := range strings.Split(flag.GetString(flagVaultOldEncFields), ",") {
+		if field = strings.TrimSpace(field); field != "" {
+			oldEncFields = append(oldEncFields, field)
+		}
+	}
+	fieldPath := flag.GetString(flagVaultFieldPath)
+	fieldFormat := flag.GetString(flagVaultFieldFormat)
+	if fieldPath != "" {
+		if err := validateFormat(flagVaultFieldFormat, fieldFormat); err != nil {
+			return nil, err
+		}
+	}
+	if aclField == "" && encField == "" && fieldPath == "" {
 		return nil, fmt.Errorf(
-			"vault: at least one of fields '%s' or '%s' must be provided",
-			flagVaultAclField, flagVaultEncField)
+			"vault: at least one of fields '%s', '%s' or '%s' must be provided",
+			flagVaultAclField, flagVaultEncField, flagVaultFieldPath)
 	}
 
 	config := &vaultConfig{
@@ -247,6 +317,9 @@ func vaultParseFlag(flag *z.SuperFlag) (*vaultConfig, error) {
 		aclFormat:    aclFormat,
 		encField:     encField,
 		encFormat:    encFormat,
+		oldEncFields: oldEncFields,
+		fieldPath:    fieldPath,
+		fieldFormat:  fieldFormat,
 	}
 	return config, nil
 }
@@ -259,14 +332,17 @@ func vaultDefaults(aclEnabled, encEnabled bool) string {
 		flagVaultSecretIdFile, "",
 		flagVaultPath, "secret/data/dgraph")
 	if aclEnabled {
-		fmt.Fprintf(&configBuilder, "; %s=%s; %s=%s",
+		fmt.Fprintf(&configBuilder, "; %s=%s; %s=%s; %s=%s; %s=%s",
 			flagVaultAclField, "",
-			flagVaultAclFormat, "base64")
+			flagVaultAclFormat, "base64",
+			flagVaultFieldPath, "",
+			flagVaultFieldFormat, "base64")
 	}
 	if encEnabled {
-		fmt.Fprintf(&configBuilder, "; %s=%s; %s=%s",
+		fmt.Fprintf(&configBuilder, "; %s=%s; %s=%s; %s=%s",
 			flagVaultEncField, "",
-			flagVaultEncFormat, "base64")
+			flagVaultEncFormat, "base64",
+			flagVaultOldEncFields, "")
 	}
 	return configBuilder.String()
 }
@@ -286,12 +362,19 @@ func registerVaultFlag(flag *pflag.FlagSet, aclEnabled, encEnabled bool) {
 	if aclEnabled {
 		helpBuilder = helpBuilder.
 			Flag(flagVaultAclField, "Vault field containing ACL key.").
-			Flag(flagVaultAclFormat, "ACL key format, can be 'raw' or 'base64'.")
+			Flag(flagVaultAclFormat, "ACL key format, can be 'raw' or 'base64'.").
+			Flag(flagVaultFieldPath, "Vault KV store path of the keys used by the @encrypted "+
+				"directive. Every field of the store is a key named after the field.").
+			Flag(flagVaultFieldFormat, "Format of the keys used by the @encrypted directive, "+
+				"can be 'raw' or 'base64'.")
 	}
 	if encEnabled {
 		helpBuilder = helpBuilder.
 			Flag(flagVaultEncField, "Vault field containing encryption key.").
-			Flag(flagVaultEncFormat, "Encryption key format, can be 'raw' or 'base64'.")
+			Flag(flagVaultEncFormat, "Encryption key format, can be 'raw' or 'base64'.").
+			Flag(flagVaultOldEncFields, "Comma separated list of the Vault fields containing "+
+				"the previous encryption keys, in the same format as the encryption key. They "+
+				"are used like the old-key-files of the encryption flag.")
 	}
 	helpText := helpBuilder.String()
 
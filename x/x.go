@@ -268,6 +268,41 @@ func ExtractNamespace(ctx context.Context) (uint64, error) {
 	return namespace, nil
 }
 
+// ExtractMaxStaleness parses the max-staleness value from the incoming gRPC context. It returns
+// zero if the request doesn't accept stale results.
+func ExtractMaxStaleness(ctx context.Context) (time.Duration, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return 0, nil
+	}
+	staleness := md.Get("max-staleness")
+	if len(staleness) == 0 {
+		return 0, nil
+	}
+	d, err := time.ParseDuration(staleness[0])
+	if err != nil {
+		return 0, errors.Wrapf(err, "Error while parsing max-staleness from metadata")
+	}
+	if d < 0 {
+		return 0, errors.Errorf("max-staleness can't be negative, got: %s", staleness[0])
+	}
+	return d, nil
+}
+
+// AttachMaxStaleness adds the staleness bound of a query received over HTTP to the gRPC context
+// metadata, like the gRPC clients set it.
+func AttachMaxStaleness(ctx context.Context, maxStaleness time.Duration) context.Context {
+	if maxStaleness <= 0 {
+		return ctx
+	}
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		md = metadata.New(nil)
+	}
+	md.Set("max-staleness", maxStaleness.String())
+	return metadata.NewIncomingContext(ctx, md)
+}
+
 func IsRootNsOperation(ctx context.Context) bool {
 	md, ok := metadata.FromIncomingContext(ctx)
 	if !ok {
@@ -503,7 +538,8 @@ func AttachAuthToken(ctx context.Context, r *http.Request) context.Context {
 	return ctx
 }
 
-// AttachAccessJwt adds any incoming JWT header data into the grpc context metadata
+// AttachAccessJwt adds any incoming JWT header data into the grpc context metadata. Requests
+// without it get the access JWT of the user their client certificate maps to, if any.
 func AttachAccessJwt(ctx context.Context, r *http.Request) context.Context {
 	if accessJwt := r.Header.Get("X-Dgraph-AccessToken"); accessJwt != "" {
 		md, ok := metadata.FromIncomingContext(ctx)
@@ -514,7 +550,7 @@ func AttachAccessJwt(ctx context.Context, r *http.Request) context.Context {
 		md.Append("accessJwt", accessJwt)
 		ctx = metadata.NewIncomingContext(ctx, md)
 	}
-	return ctx
+	return attachCertJwt(ctx, r.TLS)
 }
 
 // AttachRemoteIP adds any incoming IP data into the grpc context metadata
@@ -713,6 +749,20 @@ func FixedDuration(d time.Duration) string {
 	return str
 }
 
+// ParseDuration parses durations like "90m" or "1h30m". In addition to the units
+// understood by time.ParseDuration, a whole number of days like "30d" is accepted.
+func ParseDuration(s string) (time.Duration, error) {
+	if days, ok := strings.CutSuffix(s, "d"); ok {
+		n, err := strconv.ParseInt(days, 10, 64)
+		if err != nil {
+			return 0, errors.Wrapf(err, "invalid duration %q", s)
+		}
+		return time.Duration(n) * 24 * time.Hour, nil
+	}
+	d, err := time.ParseDuration(s)
+	return d, errors.Wrapf(err, "invalid duration %q", s)
+}
+
 // PageRange returns start and end indices given pagination params. Note that n
 // is the size of the input list.
 func PageRange(count, offset, n int) (int, int) {